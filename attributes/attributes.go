@@ -2,6 +2,7 @@ package attributes
 
 import (
 	"errors"
+	"strconv"
 	"strings"
 
 	"github.com/flyx/askew/data"
@@ -38,7 +39,10 @@ func (t *Component) collect(name, val string) error {
 	case "params":
 		var err error
 		t.Params, err = parsers.ParseParameters(val)
-		return err
+		if err != nil {
+			return parsers.Wrap(": invalid params: ", err)
+		}
+		return nil
 	case "gen-new-init":
 		t.GenNewInit = true
 		return nil
@@ -97,11 +101,12 @@ func (i *IncludeChild) collect(name, val string) error {
 
 // Embed collects the attributes of <a:embed>.
 type Embed struct {
-	List, Optional bool
-	T, Name        string
-	Args           data.Arguments
-	Value          string
-	Control        bool
+	List, Optional, Virtual bool
+	T, Name                 string
+	Args                    data.Arguments
+	Value                   string
+	Control                 bool
+	ItemHeight              int
 }
 
 func (e *Embed) collect(name, val string) error {
@@ -124,7 +129,10 @@ func (e *Embed) collect(name, val string) error {
 		}
 		var err error
 		e.Args, err = parsers.AnalyseArguments(val)
-		return err
+		if err != nil {
+			return parsers.Wrap(": invalid args: ", err)
+		}
+		return nil
 	case "value":
 		if e.Args.Count != -1 {
 			return errors.New(": embed cannot have both args and value attributes")
@@ -137,6 +145,16 @@ func (e *Embed) collect(name, val string) error {
 	case "control":
 		e.Control = true
 		return nil
+	case "virtual":
+		e.Virtual = true
+		return nil
+	case "item-height":
+		h, err := strconv.Atoi(val)
+		if err != nil || h <= 0 {
+			return errors.New(": item-height must be a positive integer")
+		}
+		e.ItemHeight = h
+		return nil
 	}
 	return invalidAttribute{name}
 }
@@ -144,8 +162,16 @@ func (e *Embed) collect(name, val string) error {
 // General collects attributes that may occur on any element.
 type General struct {
 	Bindings []data.VariableMapping
+	Observed []data.Assignment
 	Capture  []data.UnboundEventMapping
 	If, For  *data.ControlBlock
+	ElseIf   *data.ControlBlock
+	Else     bool
+	Switch   *data.ControlBlock
+	Case     string
+	Default  bool
+	IfLive   string
+	ForLive  *data.ControlBlock
 	Assign   []data.Assignment
 }
 
@@ -153,34 +179,85 @@ func (g *General) collect(name, val string) error {
 	switch name {
 	case "bindings":
 		var err error
-		g.Bindings, err = parsers.ParseBindings(val)
+		g.Bindings, g.Observed, err = parsers.ParseBindings(val)
 		if err != nil {
-			return errors.New(": invalid bindings: " + err.Error())
+			return parsers.Wrap(": invalid bindings: ", err)
 		}
 		for _, binding := range g.Bindings {
-			if binding.Value.Kind == data.BoundEventValue {
+			switch binding.Value.Kind {
+			case data.BoundEventValue:
+				return errors.New(": cannot use event() in bindings")
+			case data.BoundEventDetail:
+				return errors.New(": cannot use detail() in bindings")
+			}
+		}
+		for _, o := range g.Observed {
+			switch o.Target.Kind {
+			case data.BoundEventValue:
 				return errors.New(": cannot use event() in bindings")
+			case data.BoundEventDetail:
+				return errors.New(": cannot use detail() in bindings")
+			case data.BoundExpr:
+				return errors.New(": cannot bind go() to an observable")
+			case data.BoundProperty, data.BoundFormValue:
+			default:
+				if o.TwoWay {
+					return errors.New(": `<->` requires prop() or form()")
+				}
 			}
 		}
 	case "capture":
 		var err error
 		g.Capture, err = parsers.ParseCapture(val)
 		if err != nil {
-			return errors.New(": invalid capture: " + err.Error())
+			return parsers.Wrap(": invalid capture: ", err)
 		}
 	case "if":
 		g.If = &data.ControlBlock{Kind: data.IfBlock, Expression: val}
+	case "if.live":
+		if strings.TrimSpace(val) == "" {
+			return errors.New(": a:if.live requires an expression")
+		}
+		g.IfLive = val
+	case "else-if":
+		if strings.TrimSpace(val) == "" {
+			return errors.New(": a:else-if requires an expression")
+		}
+		g.ElseIf = &data.ControlBlock{Kind: data.IfBlock, Expression: val}
+	case "else":
+		if val != "" {
+			return errors.New(": a:else cannot have a value")
+		}
+		g.Else = true
+	case "switch":
+		g.Switch = &data.ControlBlock{Kind: data.SwitchBlock, Expression: val}
+	case "case":
+		if strings.TrimSpace(val) == "" {
+			return errors.New(": a:case requires a value")
+		}
+		g.Case = val
+	case "default":
+		if val != "" {
+			return errors.New(": a:default cannot have a value")
+		}
+		g.Default = true
 	case "for":
 		var err error
 		g.For, err = parsers.ParseFor(val)
 		if err != nil {
-			return errors.New(": invalid for: " + err.Error())
+			return parsers.Wrap(": invalid for: ", err)
+		}
+	case "for.live":
+		var err error
+		g.ForLive, err = parsers.ParseFor(val)
+		if err != nil {
+			return parsers.Wrap(": invalid for.live: ", err)
 		}
 	case "assign":
 		var err error
 		g.Assign, err = parsers.ParseAssignments(val)
 		if err != nil {
-			return errors.New(": invalid assign: " + err.Error())
+			return parsers.Wrap(": invalid assign: ", err)
 		}
 	default:
 		return invalidAttribute{name}
@@ -188,6 +265,16 @@ func (g *General) collect(name, val string) error {
 	return nil
 }
 
+// ValueError wraps err, which occurred while processing the given value of
+// the attribute with the given key, so that it can be located in the source.
+func ValueError(key, val string, err error) error {
+	ret := &data.AttrError{Key: key, Value: val, Offset: -1, Err: err}
+	if pe, ok := err.(*parsers.Error); ok {
+		ret.Offset = pe.Offset
+	}
+	return ret
+}
+
 // ExtractAskewAttribs removes all askew attributes from the node and hands them
 // to the collector.
 func ExtractAskewAttribs(n *html.Node, target Collector) error {
@@ -214,9 +301,9 @@ func ExtractAskewAttribs(n *html.Node, target Collector) error {
 		if err := target.collect(key, attr.Val); err != nil {
 			if ia, ok := err.(invalidAttribute); ok {
 				ia.name = "a:" + ia.name
-				return ia
+				err = ia
 			}
-			return err
+			return ValueError(attr.Key, attr.Val, err)
 		}
 	}
 	return nil
@@ -242,7 +329,7 @@ func Collect(n *html.Node, target Collector) error {
 			if err == ErrRemoveAttribute {
 				n.Attr = append(n.Attr[:i], n.Attr[i+1:]...)
 			} else {
-				return err
+				return ValueError(attr.Key, attr.Val, err)
 			}
 		} else {
 			i++
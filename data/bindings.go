@@ -26,6 +26,9 @@ const (
 	// <a:text> elements with text nodes, getting it can be used to access the
 	// raw js.Value of the node.
 	BoundSelf
+	// BoundEventDetail is a reference to an item of the `detail` of the
+	// CustomEvent that has been received. It can only be used in a:capture.
+	BoundEventDetail
 )
 
 // BoundValue specifies the target of a value binding.
@@ -1,5 +1,7 @@
 package data
 
+import "time"
+
 // EventHandling describes how the event's default action should be handled.
 type EventHandling int
 
@@ -17,6 +19,45 @@ const (
 	AutoPreventDefault
 )
 
+// EventOptions are the modifiers of an event mapping besides preventDefault.
+type EventOptions struct {
+	// Once, Passive and Capture are given as options to addEventListener.
+	Once, Passive, Capture bool
+	// StopPropagation and StopImmediatePropagation stop the propagation of
+	// the event before the handler is called.
+	StopPropagation, StopImmediatePropagation bool
+	// Self ignores events that have been dispatched to a descendant of the
+	// node.
+	Self bool
+	// Filter, if not nil, restricts the keyboard or mouse events the handler
+	// is called for.
+	Filter *EventFilter
+	// Debounce and Throttle, if not zero, limit how often the handler is
+	// called. At most one of them is set.
+	Debounce, Throttle time.Duration
+}
+
+// HasListenerOptions returns true if any of the options given to
+// addEventListener is set, or if the events are filtered.
+func (eo EventOptions) HasListenerOptions() bool {
+	return eo.Once || eo.Passive || eo.Capture || eo.Filter != nil
+}
+
+// IsLimited returns true if the handler is debounced or throttled.
+func (eo EventOptions) IsLimited() bool {
+	return eo.Debounce != 0 || eo.Throttle != 0
+}
+
+// EventFilter describes the keys or mouse buttons an event must have been
+// caused by.
+type EventFilter struct {
+	// Keys are values of the event's `key`, Buttons values of its `button`.
+	Keys    []string
+	Buttons []int
+	// Ctrl, Shift, Alt and Meta are the modifier keys that must be pressed.
+	Ctrl, Shift, Alt, Meta bool
+}
+
 // BoundParam is a capture callback parameter that is bound to a value.
 type BoundParam struct {
 	Param
@@ -30,6 +71,7 @@ type EventMapping struct {
 	FromController bool
 	ParamMappings  []BoundParam
 	Handling       EventHandling
+	Options        EventOptions
 }
 
 // UnboundEventMapping describes an event mapping for which the parameter names
@@ -39,4 +81,5 @@ type UnboundEventMapping struct {
 	Handler       string
 	ParamMappings map[string]BoundValue
 	Handling      EventHandling
+	Options       EventOptions
 }
@@ -2,6 +2,7 @@ package data
 
 import (
 	"unicode"
+	"unicode/utf8"
 
 	"github.com/flyx/net/html"
 )
@@ -47,24 +48,37 @@ type ConstructorCall struct {
 	Kind            ConstructorCallKind
 	Index, Variable string // only for NestedFor
 	Expression      string // only for NestedIf and NestedFor
+	// Hydratable is true if askew generates the hydrate func of the component.
+	Hydratable bool
 }
 
 // Embed describes a <a:embed> node.
 type Embed struct {
 	// is a constructor call if Kind == DirectEmbed && Value == "".
-	Args             Arguments
-	Value            string
-	Kind             EmbedKind
-	Path             []int
-	Field, Ns, T     string
-	Control          bool
+	Args         Arguments
+	Value        string
+	Kind         EmbedKind
+	Path         []int
+	Field, Ns, T string
+	Control      bool
+	// Virtual is true for a list embed that only renders visible items, all of
+	// which have a height of ItemHeight pixels.
+	Virtual          bool
+	ItemHeight       int
 	ConstructorCalls []ConstructorCall
+	// Hydratable is true if askew generates the hydrate funcs of all embedded
+	// components. Otherwise, they are re-created when hydrating.
+	Hydratable bool
+	// Node is the <a:embed> element, used for error reporting.
+	Node *html.Node
 }
 
 // Handler describes a <a:handler> node.
 type Handler struct {
 	Params  []Param
 	Returns *ParamType
+	// Node is the element declaring the handler, used for error reporting.
+	Node *html.Node
 }
 
 // ControllerMethod is a method of a controller declared with <a:controller>.
@@ -72,10 +86,27 @@ type ControllerMethod struct {
 	Handler
 }
 
+// Event describes an event declared in <a:events> that the component emits.
+type Event struct {
+	Params []Param
+	// Node is the <a:events> element, used for error reporting.
+	Node *html.Node
+}
+
+// EmitName returns the name of the method that emits the event with the
+// given name.
+func EmitName(event string) string {
+	first, size := utf8.DecodeRuneInString(event)
+	return "Emit" + string(unicode.ToUpper(first)) + event[size:]
+}
+
 // Capture describe a `a:capture` attribute.
 type Capture struct {
+	// Path is relative to the element of the enclosing control block, if any.
 	Path     []int
 	Mappings []EventMapping
+	// Node is the element with the attribute, used for error reporting.
+	Node *html.Node
 }
 
 // ComponentParam is a component parameter whose type is not parsed or checked by
@@ -92,12 +123,39 @@ type Assignment struct {
 	Expression string
 	Path       []int
 	Target     BoundValue
+	// Node is the element of an observed value, used for error reporting.
+	Node *html.Node
+	// TwoWay is true for an observed value that also updates the observable
+	// when the DOM value changes.
+	TwoWay bool
 }
 
 // Block is a subtree of a component.
 type Block struct {
 	Assignments []Assignment
 	Controlled  []*ControlBlock
+	// Variables and Captures are those inside a control block. They are empty
+	// in the Block of a Unit, whose variables and captures are processed
+	// outside of control blocks.
+	Variables []VariableMapping
+	Captures  []Capture
+}
+
+// LiveIf describes an element with a:if.live, which is shown while the
+// observable yielded by Expression has the value true.
+type LiveIf struct {
+	Expression string
+	Path       []int
+	// Node is the element, used for error reporting.
+	Node *html.Node
+}
+
+// LiveFor describes an element with a:for.live. Its ControlBlock is a
+// ForBlock whose elements are re-created each time the range is re-evaluated.
+type LiveFor struct {
+	*ControlBlock
+	// Node is the element, used for error reporting.
+	Node *html.Node
 }
 
 // ControlBlockKind describes the kind of a control block.
@@ -111,6 +169,10 @@ const (
 	// variables in assignments in the original element.
 	// The original element is removed from the structure.
 	ForBlock
+	// SwitchBlock keeps the first of its Cases whose values match its
+	// expression, and removes all others. The element of the SwitchBlock
+	// itself, which contains the cases, is kept.
+	SwitchBlock
 )
 
 // ControlBlock is a block governed by some control structure.
@@ -121,22 +183,71 @@ type ControlBlock struct {
 	Index, Variable string // only for ForBlock
 	Expression      string
 	Path            []int
+	// Loops is the number of a:for a ForBlock is inside, including itself.
+	Loops int
+	// Else contains the branches given by a:else-if and a:else on the
+	// following siblings of an IfBlock's element. They are IfBlocks themselves,
+	// the one for a:else has an empty Expression.
+	Else []*ControlBlock
+	// Cases contains the children of a SwitchBlock's element, given by a:case
+	// and a:default. The Expression of a case is the list of its values, and
+	// is empty for a:default. Their Kind is not used.
+	Cases []*ControlBlock
+}
+
+// Branches returns the IfBlock and its Else branches.
+func (cb *ControlBlock) Branches() []*ControlBlock {
+	return append([]*ControlBlock{cb}, cb.Else...)
+}
+
+// Blocks returns the control blocks whose content is governed by cb: The
+// branches of an IfBlock, the cases of a SwitchBlock, or cb itself.
+func (cb *ControlBlock) Blocks() []*ControlBlock {
+	switch cb.Kind {
+	case IfBlock:
+		return cb.Branches()
+	case SwitchBlock:
+		return cb.Cases
+	}
+	return []*ControlBlock{cb}
+}
+
+// HasDefault returns true if one of the SwitchBlock's Cases is given by
+// a:default.
+func (cb *ControlBlock) HasDefault() bool {
+	for _, c := range cb.Cases {
+		if c.Expression == "" {
+			return true
+		}
+	}
+	return false
+}
+
+// HasElse returns true if the last branch of the IfBlock is given by a:else.
+func (cb *ControlBlock) HasElse() bool {
+	return len(cb.Else) > 0 && cb.Else[len(cb.Else)-1].Expression == ""
 }
 
 // Component describes a <a:component> node.
 type Component struct {
 	Unit
 	// HTML id. internally generated.
-	ID              string
-	Name            string
-	Parameters      []ComponentParam
-	Template        *html.Node
-	Fields          []*Field
-	Handlers        map[string]Handler
-	Controller      map[string]ControllerMethod
-	Captures        []Capture
-	GenNewInit      bool
-	GenList, GenOpt bool
+	ID                          string
+	Name                        string
+	Parameters                  []ComponentParam
+	Template                    *html.Node
+	Fields                      []*Field
+	Handlers                    map[string]Handler
+	Controller                  map[string]ControllerMethod
+	Events                      map[string]Event
+	Captures                    []Capture
+	GenNewInit                  bool
+	GenList, GenOpt, GenVirtual bool
+	// UsageRestricted is true if the `usage` attribute has been given.
+	// Otherwise, GenVirtual is set by the virtual embeds using the component.
+	UsageRestricted bool
+	// Node is the <a:component> element, used for error reporting.
+	Node *html.Node
 }
 
 // NewName returns the name of the component's new func.
@@ -147,3 +258,12 @@ func (c Component) NewName() string {
 	}
 	return "New" + c.Name
 }
+
+// HydrateName returns the name of the component's hydrate func.
+func (c Component) HydrateName() string {
+	runes := []rune(c.Name)
+	if unicode.IsLower(runes[0]) {
+		return "hydrate" + string(unicode.ToUpper(runes[0])) + string(runes[1:])
+	}
+	return "Hydrate" + c.Name
+}
@@ -0,0 +1,14 @@
+package data
+
+import "testing"
+
+func TestEmitName(t *testing.T) {
+	for event, expected := range map[string]string{
+		"selected": "EmitSelected", "Closed": "EmitClosed", "é": "EmitÉ",
+		"ändern": "EmitÄndern",
+	} {
+		if actual := EmitName(event); actual != expected {
+			t.Errorf("%s: expected %s, got %s", event, expected, actual)
+		}
+	}
+}
@@ -0,0 +1,78 @@
+package data
+
+import "sort"
+
+// Diagnostic is an error located in a source file.
+type Diagnostic struct {
+	Pos Position
+	Msg string
+}
+
+func (d *Diagnostic) Error() string {
+	return d.Pos.String() + ": " + d.Msg
+}
+
+// Diagnostics collects errors so that processing can continue after the first
+// one, letting all errors be reported at once.
+type Diagnostics struct {
+	items []*Diagnostic
+}
+
+// Add adds the given diagnostic.
+func (d *Diagnostics) Add(item *Diagnostic) {
+	d.items = append(d.items, item)
+}
+
+// Len returns the number of collected diagnostics.
+func (d *Diagnostics) Len() int {
+	return len(d.items)
+}
+
+// Sorted returns the collected diagnostics sorted by position, without
+// duplicates.
+func (d *Diagnostics) Sorted() []*Diagnostic {
+	ret := append([]*Diagnostic(nil), d.items...)
+	sort.SliceStable(ret, func(i, j int) bool {
+		l, r := ret[i].Pos, ret[j].Pos
+		if l.File != r.File {
+			return l.File < r.File
+		}
+		if l.Line != r.Line {
+			return l.Line < r.Line
+		}
+		return l.Column < r.Column
+	})
+	for i := 1; i < len(ret); {
+		if *ret[i] == *ret[i-1] {
+			ret = append(ret[:i], ret[i+1:]...)
+		} else {
+			i++
+		}
+	}
+	return ret
+}
+
+// IsFollowUp checks whether err only follows from an earlier error, in which
+// case it should not be reported.
+func IsFollowUp(err error) bool {
+	for {
+		switch e := err.(type) {
+		case *NodeError:
+			err = e.Err
+		case *AttrError:
+			err = e.Err
+		case UnknownSymbolErr:
+			return e.FollowUp
+		default:
+			return false
+		}
+	}
+}
+
+// Report adds err, which occurred in the file at path, to the diagnostics
+// unless it is a follow-up error.
+func (b *BaseDir) Report(path string, err error) {
+	if !IsFollowUp(err) {
+		b.Diagnostics.Add(b.Positions.Locate(path, err))
+	}
+}
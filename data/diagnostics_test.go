@@ -0,0 +1,70 @@
+package data
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestDiagnosticsSorted(t *testing.T) {
+	var d Diagnostics
+	for _, item := range []*Diagnostic{
+		{Pos: Position{"b.askew", 1, 1}, Msg: "b"},
+		{Pos: Position{"a.askew", 2, 5}, Msg: "a2"},
+		{Pos: Position{"a.askew", 2, 1}, Msg: "a1"},
+		{Pos: Position{"a.askew", 10, 1}, Msg: "a3"},
+		{Pos: Position{"a.askew", 2, 5}, Msg: "a2"},
+		{Pos: Position{"a.askew", 2, 5}, Msg: "a2 other"},
+	} {
+		d.Add(item)
+	}
+	if d.Len() != 6 {
+		t.Errorf("expected 6 diagnostics, got %d", d.Len())
+	}
+	expected := []string{"a.askew:2:1: a1", "a.askew:2:5: a2",
+		"a.askew:2:5: a2 other", "a.askew:10:1: a3", "b.askew:1:1: b"}
+	sorted := d.Sorted()
+	if len(sorted) != len(expected) {
+		t.Fatalf("expected %d sorted diagnostics, got %d", len(expected), len(sorted))
+	}
+	for i := range expected {
+		if sorted[i].Error() != expected[i] {
+			t.Errorf("at %d: expected %s, got %s", i, expected[i], sorted[i])
+		}
+	}
+	if d.Len() != 6 {
+		t.Error("Sorted must not modify the collected diagnostics")
+	}
+}
+
+func TestIsFollowUp(t *testing.T) {
+	for _, c := range []struct {
+		err      error
+		followUp bool
+	}{
+		{errors.New("plain"), false},
+		{UnknownSymbolErr{Kind: "macro", ID: "m"}, false},
+		{UnknownSymbolErr{Kind: "macro", ID: "m", FollowUp: true}, true},
+		{&NodeError{Err: &AttrError{Err: UnknownSymbolErr{FollowUp: true}}}, true},
+		{&NodeError{Err: &AttrError{Err: errors.New("other")}}, false},
+	} {
+		if IsFollowUp(c.err) != c.followUp {
+			t.Errorf("%#v: expected IsFollowUp to be %v", c.err, c.followUp)
+		}
+	}
+}
+
+func TestReport(t *testing.T) {
+	var b BaseDir
+	b.Report("a.askew", errors.New("first"))
+	b.Report("a.askew", &NodeError{Err: UnknownSymbolErr{
+		Kind: "component", ID: "c", FollowUp: true}})
+	b.Report("b.askew", UnknownSymbolErr{Kind: "component", ID: "c"})
+	sorted := b.Diagnostics.Sorted()
+	if len(sorted) != 2 {
+		t.Fatalf("expected 2 diagnostics, got %d", len(sorted))
+	}
+	if sorted[0].Error() != "a.askew: first" ||
+		sorted[1].Error() != "b.askew: unknown component: 'c'" {
+		t.Errorf("unexpected diagnostics: %s, %s", sorted[0], sorted[1])
+	}
+}
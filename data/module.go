@@ -48,6 +48,32 @@ type Package struct {
 	ImportPath string
 	// Name is the package's name.
 	Name string
+	// Incomplete is set when a file of the package had errors that prevented
+	// its symbols from being discovered.
+	Incomplete bool
+	// Failed contains the names of symbols that could not be registered because
+	// of errors.
+	Failed map[string]struct{}
+}
+
+// MarkFailed records that the symbol with the given name could not be
+// registered. If the name is empty, the package is marked as incomplete.
+func (p *Package) MarkFailed(name string) {
+	if name == "" {
+		p.Incomplete = true
+		return
+	}
+	if p.Failed == nil {
+		p.Failed = make(map[string]struct{})
+	}
+	p.Failed[name] = struct{}{}
+}
+
+// HasFailed checks whether the symbol with the given name may be missing
+// because of previous errors.
+func (p *Package) HasFailed(name string) bool {
+	_, ok := p.Failed[name]
+	return ok || p.Incomplete
 }
 
 // BaseDir describes the directory on which askew is executed
@@ -56,4 +82,8 @@ type BaseDir struct {
 	Packages map[string]*Package
 	// ImportPath is the path with which the base directory can be imported.
 	ImportPath string
+	// Positions maps the nodes of all parsed files to their source positions.
+	Positions Positions
+	// Diagnostics collects the errors found in all processed files.
+	Diagnostics Diagnostics
 }
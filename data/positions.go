@@ -0,0 +1,381 @@
+package data
+
+import (
+	"bytes"
+	"fmt"
+	"strings"
+
+	"github.com/flyx/net/html"
+)
+
+// Position is a location in a source file. Line and Column start at 1;
+// Column counts bytes.
+type Position struct {
+	File         string
+	Line, Column int
+}
+
+func (p Position) String() string {
+	if p.Line == 0 {
+		return p.File
+	}
+	return fmt.Sprintf("%s:%d:%d", p.File, p.Line, p.Column)
+}
+
+// advance returns the position after reading s starting at p.
+func (p Position) advance(s string) Position {
+	for i := 0; i < len(s); i++ {
+		if s[i] == '\n' {
+			p.Line++
+			p.Column = 1
+		} else {
+			p.Column++
+		}
+	}
+	return p
+}
+
+type nodePositions struct {
+	pos Position
+	// positions of attribute values, by attribute key
+	attrs map[string]Position
+	// source of attribute values, by attribute key
+	values map[string]string
+	// position and source of the text directly following the start tag
+	content Position
+	text    string
+}
+
+// Positions is a side table mapping HTML nodes to their location in the source.
+//
+// The HTML parser does not track positions. Instead, the source is tokenized
+// again and the start tags are matched to the parsed elements in document
+// order. Elements implied by the parser have no position.
+type Positions struct {
+	nodes map[*html.Node]*nodePositions
+}
+
+type startTag struct {
+	name string
+	np   *nodePositions
+}
+
+// lookahead is the number of start tags that may be skipped when matching
+// tags to elements. This compensates for tags the parser drops, e.g. a
+// misplaced <body>.
+const lookahead = 2
+
+func isSpace(b byte) bool {
+	switch b {
+	case ' ', '\n', '\r', '\t', '\f':
+		return true
+	}
+	return false
+}
+
+func skipSpace(raw []byte, i int) int {
+	for i < len(raw) && isSpace(raw[i]) {
+		i++
+	}
+	return i
+}
+
+func scanName(raw []byte, i int) (name string, next int) {
+	start := i
+	for i < len(raw) && !isSpace(raw[i]) && raw[i] != '/' && raw[i] != '>' &&
+		raw[i] != '=' {
+		i++
+	}
+	return strings.ToLower(string(raw[start:i])), i
+}
+
+// attrSpan is the location of an attribute value inside a start tag.
+type attrSpan struct {
+	start, end int
+}
+
+// scanTag reads the raw content of a start tag and returns the tag name and
+// the locations of all attribute values relative to the start of raw.
+// Attributes without value are located at their name, with an empty span.
+func scanTag(raw []byte) (name string, attrs map[string]attrSpan) {
+	name, i := scanName(raw, 1)
+	attrs = make(map[string]attrSpan)
+	// the tokenizer keeps the first of duplicate attributes.
+	add := func(key string, start, end int) {
+		if _, ok := attrs[key]; !ok {
+			attrs[key] = attrSpan{start, end}
+		}
+	}
+	for {
+		i = skipSpace(raw, i)
+		for i < len(raw) && raw[i] == '/' {
+			i = skipSpace(raw, i+1)
+		}
+		if i >= len(raw) || raw[i] == '>' {
+			return
+		}
+		var key string
+		keyStart := i
+		key, i = scanName(raw, i)
+		if key == "" {
+			// stray '='
+			i++
+			continue
+		}
+		j := skipSpace(raw, i)
+		if j >= len(raw) || raw[j] != '=' {
+			add(key, keyStart, keyStart)
+			continue
+		}
+		i = skipSpace(raw, j+1)
+		start := i
+		if i < len(raw) && (raw[i] == '"' || raw[i] == '\'') {
+			quote := raw[i]
+			i++
+			start = i
+			for i < len(raw) && raw[i] != quote {
+				i++
+			}
+			add(key, start, i)
+			i++
+			continue
+		}
+		for i < len(raw) && !isSpace(raw[i]) && raw[i] != '>' {
+			i++
+		}
+		add(key, start, i)
+	}
+}
+
+// rawOffset maps offset, a byte offset in the unescaped form of raw, to the
+// corresponding byte offset in raw. Offsets inside the replacement of a
+// character reference are mapped to the start of the reference.
+func rawOffset(raw string, offset int) int {
+	i := 0
+	for i < len(raw) {
+		// each segment contains at most one character reference, at its start.
+		end := strings.IndexByte(raw[i+1:], '&')
+		if end < 0 {
+			end = len(raw)
+		} else {
+			end += i + 1
+		}
+		seg := raw[i:end]
+		value := html.UnescapeString(seg)
+		// n is the length of the reference in seg, the rest is literal text.
+		n := 0
+		for !strings.HasSuffix(value, seg[n:]) {
+			n++
+		}
+		replaced := len(value) - len(seg[n:])
+		if offset < replaced {
+			return i
+		}
+		if offset < len(value) {
+			return i + n + offset - replaced
+		}
+		offset -= len(value)
+		i = end
+	}
+	return len(raw)
+}
+
+func elements(roots []*html.Node) []*html.Node {
+	var ret []*html.Node
+	var walk func(n *html.Node)
+	walk = func(n *html.Node) {
+		if n.Type == html.ElementNode {
+			ret = append(ret, n)
+		}
+		for c := n.FirstChild; c != nil; c = c.NextSibling {
+			walk(c)
+		}
+	}
+	for _, r := range roots {
+		walk(r)
+	}
+	return ret
+}
+
+func (p *Positions) match(tags []startTag, roots []*html.Node) {
+	if p.nodes == nil {
+		p.nodes = make(map[*html.Node]*nodePositions)
+	}
+	i := 0
+	for _, n := range elements(roots) {
+		for j := i; j < len(tags) && j <= i+lookahead; j++ {
+			if strings.EqualFold(tags[j].name, n.Data) {
+				if tags[j].np != nil {
+					p.nodes[n] = tags[j].np
+				}
+				i = j + 1
+				break
+			}
+		}
+	}
+}
+
+// Record tokenizes src, which is the content of the given file, and records
+// the positions of the elements in the node trees with the given roots, which
+// must have been parsed from src.
+func (p *Positions) Record(file string, src []byte, roots ...*html.Node) {
+	var tags []startTag
+	var prevTag bool
+	start := Position{File: file, Line: 1, Column: 1}
+	z := html.NewTokenizer(bytes.NewReader(src))
+	for {
+		tt := z.Next()
+		if tt == html.ErrorToken {
+			break
+		}
+		raw := string(z.Raw())
+		if tt == html.StartTagToken || tt == html.SelfClosingTagToken {
+			name, spans := scanTag([]byte(raw))
+			np := &nodePositions{pos: start, attrs: make(map[string]Position),
+				values: make(map[string]string), content: start.advance(raw)}
+			for key, span := range spans {
+				np.attrs[key] = start.advance(raw[:span.start])
+				np.values[key] = raw[span.start:span.end]
+			}
+			tags = append(tags, startTag{name: name, np: np})
+		} else if tt == html.TextToken && prevTag {
+			tags[len(tags)-1].np.text = raw
+		}
+		prevTag = tt == html.StartTagToken
+		start = start.advance(raw)
+	}
+	p.match(tags, roots)
+}
+
+// Transfer copies the positions of the nodes in the trees rooted at from
+// to the nodes in the trees rooted at to. This is used when the content of a
+// file is rendered and parsed again.
+func (p *Positions) Transfer(from, to []*html.Node) {
+	var tags []startTag
+	for _, n := range elements(from) {
+		tags = append(tags, startTag{name: n.Data, np: p.nodes[n]})
+	}
+	p.match(tags, to)
+}
+
+// Copy assigns the position of the node from to the node to.
+func (p *Positions) Copy(from, to *html.Node) {
+	if np, ok := p.nodes[from]; ok {
+		p.nodes[to] = np
+	}
+}
+
+// Discard removes the positions of all nodes in the given file. This is used
+// when a file is discovered again.
+func (p *Positions) Discard(file string) {
+	for n, np := range p.nodes {
+		if np.pos.File == file {
+			delete(p.nodes, n)
+		}
+	}
+}
+
+// Node returns the position of the given node. If the node has not been
+// recorded, the position of its nearest recorded ancestor is returned.
+func (p *Positions) Node(n *html.Node) (Position, bool) {
+	for ; n != nil; n = n.Parent {
+		if np, ok := p.nodes[n]; ok {
+			return np.pos, true
+		}
+	}
+	return Position{}, false
+}
+
+// Attr returns the position of the value of the attribute with the given
+// key on the given node.
+func (p *Positions) Attr(n *html.Node, key string) (Position, bool) {
+	if np, ok := p.nodes[n]; ok {
+		pos, ok := np.attrs[strings.ToLower(key)]
+		return pos, ok
+	}
+	return Position{}, false
+}
+
+// NodeError is an error that occurred while processing a node.
+type NodeError struct {
+	Node *html.Node
+	// Path lists the nodes from the outermost processed node down to Node.
+	Path string
+	Err  error
+}
+
+func (e *NodeError) Error() string {
+	return e.Path + e.Err.Error()
+}
+
+// TextError is an error in the text content of a node, which must directly
+// follow the node's start tag.
+type TextError struct {
+	Text string
+	// Offset is the byte offset inside Text where the error occurred,
+	// -1 if unknown.
+	Offset int
+	Err    error
+}
+
+func (e *TextError) Error() string {
+	return e.Err.Error()
+}
+
+// AttrError is an error in the value of an attribute.
+type AttrError struct {
+	Key, Value string
+	// Offset is the byte offset inside Value where the error occurred,
+	// -1 if unknown.
+	Offset int
+	Err    error
+}
+
+func (e *AttrError) Error() string {
+	return e.Err.Error()
+}
+
+// advanceRaw returns the position of the given offset in value, which starts
+// at pos. raw is the source of value, which may contain character references.
+// If value has not been unescaped from raw, value is taken as source.
+func advanceRaw(pos Position, raw, value string, offset int) Position {
+	if html.UnescapeString(raw) == value {
+		return pos.advance(raw[:rawOffset(raw, offset)])
+	}
+	return pos.advance(value[:offset])
+}
+
+// Locate turns err into a Diagnostic located at the source position it refers
+// to. If err does not refer to a node with known position, the Diagnostic is
+// located at path instead.
+func (p *Positions) Locate(path string, err error) *Diagnostic {
+	if d, ok := err.(*Diagnostic); ok {
+		return d
+	}
+	ne, ok := err.(*NodeError)
+	if !ok {
+		return &Diagnostic{Pos: Position{File: path},
+			Msg: strings.TrimPrefix(err.Error(), ": ")}
+	}
+	var pos Position
+	var found bool
+	switch e := ne.Err.(type) {
+	case *AttrError:
+		pos, found = p.Attr(ne.Node, e.Key)
+		if found && e.Offset >= 0 && e.Offset <= len(e.Value) {
+			pos = advanceRaw(pos, p.nodes[ne.Node].values[strings.ToLower(e.Key)],
+				e.Value, e.Offset)
+		}
+	case *TextError:
+		if np, ok := p.nodes[ne.Node]; ok && e.Offset >= 0 && e.Offset <= len(e.Text) {
+			pos, found = advanceRaw(np.content, np.text, e.Text, e.Offset), true
+		}
+	}
+	if !found {
+		pos, found = p.Node(ne.Node)
+	}
+	if !found {
+		return &Diagnostic{Pos: Position{File: path}, Msg: err.Error()}
+	}
+	return &Diagnostic{Pos: pos, Msg: strings.TrimPrefix(ne.Err.Error(), ": ")}
+}
@@ -0,0 +1,206 @@
+package data
+
+import (
+	"bytes"
+	"errors"
+	"testing"
+
+	"github.com/flyx/net/html"
+)
+
+func parse(t *testing.T, p *Positions, src string) []*html.Node {
+	t.Helper()
+	nodes, err := html.ParseFragment(bytes.NewReader([]byte(src)), &BodyEnv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p.Record("a.askew", []byte(src), nodes...)
+	return nodes
+}
+
+func TestPositionString(t *testing.T) {
+	if s := (Position{File: "a.askew"}).String(); s != "a.askew" {
+		t.Errorf("unexpected position without line: %s", s)
+	}
+	if s := (Position{File: "a.askew", Line: 3, Column: 7}).String(); s != "a.askew:3:7" {
+		t.Errorf("unexpected position: %s", s)
+	}
+}
+
+func TestRecordNodes(t *testing.T) {
+	var p Positions
+	nodes := parse(t, &p, "<div>\n  <p>text</p>\n\t<span></span></div>")
+	div := nodes[0]
+	pos, ok := p.Node(div)
+	if !ok || pos != (Position{"a.askew", 1, 1}) {
+		t.Errorf("wrong position of <div>: %v (%v)", pos, ok)
+	}
+	para := div.FirstChild.NextSibling
+	pos, ok = p.Node(para)
+	if !ok || pos != (Position{"a.askew", 2, 3}) {
+		t.Errorf("wrong position of <p>: %v (%v)", pos, ok)
+	}
+	// text nodes report the position of their parent
+	pos, ok = p.Node(para.FirstChild)
+	if !ok || pos != (Position{"a.askew", 2, 3}) {
+		t.Errorf("wrong position of text: %v (%v)", pos, ok)
+	}
+	span := para.NextSibling.NextSibling
+	pos, ok = p.Node(span)
+	if !ok || pos != (Position{"a.askew", 3, 2}) {
+		t.Errorf("wrong position of <span>: %v (%v)", pos, ok)
+	}
+}
+
+func TestRecordAttrs(t *testing.T) {
+	var p Positions
+	nodes := parse(t, &p, `<a href="x"
+  Title='y' disabled data-v=z>`)
+	for _, c := range []struct {
+		key string
+		pos Position
+		ok  bool
+	}{
+		{"href", Position{"a.askew", 1, 10}, true},
+		{"title", Position{"a.askew", 2, 10}, true},
+		{"TITLE", Position{"a.askew", 2, 10}, true},
+		{"disabled", Position{"a.askew", 2, 13}, true},
+		{"data-v", Position{"a.askew", 2, 29}, true},
+		{"missing", Position{}, false},
+	} {
+		pos, ok := p.Attr(nodes[0], c.key)
+		if ok != c.ok || pos != c.pos {
+			t.Errorf("wrong position of %s: %v (%v)", c.key, pos, ok)
+		}
+	}
+}
+
+func TestRecordImpliedElements(t *testing.T) {
+	var p Positions
+	nodes := parse(t, &p, "<table><tr><td>x</td></tr></table>")
+	tbody := nodes[0].FirstChild
+	if tbody.Data != "tbody" {
+		t.Fatalf("expected implied tbody, got %s", tbody.Data)
+	}
+	if _, ok := p.nodes[tbody]; ok {
+		t.Error("implied <tbody> must not have a position")
+	}
+	pos, ok := p.Node(tbody.FirstChild)
+	if !ok || pos != (Position{"a.askew", 1, 8}) {
+		t.Errorf("wrong position of <tr>: %v (%v)", pos, ok)
+	}
+}
+
+func TestTransfer(t *testing.T) {
+	var p Positions
+	from := parse(t, &p, "\n<div><b></b></div>")
+	to, err := html.ParseFragment(bytes.NewReader([]byte("<div><b></b></div>")), &BodyEnv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p.Transfer(from, to)
+	pos, ok := p.Node(to[0].FirstChild)
+	if !ok || pos != (Position{"a.askew", 2, 6}) {
+		t.Errorf("wrong transferred position: %v (%v)", pos, ok)
+	}
+}
+
+func TestLocate(t *testing.T) {
+	var p Positions
+	nodes := parse(t, &p, "<div>\n<p a:capture=\"click:foo\"></p></div>")
+	para := nodes[0].FirstChild.NextSibling
+
+	d := p.Locate("a.askew", &NodeError{Node: para, Path: "<div>",
+		Err: errors.New(": broken")})
+	if d.Error() != "a.askew:2:1: broken" {
+		t.Errorf("unexpected node diagnostic: %s", d)
+	}
+
+	d = p.Locate("a.askew", &NodeError{Node: para, Err: &AttrError{
+		Key: "a:capture", Value: "click:foo", Offset: 6, Err: errors.New("bad handler")}})
+	if d.Error() != "a.askew:2:21: bad handler" {
+		t.Errorf("unexpected attribute diagnostic: %s", d)
+	}
+
+	d = p.Locate("a.askew", &NodeError{Node: para, Err: &AttrError{
+		Key: "a:capture", Value: "click:foo", Offset: -1, Err: errors.New("bad")}})
+	if d.Error() != "a.askew:2:15: bad" {
+		t.Errorf("unexpected diagnostic without offset: %s", d)
+	}
+
+	d = p.Locate("b.askew", errors.New(": no node"))
+	if d.Error() != "b.askew: no node" {
+		t.Errorf("unexpected diagnostic without node: %s", d)
+	}
+
+	orig := &Diagnostic{Pos: Position{"c.askew", 1, 1}, Msg: "kept"}
+	if p.Locate("a.askew", orig) != orig {
+		t.Error("Locate must keep existing diagnostics")
+	}
+}
+
+func TestRawOffset(t *testing.T) {
+	for _, c := range []struct {
+		raw            string
+		offset, result int
+	}{
+		{"abc", 2, 2},
+		{"abc", 3, 3},
+		{"a&amp;b", 1, 1},
+		{"a&amp;b", 2, 6},
+		{"&lt;&gt;x", 2, 8},
+		{"&amp;amp;", 1, 5},
+		// offsets inside a replacement are mapped to the start of the reference.
+		{"&#x1F600;x", 2, 0},
+		{"&#x1F600;x", 4, 9},
+		// not a character reference
+		{"a&b", 2, 2},
+		{"&", 1, 1},
+	} {
+		if result := rawOffset(c.raw, c.offset); result != c.result {
+			t.Errorf("offset %d in %q: expected %d, got %d", c.offset, c.raw, c.result, result)
+		}
+	}
+}
+
+func TestLocateWithReferences(t *testing.T) {
+	var p Positions
+	nodes := parse(t, &p, "<div>\n<p a:assign=\"a = &quot;x&quot; +\">\n  &amp;y z</p></div>")
+	para := nodes[0].FirstChild.NextSibling
+
+	d := p.Locate("a.askew", &NodeError{Node: para, Err: &AttrError{
+		Key: "a:assign", Value: `a = "x" +`, Offset: 8, Err: errors.New("bad")}})
+	if d.Error() != "a.askew:2:32: bad" {
+		t.Errorf("unexpected attribute diagnostic: %s", d)
+	}
+
+	d = p.Locate("a.askew", &NodeError{Node: para, Err: &TextError{
+		Text: "\n  &y z", Offset: 6, Err: errors.New(": bad text")}})
+	if d.Error() != "a.askew:3:10: bad text" {
+		t.Errorf("unexpected text diagnostic: %s", d)
+	}
+
+	// values that have been changed after parsing are located as given.
+	d = p.Locate("a.askew", &NodeError{Node: para, Err: &AttrError{
+		Key: "a:assign", Value: "b = 1", Offset: 4, Err: errors.New("changed")}})
+	if d.Error() != "a.askew:2:18: changed" {
+		t.Errorf("unexpected diagnostic for changed value: %s", d)
+	}
+}
+
+func TestDiscard(t *testing.T) {
+	var p Positions
+	a := parse(t, &p, "<div></div>")
+	nodes, err := html.ParseFragment(bytes.NewReader([]byte("<p></p>")), &BodyEnv)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p.Record("b.askew", []byte("<p></p>"), nodes...)
+	p.Discard("a.askew")
+	if _, ok := p.Node(a[0]); ok {
+		t.Error("position of discarded file still known")
+	}
+	if pos, ok := p.Node(nodes[0]); !ok || pos.File != "b.askew" {
+		t.Errorf("lost position of other file: %v (%v)", pos, ok)
+	}
+}
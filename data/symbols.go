@@ -13,7 +13,14 @@ type Unit struct {
 	Block
 
 	Variables []VariableMapping
-	Embeds    []Embed
+	// Observed are the values bound to an observable with `<-` or `<->`.
+	// Their expression yields the observable.
+	Observed []Assignment
+	// Live are the elements with a:if.live.
+	Live []LiveIf
+	// LiveFor are the elements with a:for.live.
+	LiveFor []*LiveFor
+	Embeds  []Embed
 }
 
 // OutsideModuleErr is an error that is returned when trying to resolve a
@@ -26,6 +33,18 @@ func (e OutsideModuleErr) Error() string {
 	return "cannot use controls from import path " + e.Path + " which is outside current module"
 }
 
+// UnknownSymbolErr is an error that is returned when a symbol cannot be
+// resolved.
+type UnknownSymbolErr struct {
+	Kind, ID string
+	// FollowUp is true if the symbol may be missing because of previous errors.
+	FollowUp bool
+}
+
+func (e UnknownSymbolErr) Error() string {
+	return "unknown " + e.Kind + ": '" + e.ID + "'"
+}
+
 // Symbols is the context of the procesor. It stores all seen symbols along
 // with the packages they are declared in.
 type Symbols struct {
@@ -108,7 +127,7 @@ func (s *Symbols) ResolveMacro(id string) (Macro, error) {
 		}
 	}
 
-	return Macro{}, fmt.Errorf("unknown macro: '%s'", id)
+	return Macro{}, UnknownSymbolErr{Kind: "macro", ID: id, FollowUp: pkg.HasFailed(name)}
 }
 
 // ResolveComponent resolves the given identifier to a Component.
@@ -125,5 +144,5 @@ func (s *Symbols) ResolveComponent(id string) (
 		}
 	}
 
-	return nil, symName, aliasName, fmt.Errorf("unknown component: '%s'", id)
+	return nil, symName, aliasName, UnknownSymbolErr{Kind: "component", ID: id, FollowUp: pkg.HasFailed(symName)}
 }
@@ -12,4 +12,9 @@ type VariableMapping struct {
 	Variable GoValue
 	Value    BoundValue
 	Path     []int
+	// Loops is the number of a:for the variable is inside. If it is not 0, the
+	// variable is a slice with one item for each iteration of the outermost
+	// loop, nested as often as there are loops. The innermost slices hold the
+	// values of the elements generated by the innermost loop.
+	Loops int
 }
@@ -0,0 +1,181 @@
+package main
+
+import (
+	"fmt"
+	"strings"
+)
+
+// number of unchanged lines shown around each change in a diff.
+const diffContext = 3
+
+type editKind byte
+
+const (
+	editKeep   editKind = ' '
+	editDelete editKind = '-'
+	editInsert editKind = '+'
+)
+
+type edit struct {
+	kind editKind
+	line string
+}
+
+func splitLines(s string) []string {
+	ret := strings.SplitAfter(s, "\n")
+	if ret[len(ret)-1] == "" {
+		ret = ret[:len(ret)-1]
+	}
+	return ret
+}
+
+// diffLines calculates a shortest edit script transforming a into b with
+// the algorithm by Eugene W. Myers.
+func diffLines(a, b []string) []edit {
+	n, m := len(a), len(b)
+	// v[k+offset] holds the furthest x reached on diagonal k.
+	offset := n + m + 1
+	v := make([]int, 2*offset+1)
+	// trace[d] holds the diagonals -d-1 .. d+1 of v before step d.
+	var trace [][]int
+	x, y := 0, 0
+search:
+	for d := 0; d <= n+m; d++ {
+		trace = append(trace, append([]int(nil), v[offset-d-1:offset+d+2]...))
+		for k := -d; k <= d; k += 2 {
+			if k == -d || (k != d && v[offset+k-1] < v[offset+k+1]) {
+				x = v[offset+k+1]
+			} else {
+				x = v[offset+k-1] + 1
+			}
+			y = x - k
+			for x < n && y < m && a[x] == b[y] {
+				x++
+				y++
+			}
+			v[offset+k] = x
+			if x >= n && y >= m {
+				break search
+			}
+		}
+	}
+
+	var reversed []edit
+	for d := len(trace) - 1; d > 0; d-- {
+		prev := trace[d]
+		k := x - y
+		var prevK int
+		if k == -d || (k != d && prev[k-1+d+1] < prev[k+1+d+1]) {
+			prevK = k + 1
+		} else {
+			prevK = k - 1
+		}
+		prevX := prev[prevK+d+1]
+		prevY := prevX - prevK
+		for x > prevX && y > prevY {
+			reversed = append(reversed, edit{editKeep, a[x-1]})
+			x--
+			y--
+		}
+		if x == prevX {
+			reversed = append(reversed, edit{editInsert, b[y-1]})
+			y--
+		} else {
+			reversed = append(reversed, edit{editDelete, a[x-1]})
+			x--
+		}
+	}
+	for x > 0 && y > 0 {
+		reversed = append(reversed, edit{editKeep, a[x-1]})
+		x--
+		y--
+	}
+
+	ret := make([]edit, len(reversed))
+	for i := range reversed {
+		ret[i] = reversed[len(reversed)-1-i]
+	}
+	return ret
+}
+
+// unifiedDiff returns a diff in unified format that transforms the content
+// old of the file at path into the content new. Returns the empty string if
+// old and new are equal.
+func unifiedDiff(path string, old, new string) string {
+	if old == new {
+		return ""
+	}
+	edits := diffLines(splitLines(old), splitLines(new))
+
+	var b strings.Builder
+	b.WriteString("--- a/" + path + "\n+++ b/" + path + "\n")
+	// line numbers in old and new at the current edit.
+	oldLine, newLine := 0, 0
+	for i := 0; i < len(edits); {
+		if edits[i].kind == editKeep {
+			oldLine++
+			newLine++
+			i++
+			continue
+		}
+		// found a change, collect the hunk around it.
+		start := i - diffContext
+		if start < 0 {
+			start = 0
+		}
+		// the hunk continues as long as changes are close enough to share
+		// their context lines.
+		last := i
+		for j := i + 1; j < len(edits) && j <= last+2*diffContext; j++ {
+			if edits[j].kind != editKeep {
+				last = j
+			}
+		}
+		end := last + 1 + diffContext
+		if end > len(edits) {
+			end = len(edits)
+		}
+
+		oldStart, newStart := oldLine-(i-start), newLine-(i-start)
+		oldLen, newLen := 0, 0
+		for _, e := range edits[start:end] {
+			if e.kind != editInsert {
+				oldLen++
+			}
+			if e.kind != editDelete {
+				newLen++
+			}
+		}
+		fmt.Fprintf(&b, "@@ -%s +%s @@\n", hunkRange(oldStart, oldLen),
+			hunkRange(newStart, newLen))
+		for _, e := range edits[start:end] {
+			b.WriteByte(byte(e.kind))
+			b.WriteString(e.line)
+			if !strings.HasSuffix(e.line, "\n") {
+				b.WriteString("\n\\ No newline at end of file\n")
+			}
+		}
+		for _, e := range edits[i:end] {
+			if e.kind != editInsert {
+				oldLine++
+			}
+			if e.kind != editDelete {
+				newLine++
+			}
+		}
+		i = end
+	}
+	return b.String()
+}
+
+// hunkRange formats a range of lines for a hunk header. start is zero-based.
+func hunkRange(start, length int) string {
+	if length == 0 {
+		// an empty range is denoted by the line before it.
+		return fmt.Sprintf("%d,0", start)
+	}
+	if length == 1 {
+		return fmt.Sprintf("%d", start+1)
+	}
+	return fmt.Sprintf("%d,%d", start+1, length)
+}
@@ -0,0 +1,106 @@
+package main
+
+import (
+	"io/ioutil"
+	"path/filepath"
+	"testing"
+
+	"github.com/flyx/askew/output"
+)
+
+func TestDiffLines(t *testing.T) {
+	for _, c := range []struct {
+		a, b, script string
+	}{
+		{"", "", ""},
+		{"a\nb\n", "a\nb\n", "  "},
+		{"", "a\n", "+"},
+		{"a\n", "", "-"},
+		{"a\nb\nc\n", "a\nc\n", " - "},
+		{"a\nc\n", "a\nb\nc\n", " + "},
+		{"a\nb\nc\n", "a\nx\nc\n", " -+ "},
+		{"a\nb\nc\nd\n", "b\nc\nd\ne\n", "-   +"},
+	} {
+		edits := diffLines(splitLines(c.a), splitLines(c.b))
+		script := make([]byte, len(edits))
+		var a, b string
+		for i, e := range edits {
+			script[i] = byte(e.kind)
+			if e.kind != editInsert {
+				a += e.line
+			}
+			if e.kind != editDelete {
+				b += e.line
+			}
+		}
+		if string(script) != c.script {
+			t.Errorf("%q -> %q: expected edits %q, got %q", c.a, c.b, c.script, script)
+		}
+		if a != c.a || b != c.b {
+			t.Errorf("%q -> %q: edits yield %q -> %q", c.a, c.b, a, b)
+		}
+	}
+}
+
+func TestUnifiedDiff(t *testing.T) {
+	if d := unifiedDiff("x.go", "a\n", "a\n"); d != "" {
+		t.Errorf("expected no diff for equal content, got %q", d)
+	}
+	old := "1\n2\n3\n4\n5\n6\n7\n8\n9\n10\n11\n12\n13\n14\n15\n16\n"
+	new := "1\n2\n3\n4\n5\nsix\n7\n8\n9\n10\n11\n12\n13\n14\n15\n16\n17"
+	expected := `--- a/x.go
++++ b/x.go
+@@ -3,7 +3,7 @@
+ 3
+ 4
+ 5
+-6
++six
+ 7
+ 8
+ 9
+@@ -14,3 +14,4 @@
+ 14
+ 15
+ 16
++17
+\ No newline at end of file
+`
+	if d := unifiedDiff("x.go", old, new); d != expected {
+		t.Errorf("unexpected diff:\n%s", d)
+	}
+	expected = "--- a/x.go\n+++ b/x.go\n@@ -0,0 +1 @@\n+a\n"
+	if d := unifiedDiff("x.go", "", "a\n"); d != expected {
+		t.Errorf("unexpected diff for new file:\n%s", d)
+	}
+}
+
+func TestDiffMode(t *testing.T) {
+	inProject(t, validProject, func(dir string) {
+		p, order := newProcessor(t, writeMode)
+		if !p.generate(order, dir, output.WasmBackend) {
+			t.Fatalf("generate failed: %q", diagnostics(&p.syms.Diagnostics))
+		}
+
+		p, order = newProcessor(t, diffMode)
+		if !p.generate(order, dir, output.WasmBackend) || p.stale != 0 {
+			t.Fatalf("up-to-date files reported as stale: %d", p.stale)
+		}
+
+		path := filepath.Join("ui", "a.askew.go")
+		if err := ioutil.WriteFile(path, []byte("package ui\n"), 0644); err != nil {
+			t.Fatal(err)
+		}
+		p, order = newProcessor(t, diffMode)
+		if p.generate(order, dir, output.WasmBackend) || p.stale != 1 {
+			t.Errorf("expected one stale file, got %d", p.stale)
+		}
+		content, err := ioutil.ReadFile(path)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if string(content) != "package ui\n" {
+			t.Error("diff mode modified a generated file")
+		}
+	})
+}
@@ -8,7 +8,8 @@ require (
 	github.com/pointlander/compress v1.1.0 // indirect
 	github.com/pointlander/jetset v1.0.0 // indirect
 	github.com/pointlander/peg v1.0.0 // indirect
-	golang.org/x/mod v0.3.0
+	golang.org/x/mod v0.14.0
+	golang.org/x/tools v0.17.0
 	golang.org/x/xerrors v0.0.0-20191204190536-9bdfabe68543 // indirect
 	gopkg.in/yaml.v3 v3.0.0-20210107192922-496545a6307b
 )
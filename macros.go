@@ -15,10 +15,11 @@ type macroDiscovery struct {
 
 func (md *macroDiscovery) Process(n *html.Node) (descend bool, replacement *html.Node, err error) {
 	name := attributes.Val(n.Attr, "name")
+	pkg, _ := md.syms.Packages[md.syms.CurPkg]
 	if name == "" {
+		pkg.MarkFailed("")
 		return false, nil, errors.New(": attribute `name` missing")
 	}
-	pkg, _ := md.syms.Packages[md.syms.CurPkg]
 	for _, file := range pkg.Files {
 		_, ok := file.Macros[name]
 		if ok {
@@ -32,6 +33,7 @@ func (md *macroDiscovery) Process(n *html.Node) (descend bool, replacement *html
 
 	first, last, err := w.WalkChildren(n, &walker.Siblings{Cur: n.FirstChild})
 	if err != nil {
+		pkg.MarkFailed(name)
 		return false, nil, err
 	}
 	curFile := md.syms.CurAskewFile()
@@ -73,7 +75,7 @@ func (ip *includeProcessor) Process(n *html.Node) (descend bool, replacement *ht
 	}
 	m, err := ip.syms.ResolveMacro(name)
 	if err != nil {
-		return false, nil, errors.New(": failed to process: " + err.Error())
+		return false, nil, attributes.ValueError("name", name, err)
 	}
 
 	vm := valueMapper{slots: m.Slots, values: make([]*html.Node, len(m.Slots)),
@@ -85,7 +87,7 @@ func (ip *includeProcessor) Process(n *html.Node) (descend bool, replacement *ht
 	}
 
 	instantiator := macroInstantiator{
-		slots: m.Slots, values: vm.values}
+		slots: m.Slots, values: vm.values, positions: &ip.syms.Positions}
 	ec := elmCopier{&instantiator}
 	instantiator.w =
 		walker.Walker{TextNode: textCopier{}, StdElements: &ec, Text: &ec,
@@ -138,9 +140,10 @@ func (vm *valueMapper) Process(n *html.Node) (descend bool, replacement *html.No
 }
 
 type macroInstantiator struct {
-	slots  []data.Slot
-	values []*html.Node
-	w      walker.Walker
+	slots     []data.Slot
+	values    []*html.Node
+	w         walker.Walker
+	positions *data.Positions
 }
 
 type textCopier struct{}
@@ -158,6 +161,7 @@ func (ec *elmCopier) Process(n *html.Node) (descend bool, replacement *html.Node
 	replacement = &html.Node{
 		Type: n.Type, DataAtom: n.DataAtom, Data: n.Data, Namespace: n.Namespace,
 		Attr: append([]html.Attribute(nil), n.Attr...)}
+	ec.mi.positions.Copy(n, replacement)
 	replacement.FirstChild, replacement.LastChild, err = ec.mi.w.WalkChildren(
 		replacement, &walker.Siblings{Cur: n.FirstChild})
 	return
@@ -191,7 +195,7 @@ type unitDescender struct {
 
 func (cd *unitDescender) Process(n *html.Node) (descend bool, replacement *html.Node, err error) {
 	w := walker.Walker{TextNode: walker.Allow{}, StdElements: walker.Allow{}, Include: &includeProcessor{cd.syms},
-		Handlers: walker.Allow{}, Controller: walker.Allow{}, Data: walker.Allow{},
+		Handlers: walker.Allow{}, Events: walker.Allow{}, Controller: walker.Allow{}, Data: walker.Allow{},
 		Embed: walker.Allow{}, Construct: walker.Allow{}, Text: walker.Allow{}}
 	n.FirstChild, n.LastChild, err = w.WalkChildren(n, &walker.Siblings{Cur: n.FirstChild})
 	return false, nil, err
@@ -203,7 +207,10 @@ func (importRemover) Process(n *html.Node) (descend bool, replacement *html.Node
 	return false, &html.Node{Type: html.TextNode}, nil
 }
 
-func processMacros(nodes []*html.Node, syms *data.Symbols) (dummyParent *html.Node, err error) {
+// processMacros discovers the macros in the given nodes and processes all
+// includes. onError is called for each top-level node that failed.
+func processMacros(nodes []*html.Node, syms *data.Symbols,
+	onError func(err error)) (dummyParent *html.Node, err error) {
 	dummyParent = &html.Node{Type: html.ElementNode}
 	if len(nodes) > 0 {
 		dummyParent.FirstChild = nodes[0]
@@ -212,7 +219,8 @@ func processMacros(nodes []*html.Node, syms *data.Symbols) (dummyParent *html.No
 			Component: &unitDescender{syms: syms},
 			Site:      &unitDescender{syms: syms},
 			Macro:     &macroDiscovery{syms: syms},
-			Import:    importRemover{}}
+			Import:    importRemover{},
+			OnError:   onError}
 		_, _, err = w.WalkChildren(dummyParent, &walker.NodeSlice{Items: nodes})
 	}
 	return
@@ -7,6 +7,7 @@ import (
 	"path/filepath"
 	"strings"
 
+	"github.com/flyx/askew/data"
 	"github.com/flyx/askew/output"
 	"github.com/flyx/askew/packages"
 
@@ -23,7 +24,11 @@ func main() {
 			"relative to the directory given at command line, or to cwd if no directory is given.")
 	backendOpt := getopt.StringLong(
 		"backend", 'b', "gopherjs", "backend to use; either `gopherjs` (default) or `wasm`")
-	data := getopt.StringLong("data", 'd', "", "path to a data file to use for *.askew.tmpl / *.asite.tmpl files")
+	dataPath := getopt.StringLong("data", 'd', "", "path to a data file to use for *.askew.tmpl / *.asite.tmpl files")
+	watchOpt := getopt.BoolLong("watch", 'w', "keep running and regenerate packages whose files change")
+	checkOpt := getopt.BoolLong("check", 'c', "only check the input for errors, do not write any files")
+	diffOpt := getopt.BoolLong("diff", 0, "do not write any files, print the differences between the generated code and the existing files")
+	noTypeCheckOpt := getopt.BoolLong("no-typecheck", 0, "do not type-check the generated code")
 	getopt.Parse()
 	var err error
 	outputDirPath, err := filepath.Abs(*outputOpt)
@@ -45,18 +50,26 @@ func main() {
 		os.Exit(1)
 	}
 
-	info, err := os.Stat(*outputOpt)
-	if err != nil {
-		if os.IsNotExist(err) {
-			err = os.MkdirAll(*outputOpt, os.ModePerm)
-			if err != nil {
-				panic("unable to create output directory " + *outputOpt)
+	if *checkOpt && *diffOpt {
+		os.Stdout.WriteString("[error] --check and --diff are mutually exclusive\n")
+		os.Exit(1)
+	}
+
+	// the output directory is not written to when only checking or comparing.
+	if !*checkOpt && !*diffOpt {
+		info, err := os.Stat(*outputOpt)
+		if err != nil {
+			if os.IsNotExist(err) {
+				err = os.MkdirAll(*outputOpt, os.ModePerm)
+				if err != nil {
+					panic("unable to create output directory " + *outputOpt)
+				}
+			} else {
+				panic("unable to access output directory " + *outputOpt)
 			}
-		} else {
-			panic("unable to access output directory " + *outputOpt)
+		} else if !info.IsDir() {
+			panic("output path is not a directory: " + *outputOpt)
 		}
-	} else if !info.IsDir() {
-		panic("output path is not a directory: " + *outputOpt)
 	}
 
 	var backend output.Backend
@@ -70,14 +83,18 @@ func main() {
 	}
 
 	var loadedData interface{}
-	if *data != "" {
-		raw, err := ioutil.ReadFile(*data)
-		if err != nil {
+	if *dataPath != "" {
+		if loadedData, err = loadData(*dataPath); err != nil {
 			fmt.Printf("[error] %v\n", err.Error())
 			os.Exit(1)
 		}
-		if err = yaml.Unmarshal(raw, &loadedData); err != nil {
-			fmt.Printf("[error] %v\n", err.Error())
+	}
+
+	var snapshot packages.Snapshot
+	if *watchOpt {
+		// taken before discovery so that changes during processing are seen.
+		if snapshot, err = packages.TakeSnapshot(*excludes); err != nil {
+			os.Stdout.WriteString("[error] " + err.Error() + "\n")
 			os.Exit(1)
 		}
 	}
@@ -95,22 +112,42 @@ func main() {
 
 	var p processor
 	p.init(base)
-	for _, path := range order {
-		if err := p.processMacros(path); err != nil {
-			os.Stdout.WriteString("[error] " + err.Error() + "\n")
-			os.Exit(1)
-		}
+	p.typeCheck = !*noTypeCheckOpt
+	if *checkOpt {
+		p.mode = checkMode
+	} else if *diffOpt {
+		p.mode = diffMode
 	}
-	for _, path := range order {
-		if err := p.processComponents(path); err != nil {
-			os.Stdout.WriteString("[error] " + err.Error() + "\n")
-			os.Exit(1)
-		}
+	ok := p.generate(order, outputDirPath, backend)
+	if *watchOpt {
+		w := watcher{p: &p, excludes: *excludes, dataPath: *dataPath,
+			data: loadedData, snapshot: snapshot}
+		w.run(outputDirPath, backend)
+	} else if !ok {
+		os.Exit(1)
 	}
+}
 
-	os.Stdout.WriteString("[info] generating code\n")
-	if err := p.dump(outputDirPath, backend); err != nil {
-		os.Stdout.WriteString("[error] " + err.Error() + "\n")
-		os.Exit(1)
+func loadData(path string) (interface{}, error) {
+	raw, err := ioutil.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var ret interface{}
+	if err = yaml.Unmarshal(raw, &ret); err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+func reportDiagnostics(d *data.Diagnostics) {
+	items := d.Sorted()
+	for _, item := range items {
+		fmt.Fprintf(os.Stderr, "[error] %s\n", item.Error())
+	}
+	if len(items) == 1 {
+		fmt.Fprintln(os.Stderr, "[error] 1 error")
+	} else {
+		fmt.Fprintf(os.Stderr, "[error] %d errors\n", len(items))
 	}
 }
@@ -0,0 +1,129 @@
+package main
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/flyx/askew/data"
+	"github.com/flyx/askew/packages"
+)
+
+// inProject creates a temporary Go module containing the given files,
+// changes into its directory while running fn and removes it afterwards.
+func inProject(t *testing.T, files map[string]string, fn func(dir string)) {
+	t.Helper()
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir, err := ioutil.TempDir("", "askew")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	writeFiles(t, dir, map[string]string{
+		"go.mod": "module example.com/proj\n\ngo 1.12\n"})
+	writeFiles(t, dir, files)
+	if err = os.Chdir(dir); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Chdir(wd)
+	fn(dir)
+}
+
+func writeFiles(t *testing.T, dir string, files map[string]string) {
+	t.Helper()
+	for path, content := range files {
+		path = filepath.Join(dir, filepath.FromSlash(path))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+}
+
+// withRuntime adds a go.mod and go.sum to files which make the askew module
+// of the working tree available, so that the generated code can be
+// type-checked.
+func withRuntime(t *testing.T, files map[string]string) map[string]string {
+	t.Helper()
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	sum, err := ioutil.ReadFile("go.sum")
+	if err != nil {
+		t.Fatal(err)
+	}
+	files["go.mod"] = "module example.com/proj\n\ngo 1.12\n\n" +
+		"require github.com/flyx/askew v0.0.0\n\n" +
+		"replace github.com/flyx/askew => " + filepath.ToSlash(wd) + "\n"
+	files["go.sum"] = string(sum)
+	return files
+}
+
+// newProcessor discovers the packages in the cwd and returns a processor for
+// them along with the order in which they must be generated.
+func newProcessor(t *testing.T, m mode) (*processor, []string) {
+	t.Helper()
+	base, err := packages.Discover(nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	order, err := packages.Sort(base.ImportPath, base.Packages)
+	if err != nil {
+		t.Fatal(err)
+	}
+	p := &processor{mode: m}
+	p.init(base)
+	return p, order
+}
+
+// diagnostics returns the reported diagnostics as strings.
+func diagnostics(d *data.Diagnostics) []string {
+	var ret []string
+	for _, item := range d.Sorted() {
+		ret = append(ret, item.Error())
+	}
+	return ret
+}
+
+func expectDiagnostics(t *testing.T, d *data.Diagnostics, expected ...string) {
+	t.Helper()
+	actual := diagnostics(d)
+	if len(actual) != len(expected) {
+		t.Fatalf("expected %d diagnostics, got %d: %q", len(expected), len(actual), actual)
+	}
+	for i := range expected {
+		if actual[i] != expected[i] {
+			t.Errorf("diagnostic %d: expected %q, got %q", i, expected[i], actual[i])
+		}
+	}
+}
+
+func TestReportDiagnostics(t *testing.T) {
+	r, w, err := os.Pipe()
+	if err != nil {
+		t.Fatal(err)
+	}
+	stderr := os.Stderr
+	os.Stderr = w
+	var d data.Diagnostics
+	d.Add(&data.Diagnostic{Pos: data.Position{File: "b.askew", Line: 1, Column: 1}, Msg: "b"})
+	d.Add(&data.Diagnostic{Pos: data.Position{File: "a.askew", Line: 2, Column: 3}, Msg: "a"})
+	reportDiagnostics(&d)
+	os.Stderr = stderr
+	w.Close()
+	out, err := ioutil.ReadAll(r)
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := "[error] a.askew:2:3: a\n[error] b.askew:1:1: b\n[error] 2 errors\n"
+	if string(out) != expected {
+		t.Errorf("expected output %q, got %q", expected, out)
+	}
+}
@@ -1,60 +1,36 @@
 package output
 
 import (
-	"bytes"
-	"go/build"
-	"io"
-	"io/ioutil"
-	"log"
+	"errors"
+	"go/parser"
+	"go/token"
 	"os"
-	"os/exec"
-	"path/filepath"
-)
 
-var goimportsPath = filepath.Join(build.Default.GOPATH, "bin", "goimports")
+	"golang.org/x/tools/imports"
+)
 
-func init() {
-	info, err := os.Stat(goimportsPath)
-	if os.IsNotExist(err) {
-		panic("`goimports` missing, please install via `go get golang.org/x/tools/cmd/goimports`")
+// formatCode adds missing imports to and removes unused imports from the
+// given Go code, which will be written to path, and formats it.
+// Like goimports, this resolves the actual names of imported packages and
+// finds missing packages in the standard library and the dependencies of the
+// module containing path.
+func formatCode(path, goCode string) ([]byte, error) {
+	if _, err := parser.ParseFile(token.NewFileSet(), "", goCode, 0); err != nil {
+		return nil, errors.New("generated invalid Go code: " + err.Error())
 	}
+	formatted, err := imports.Process(path, []byte(goCode),
+		&imports.Options{Comments: true, TabIndent: true, TabWidth: 8})
 	if err != nil {
-		panic("failed to access `goimports`: " + err.Error())
-	}
-	if !info.Mode().IsRegular() {
-		panic("`goimports` is not a regular file")
+		return nil, errors.New("failed to format Go code: " + err.Error())
 	}
+	return formatted, nil
 }
 
-func writeFormatted(goCode string, file string) {
-	fmtcmd := exec.Command(goimportsPath)
-
-	var stdout bytes.Buffer
-	var stderr bytes.Buffer
-	fmtcmd.Stdout = &stdout
-	fmtcmd.Stderr = &stderr
-
-	stdin, err := fmtcmd.StdinPipe()
+// writeFormatted formats the given Go code and writes it to the file at path.
+func (pw *PackageWriter) writeFormatted(goCode string, path string) error {
+	formatted, err := formatCode(path, goCode)
 	if err != nil {
-		panic("unable to create stdin pipe: " + err.Error())
-	}
-	err = fmtcmd.Start()
-	if err != nil {
-		panic("unable to start goimports process for formatting the code: " + err.Error())
-	}
-	io.WriteString(stdin, goCode)
-	stdin.Close()
-
-	if err := fmtcmd.Wait(); err != nil {
-		log.Println("error while formatting: " + err.Error())
-		log.Println("stderr output:")
-		log.Println(stderr.String())
-		log.Println("input:")
-		log.Println(goCode)
-		panic("failed to format Go code")
-	}
-
-	if err := ioutil.WriteFile(file, stdout.Bytes(), os.ModePerm); err != nil {
-		panic("failed to write file '" + file + "': " + err.Error())
+		return errors.New(path + ": " + err.Error())
 	}
+	return pw.write(path, formatted, os.ModePerm)
 }
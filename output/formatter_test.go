@@ -0,0 +1,163 @@
+package output
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+// inModule creates a temporary Go module containing the given files and
+// changes into its directory while running fn.
+func inModule(t *testing.T, files map[string]string, fn func()) {
+	t.Helper()
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir, err := ioutil.TempDir("", "askew")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	files["go.mod"] = "module example.com/proj\n\ngo 1.12\n"
+	for path, content := range files {
+		path = filepath.Join(dir, filepath.FromSlash(path))
+		if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err := ioutil.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err = os.Chdir(dir); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Chdir(wd)
+	fn()
+}
+
+type formatCase struct{ name, input, expected string }
+
+func expectFormatted(t *testing.T, path string, cases []formatCase) {
+	t.Helper()
+	for _, c := range cases {
+		formatted, err := formatCode(path, c.input)
+		if err != nil {
+			t.Errorf("%s: %v", c.name, err)
+		} else if string(formatted) != c.expected {
+			t.Errorf("%s: unexpected result:\n%s", c.name, formatted)
+		}
+	}
+}
+
+func TestFormatCode(t *testing.T) {
+	expectFormatted(t, "p.askew.go", []formatCase{
+		{"unused imports", `package p
+
+import (
+	"fmt"
+	"strings"
+	"syscall/js"
+	y "gopkg.in/yaml.v3"
+)
+
+func f(s fmt.Stringer) { var v js.Value; _ = v }
+`, `package p
+
+import (
+	"fmt"
+	"syscall/js"
+)
+
+func f(s fmt.Stringer) { var v js.Value; _ = v }
+`},
+		{"kept imports", `package p
+
+import (
+	_ "embed"
+	. "math"
+	y "gopkg.in/yaml.v3"
+)
+
+var x, z = y.Marshal, Pi
+`, `package p
+
+import (
+	_ "embed"
+	. "math"
+
+	y "gopkg.in/yaml.v3"
+)
+
+var x, z = y.Marshal, Pi
+`},
+		{"missing imports", `package p
+
+import "fmt"
+
+var s = fmt.Sprint(strconv.Itoa(1))
+`, `package p
+
+import (
+	"fmt"
+	"strconv"
+)
+
+var s = fmt.Sprint(strconv.Itoa(1))
+`},
+		{"shadowed package name", `package p
+
+import "strings"
+
+func f(strings struct{ Builder int }) int { return strings.Builder }
+`, `package p
+
+func f(strings struct{ Builder int }) int { return strings.Builder }
+`},
+		{"formatting", "package p\nfunc  f( ) {\nreturn}\n", "package p\n\nfunc f() {\n\treturn\n}\n"},
+	})
+}
+
+func TestFormatCodePackageNames(t *testing.T) {
+	inModule(t, map[string]string{
+		"helpers/help.go":  "package help\n\nconst X = 1\n",
+		"go-tool/tool.go":  "package gotool\n\nconst Y = 2\n",
+		"ui/controller.go": "package ui\n",
+	}, func() {
+		expectFormatted(t, "ui/ui.askew.go", []formatCase{
+			{"package name differs from path", `package ui
+
+import (
+	"example.com/proj/go-tool"
+	"example.com/proj/helpers"
+)
+
+var v = help.X + gotool.Y
+`, `package ui
+
+import (
+	gotool "example.com/proj/go-tool"
+	help "example.com/proj/helpers"
+)
+
+var v = help.X + gotool.Y
+`},
+			{"missing import from module", `package ui
+
+var v = help.X
+`, `package ui
+
+import help "example.com/proj/helpers"
+
+var v = help.X
+`},
+		})
+	})
+}
+
+func TestFormatCodeError(t *testing.T) {
+	if _, err := formatCode("p.askew.go", "package p\nfunc {"); err == nil {
+		t.Error("expected an error for invalid code")
+	}
+}
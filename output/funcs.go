@@ -3,6 +3,7 @@ package output
 import (
 	"strconv"
 	"strings"
+	"time"
 
 	"github.com/flyx/askew/data"
 	"github.com/flyx/net/html"
@@ -24,6 +25,8 @@ func nameForBound(b data.BoundKind) string {
 		return "BoundFormValue"
 	case data.BoundEventValue:
 		return "BoundEventValue"
+	case data.BoundEventDetail:
+		return "BoundEventDetail"
 	default:
 		panic("unknown boundKind")
 	}
@@ -44,6 +47,19 @@ func last(path []int) int {
 	return path[len(path)-1]
 }
 
+// hydrateName returns the name of the hydrate func that corresponds to the
+// given name of a new func, which may be qualified.
+func hydrateName(newName string) string {
+	i := strings.LastIndexByte(newName, '.') + 1
+	switch {
+	case strings.HasPrefix(newName[i:], "New"):
+		return newName[:i] + "Hydrate" + newName[i+3:]
+	case strings.HasPrefix(newName[i:], "new"):
+		return newName[:i] + "hydrate" + newName[i+3:]
+	}
+	panic("not the name of a new func: " + newName)
+}
+
 func wrapperForType(t data.ParamType) string {
 	switch t.Kind {
 	case data.StringType:
@@ -64,6 +80,9 @@ func fieldType(e data.Embed) string {
 		case data.OptionalEmbed:
 			return "askew.GenericOptional"
 		case data.ListEmbed:
+			if e.Virtual {
+				return "askew.VirtualList"
+			}
 			return "askew.GenericList"
 		default:
 			panic("unexpected field type")
@@ -76,6 +95,8 @@ func fieldType(e data.Embed) string {
 	}
 	if e.Kind == data.OptionalEmbed {
 		b.WriteString("Optional")
+	} else if e.Virtual {
+		b.WriteString("Virtual")
 	}
 	b.WriteString(e.T)
 	if e.Kind == data.ListEmbed {
@@ -97,3 +118,165 @@ func renderTemplateHTML(n *html.Node) string {
 	}
 	return ret.String()
 }
+
+// genArg returns the expression that yields the value of the given capture
+// parameter inside the listener of the event.
+func genArg(p data.BoundParam) string {
+	if p.Value.Kind == data.BoundExpr {
+		return p.Value.IDs[0]
+	}
+	var b strings.Builder
+	b.WriteString("(&")
+	b.WriteString(wrapperForType(*p.Type))
+	b.WriteString("{BoundValue: askew.")
+	b.WriteString(nameForBound(p.Value.Kind))
+	b.WriteString("At(")
+	switch p.Value.Kind {
+	case data.BoundFormValue:
+		b.WriteString(`self.Call("closest", "form"), "`)
+		b.WriteString(p.Value.ID())
+		b.WriteString(`", `)
+		b.WriteString(strconv.FormatBool(p.Value.IsRadio))
+	case data.BoundEventValue, data.BoundEventDetail:
+		b.WriteString(`arguments[0], "`)
+		b.WriteString(p.Value.ID())
+		b.WriteByte('"')
+	default:
+		b.WriteString(`self, "`)
+		b.WriteString(p.Value.ID())
+		b.WriteByte('"')
+	}
+	b.WriteString(")}).Get()")
+	return b.String()
+}
+
+// eventFilter returns the expression creating the runtime's equivalent of the
+// given filter.
+func eventFilter(f *data.EventFilter) string {
+	var items []string
+	if len(f.Keys) > 0 {
+		keys := make([]string, len(f.Keys))
+		for i := range f.Keys {
+			keys[i] = strconv.Quote(f.Keys[i])
+		}
+		items = append(items, "Keys: []string{"+strings.Join(keys, ", ")+"}")
+	}
+	if len(f.Buttons) > 0 {
+		buttons := make([]string, len(f.Buttons))
+		for i := range f.Buttons {
+			buttons[i] = strconv.Itoa(f.Buttons[i])
+		}
+		items = append(items, "Buttons: []int{"+strings.Join(buttons, ", ")+"}")
+	}
+	for _, m := range []struct {
+		name string
+		set  bool
+	}{{"Ctrl", f.Ctrl}, {"Shift", f.Shift}, {"Alt", f.Alt}, {"Meta", f.Meta}} {
+		if m.set {
+			items = append(items, m.name+": true")
+		}
+	}
+	return "&askew.EventFilter{" + strings.Join(items, ", ") + "}"
+}
+
+// blockVariables returns the variables inside the control blocks of b. If
+// several of them have the same name, e.g. in different branches of an a:if,
+// only the first one is returned.
+func blockVariables(b data.Block) []data.VariableMapping {
+	var ret []data.VariableMapping
+	seen := make(map[string]struct{})
+	var collect func(b *data.Block)
+	collect = func(b *data.Block) {
+		for _, v := range b.Variables {
+			if _, ok := seen[v.Variable.Name]; !ok {
+				seen[v.Variable.Name] = struct{}{}
+				ret = append(ret, v)
+			}
+		}
+		for _, cb := range b.Controlled {
+			for _, governed := range cb.Blocks() {
+				collect(&governed.Block)
+			}
+		}
+	}
+	collect(&b)
+	return ret
+}
+
+// blockBinds returns true if there are variables or captures inside the
+// control blocks of b.
+func blockBinds(b data.Block) bool {
+	if len(b.Variables) > 0 || len(b.Captures) > 0 {
+		return true
+	}
+	for _, cb := range b.Controlled {
+		for _, governed := range cb.Blocks() {
+			if blockBinds(governed.Block) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// blockListens returns true if there are captures inside the control blocks
+// of b.
+func blockListens(b data.Block) bool {
+	if len(b.Captures) > 0 {
+		return true
+	}
+	for _, cb := range b.Controlled {
+		for _, governed := range cb.Blocks() {
+			if blockListens(governed.Block) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// slices returns the prefix of the type of a variable inside the given number
+// of loops.
+func slices(loops int) string {
+	return strings.Repeat("[]", loops)
+}
+
+// innermost returns the expression of the slice of the variable with the given
+// name that currently receives the values of the loop nested at the given
+// depth. The outer slices each end with the slice of the current iteration.
+func innermost(name string, depth int) string {
+	ret := "o." + name
+	for i := 1; i < depth; i++ {
+		ret = ret + "[len(" + ret + ")-1]"
+	}
+	return ret
+}
+
+// loopRows returns the slices that get a new item in each iteration of the
+// given ForBlock: Those of the variables nested deeper than the loop.
+func loopRows(cb *data.ControlBlock) []string {
+	var ret []string
+	for _, v := range blockVariables(cb.Block) {
+		if v.Loops > cb.Loops {
+			ret = append(ret, innermost(v.Variable.Name, cb.Loops))
+		}
+	}
+	return ret
+}
+
+// loopVars returns the names of the variables declared by the given ForBlock.
+func loopVars(cb *data.ControlBlock) []string {
+	var ret []string
+	for _, name := range []string{cb.Index, cb.Variable} {
+		if name != "" && name != "_" {
+			ret = append(ret, name)
+		}
+	}
+	return ret
+}
+
+// duration returns an untyped constant for the given duration, so that the
+// generated code does not need to import "time".
+func duration(d time.Duration) string {
+	return strconv.FormatInt(int64(d), 10) + " /* " + d.String() + " */"
+}
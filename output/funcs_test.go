@@ -0,0 +1,12 @@
+package output
+
+import "testing"
+
+func TestInnermost(t *testing.T) {
+	for depth, expected := range []string{"o.X", "o.X", "o.X[len(o.X)-1]",
+		"o.X[len(o.X)-1][len(o.X[len(o.X)-1])-1]"} {
+		if actual := innermost("X", depth); actual != expected {
+			t.Errorf("depth %d: expected %s, got %s", depth, expected, actual)
+		}
+	}
+}
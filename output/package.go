@@ -1,7 +1,9 @@
 package output
 
 import (
+	"bytes"
 	"errors"
+	"io/ioutil"
 	"os"
 	"path/filepath"
 	"strings"
@@ -26,6 +28,16 @@ type PackageWriter struct {
 	Syms        *data.Symbols
 	PackageName string
 	RelPath     string
+	// Write is called with the path and the content of each generated file.
+	// If nil, the files are written to disk.
+	Write func(path string, content []byte) error
+}
+
+func (pw *PackageWriter) write(path string, content []byte, perm os.FileMode) error {
+	if pw.Write != nil {
+		return pw.Write(path, content)
+	}
+	return ioutil.WriteFile(path, content, perm)
 }
 
 // WriteFile writes a file of the package.
@@ -47,9 +59,11 @@ func (pw *PackageWriter) WriteFile(f *data.AskewFile) error {
 	if err := optional.Execute(&b, f); err != nil {
 		return err
 	}
+	if err := virtual.Execute(&b, f); err != nil {
+		return err
+	}
 
-	writeFormatted(b.String(), filepath.Join(pw.RelPath, f.BaseName+".askew.go"))
-	return nil
+	return pw.writeFormatted(b.String(), filepath.Join(pw.RelPath, f.BaseName+".askew.go"))
 }
 
 // WriteSite writes a file init.go in the site's package, and the HTML file
@@ -69,7 +83,10 @@ func (pw *PackageWriter) WriteSite(f *data.ASiteFile, outputPath string,
 		return err
 	}
 
-	writeFormatted(b.String(), filepath.Join(pw.RelPath, f.BaseName+".asite.go"))
+	if err := pw.writeFormatted(b.String(),
+		filepath.Join(pw.RelPath, f.BaseName+".asite.go")); err != nil {
+		return err
+	}
 
 	// HTML file
 	node := f.RootNode()
@@ -132,12 +149,7 @@ func (pw *PackageWriter) WriteSite(f *data.ASiteFile, outputPath string,
 		node.LastChild = node.LastChild.NextSibling
 	}
 
-	htmlFile, err := os.Create(filepath.Join(outputPath, f.HTMLFile))
-	if err != nil {
-		return err
-	}
-	html.Render(htmlFile, f.Document)
-	htmlFile.Close()
-
-	return nil
+	var htmlFile bytes.Buffer
+	html.Render(&htmlFile, f.Document)
+	return pw.write(filepath.Join(outputPath, f.HTMLFile), htmlFile.Bytes(), 0666)
 }
@@ -3,7 +3,6 @@ package output
 import (
 	"fmt"
 	"path/filepath"
-	"strconv"
 	"strings"
 	"text/template"
 
@@ -23,7 +22,7 @@ package {{.PackageName}}
 // Code generated by askew. DO NOT EDIT.
 
 import (
-	"syscall/js"
+	js "github.com/flyx/askew/runtime/dom"
 	{{- range $alias, $path := .Imports }}
 	{{FormatImport $alias $path}}{{ end }}
 )
@@ -34,6 +33,9 @@ var component = template.Must(template.New("component").Funcs(template.FuncMap{
 	"PathItems":    pathItems,
 	"NameForBound": nameForBound,
 	"Last":         last,
+	"EventFilter":  eventFilter,
+	"Duration":     duration,
+	"EmitName":     data.EmitName,
 	"TWrapper": func(t *data.ParamType, name string) string {
 		return wrapperForType(*t) + "{BoundValue: " + name + "}"
 	},
@@ -56,37 +58,11 @@ var component = template.Must(template.New("component").Funcs(template.FuncMap{
 	"GenArgs": func(params []data.BoundParam) string {
 		items := make([]string, 0, len(params))
 		for _, p := range params {
-			if p.Value.Kind == data.BoundExpr {
-				items = append(items, p.Value.IDs[0])
-			} else {
-				var b strings.Builder
-				b.WriteString("(&")
-				b.WriteString(wrapperForType(*p.Type))
-				b.WriteString("{BoundValue: askew.")
-				b.WriteString(nameForBound(p.Value.Kind))
-				b.WriteString("At(")
-				switch p.Value.Kind {
-				case data.BoundFormValue:
-					b.WriteString(`self.Call("closest", "form"), "`)
-					b.WriteString(p.Value.ID())
-					b.WriteString(`", `)
-					b.WriteString(strconv.FormatBool(p.Value.IsRadio))
-				case data.BoundEventValue:
-					b.WriteString(`arguments[0], "`)
-					b.WriteString(p.Value.ID())
-					b.WriteByte('"')
-				default:
-					b.WriteString(`self, "`)
-					b.WriteString(p.Value.ID())
-					b.WriteByte('"')
-				}
-				b.WriteString(")}).Get()")
-
-				items = append(items, b.String())
-			}
+			items = append(items, genArg(p))
 		}
 		return strings.Join(items, ", ")
 	},
+	"GenArg": genArg,
 	"ClassNames": func(list []string) string {
 		var b strings.Builder
 		first := true
@@ -119,7 +95,9 @@ var component = template.Must(template.New("component").Funcs(template.FuncMap{
 	},
 	"NeedsSelf": func(params []data.BoundParam) bool {
 		for _, p := range params {
-			if p.Value.Kind != data.BoundEventValue && p.Value.Kind != data.BoundExpr {
+			switch p.Value.Kind {
+			case data.BoundEventValue, data.BoundEventDetail, data.BoundExpr:
+			default:
 				return true
 			}
 		}
@@ -157,9 +135,18 @@ var component = template.Must(template.New("component").Funcs(template.FuncMap{
 	},
 	"FieldType": fieldType,
 	"BlockNotEmpty": func(b data.Block) bool {
-		return len(b.Assignments) > 0 || len(b.Controlled) > 0
+		return len(b.Assignments) > 0 || len(b.Controlled) > 0 ||
+			len(b.Variables) > 0 || len(b.Captures) > 0
 	},
-	"TemplateHTML": renderTemplateHTML,
+	"BlockVariables": blockVariables,
+	"BlockBinds":     blockBinds,
+	"BlockListens":   blockListens,
+	"LoopVars":       loopVars,
+	"LoopRows":       loopRows,
+	"Innermost":      innermost,
+	"Slices":         slices,
+	"TemplateHTML":   renderTemplateHTML,
+	"HydrateName":    hydrateName,
 }).Option("missingkey=error").Parse(`
 {{- define "Block"}}
   {{- range .Assignments}}
@@ -180,18 +167,86 @@ var component = template.Must(template.New("component").Funcs(template.FuncMap{
 		askew.Assign(tmp, {{.Expression}})
 	}
 	{{- end}}
+	{{- range .Variables}}
+	{{- if IsFormValue .Value.Kind}}
+	αbind(askew.WalkPath(block, {{PathItems .Path .Value.FormDepth}}), func(node js.Value) {
+	{{- else}}
+	αbind(askew.WalkPath(block, {{PathItems .Path 0}}), func(node js.Value) {
+	{{- end}}
+		{{- if IsFormValue .Value.Kind}}
+		tmp := askew.BoundFormValueAt(node, "{{.Value.ID}}", {{.Value.IsRadio}})
+		{{- else if IsClassValue .Value.Kind}}
+		tmp := askew.BoundClassesAt(node, []string{ {{ClassNames .Value.IDs}} })
+		{{- else if IsSelfValue .Value.Kind}}
+		tmp := askew.BoundSelfAt(node)
+		{{- else}}
+		tmp := askew.{{TypeForKind .Value.Kind}}At(node, "{{.Value.ID}}")
+		{{- end}}
+		{{- if .Loops}}
+		{{- $s := Innermost .Variable.Name .Loops}}
+		{{$s}} = append({{$s}}, {{TWrapper .Variable.Type "tmp"}})
+		{{- else}}
+		o.{{.Variable.Name}}.BoundValue = tmp
+		{{- end}}
+	})
+	{{- end}}
+	{{- range .Captures}}
+	αbind(askew.WalkPath(block, {{PathItems .Path 0}}), func(src js.Value) {
+		{{- template "Mappings" .}}
+	})
+	{{- end}}
 
 	{{- range .Controlled}}
 	{{- if eq .Kind 0}}
-	if {{.Expression}} {
-		{{if BlockNotEmpty .Block}}
-		block := askew.WalkPath(block, {{PathItems .Path 0}})
-		{{template "Block" .Block}}
+	{{- $branches := .Branches}}
+	{{- range $i, $b := $branches}}
+	{{- if eq $i 0}}
+	if {{$b.Expression}} {
+	{{- else if $b.Expression}} else if {{$b.Expression}} {
+	{{- else}} else {
+	{{- end}}
+		{{- range $j, $o := $branches}}
+		{{- if ne $i $j}}
+		{{- template "RemoveBranch" $o}}
+		{{- end}}
+		{{- end}}
+		{{- if BlockNotEmpty $b.Block}}
+		block := askew.WalkPath(block, {{PathItems $b.Path 0}})
+		{{- template "Block" $b.Block}}
 		{{- end}}
-	} else {
-		_item := askew.WalkPath(block, {{PathItems .Path 0}})
-		_parent := _item.Get("parentNode")
-		_parent.Call("replaceChild", js.Global().Get("document").Call("createComment", "removed"), _item)
+	}
+	{{- end}}
+	{{- if not .HasElse}} else {
+		{{- range $branches}}
+		{{- template "RemoveBranch" .}}
+		{{- end}}
+	}
+	{{- end}}
+	{{- else if eq .Kind 2}}
+	{{- $cases := .Cases}}
+	switch {{.Expression}} {
+	{{- range $i, $c := $cases}}
+	{{- if $c.Expression}}
+	case {{$c.Expression}}:
+	{{- else}}
+	default:
+	{{- end}}
+		{{- range $j, $o := $cases}}
+		{{- if ne $i $j}}
+		{{- template "RemoveBranch" $o}}
+		{{- end}}
+		{{- end}}
+		{{- if BlockNotEmpty $c.Block}}
+		block := askew.WalkPath(block, {{PathItems $c.Path 0}})
+		{{- template "Block" $c.Block}}
+		{{- end}}
+	{{- end}}
+	{{- if not .HasDefault}}
+	default:
+		{{- range $cases}}
+		{{- template "RemoveBranch" .}}
+		{{- end}}
+	{{- end}}
 	}
 	{{- else }}
 	{
@@ -200,7 +255,18 @@ var component = template.Must(template.New("component").Funcs(template.FuncMap{
 		_next := _orig.Get("nextSibling")
 		_parent.Call("removeChild", _orig)
 		for {{.Index}}{{with .Variable}}, {{.}}{{end}} := range {{.Expression}} {
+			{{- if BlockBinds .Block}}
+			{{- range LoopVars .}}
+			{{.}} := {{.}}
+			_ = {{.}}
+			{{- end}}
+			{{- end}}
 			block := _orig.Call("cloneNode", true)
+			{{- range LoopRows .}}
+			αbind(block, func(js.Value) {
+				{{.}} = append({{.}}, nil)
+			})
+			{{- end}}
 			{{template "Block" .Block}}
 			_parent.Call("insertBefore", block, _next)
 		}
@@ -209,12 +275,94 @@ var component = template.Must(template.New("component").Funcs(template.FuncMap{
 	{{- end}}
 {{- end}}
 
+{{define "RemoveBranch"}}
+		{
+			_item := askew.WalkPath(block, {{PathItems .Path 0}})
+			_parent := _item.Get("parentNode")
+			_parent.Call("replaceChild", js.Global().Get("document").Call("createComment", "removed"), _item)
+		}
+{{- end}}
+
+{{define "Mappings"}}
+	{{- range .Mappings}}
+		{
+			{{- if .Options.Debounce}}
+			αlimit := αcd.Limit({{Duration .Options.Debounce}}, true)
+			{{- else if .Options.Throttle}}
+			αlimit := αcd.Limit({{Duration .Options.Throttle}}, false)
+			{{- end}}
+			wrapper := js.FuncOf(func(this js.Value, arguments []js.Value) interface{} {
+				{{- if .Options.Self}}
+				if !askew.IsOwnEvent(arguments[0]) {
+					return nil
+				}
+				{{- end}}
+				{{- if .Options.StopPropagation}}
+				arguments[0].Call("stopPropagation")
+				{{- end}}
+				{{- if .Options.StopImmediatePropagation}}
+				arguments[0].Call("stopImmediatePropagation")
+				{{- end}}
+				{{- if NeedsSelf .ParamMappings}}
+				self := arguments[0].Get("currentTarget")
+				{{- end}}
+				{{template "callHandler" .}}
+				return nil
+			})
+			{{- if .Options.HasListenerOptions}}
+			αcd.ListenWith(src, "{{.Event}}", wrapper, askew.ListenerOptions{
+				Once: {{.Options.Once}}, Passive: {{.Options.Passive}}, Capture: {{.Options.Capture}}
+				{{- with .Options.Filter}},
+				Filter: {{EventFilter .}}{{end}}})
+			{{- else}}
+			αcd.Listen(src, "{{.Event}}", wrapper)
+			{{- end}}
+		}
+	{{- end}}
+{{- end}}
+
+{{define "Model"}}
+		{{- if IsFormValue .Target.Kind}}
+		o.αcd.Model({{.Expression}}, askew.BoundFormValueAt(node, "{{.Target.ID}}", {{.Target.IsRadio}}), node)
+		{{- else}}
+		o.αcd.Model({{.Expression}}, askew.BoundPropertyAt(node, "{{.Target.ID}}"), node)
+		{{- end}}
+{{- end}}
+
+{{define "ConstructorCalls"}}
+	{{- $e := .}}
+	{{- range .ConstructorCalls}}
+		{{- if eq .Kind 1}}
+		if {{.Expression}} {
+		{{- else if eq .Kind 2}}
+		for {{.Index}}, {{.Variable}} := range {{.Expression}} {
+		{{- end}}
+		{{- if eq $e.Kind 2}}
+		o.{{$e.Field}}.Set(
+		{{- else}}
+		o.{{$e.Field}}.Append(
+		{{- end}}{{with $e.Ns}}{{.}}.{{end}}{{.ConstructorName}}({{.Args.Raw}}))
+		{{- if ne .Kind 0}}
+		}
+		{{- end}}
+	{{- end}}
+{{- end}}
+
 {{define "doCall" -}}
 	o.{{if .FromController}}Controller.{{end}}{{.Handler}}({{GenArgs .ParamMappings}})
 {{- end}}
 
 {{define "callHandler"}}
-	{{- if eq .Handling 0}}
+	{{- if .Options.IsLimited}}
+		{{- /* the arguments are evaluated now, while the event is dispatched. */}}
+		{{- range $i, $p := .ParamMappings}}
+		αarg{{$i}} := {{GenArg $p}}
+		{{- end}}
+		αlimit(func() {
+			go o.{{if .FromController}}Controller.{{end}}{{.Handler}}(
+				{{- range $i, $p := .ParamMappings}}{{if $i}}, {{end}}αarg{{$i}}{{end}})
+		})
+	{{- else if eq .Handling 0}}
 		go {{template "doCall" .}}
 		arguments[0].Call("preventDefault")
 	{{- else if eq .Handling 2}}
@@ -246,6 +394,9 @@ func init() {
 // {{.Name}} is a DOM component autogenerated by Askew
 type {{.Name}} struct {
 	αcd askew.ComponentData
+	{{- range $i, $f := .LiveFor}}
+	αf{{$i}} askew.LiveFor
+	{{- end}}
 	{{- if .Controller }}
 	// Controller is the adapter for events generated from this component.
 	// if nil, events that would be passed to the controller will not be handled.
@@ -254,6 +405,14 @@ type {{.Name}} struct {
 	{{- range .Variables }}
 	{{.Variable.Name}} {{Wrapper .Variable.Type}}
 	{{- end}}
+	{{- range BlockVariables .Block}}
+	{{.Variable.Name}} {{Slices .Loops}}{{Wrapper .Variable.Type}}
+	{{- end}}
+	{{- range .LiveFor}}
+	{{- range BlockVariables .Block}}
+	{{.Variable.Name}} {{Slices .Loops}}{{Wrapper .Variable.Type}}
+	{{- end}}
+	{{- end}}
 	{{- range .Fields}}
 	{{.Name}} {{.Type}}
 	{{- end}}
@@ -275,6 +434,22 @@ func {{.NewName}}({{GenComponentParams .Parameters}}) *{{.Name}} {
 func (o *{{.Name}}) Init({{GenComponentParams .Parameters}}) {
 	o.askewInit({{ListParamVars .Parameters}})
 }
+
+// {{.HydrateName}} creates a new component from server-rendered nodes
+// starting at first, which must have been rendered from a component
+// created with the given parameters.
+func {{.HydrateName}}(first js.Value{{with GenComponentParams .Parameters}}, {{.}}{{end}}) *{{.Name}} {
+	ret := new({{.Name}})
+	ret.askewHydrate(first{{with ListParamVars .Parameters}}, {{.}}{{end}})
+	return ret
+}
+
+// Hydrate initializes the component from server-rendered nodes starting at
+// first, which must have been rendered from a component created with the
+// given arguments.
+func (o *{{.Name}}) Hydrate(first js.Value{{with GenComponentParams .Parameters}}, {{.}}{{end}}) {
+	o.askewHydrate(first{{with ListParamVars .Parameters}}, {{.}}{{end}})
+}
 {{end}}
 
 // FirstNode returns the first DOM node of this component.
@@ -283,11 +458,22 @@ func (o *{{.Name}}) FirstNode() js.Value {
 	return o.αcd.First()
 }
 
+// LastNode returns the last DOM node of this component.
+func (o *{{.Name}}) LastNode() js.Value {
+	return o.αcd.Last()
+}
+
 // askewInit initializes the component, discarding all previous information.
 // The component is initially a DocumentFragment until it gets inserted into
 // the main document. It can be manipulated both before and after insertion.
 func (o *{{.Name}}) askewInit({{GenComponentParams .Parameters}}) {
 	o.αcd.Init(α{{.Name}}Template.Get("content").Call("cloneNode", true))
+	{{- range $i, $f := .LiveFor}}
+	o.αf{{$i}}.Init(o.αcd.Walk({{PathItems .Path 0}}))
+	{{- end}}
+	{{- if or .Captures (BlockListens .Block)}}
+	αcd := &o.αcd
+	{{- end}}
 	{{ range .Fields }}
 	{{- if .DefaultValue }}o.{{.Name}} = {{.DefaultValue}}
 	{{end}}
@@ -303,27 +489,44 @@ func (o *{{.Name}}) askewInit({{GenComponentParams .Parameters}}) {
 	o.{{.Variable.Name}}.BoundValue = askew.New{{TypeForKind .Value.Kind}}(&o.αcd, "{{.Value.ID}}", {{PathItems .Path 0}})
 	{{- end}}
 	{{- end}}
+	{{- range .Observed }}
+	{{- if .TwoWay}}
+	{
+		node := o.αcd.Walk({{PathItems .Path .Target.FormDepth}})
+		{{- template "Model" .}}
+	}
+	{{- else if IsFormValue .Target.Kind}}
+	o.αcd.Observe({{.Expression}}, askew.NewBoundFormValue(&o.αcd, "{{.Target.ID}}", {{.Target.IsRadio}}, {{PathItems .Path .Target.FormDepth}}))
+	{{- else if IsClassValue .Target.Kind}}
+	o.αcd.Observe({{.Expression}}, askew.NewBoundClasses(&o.αcd, []string{ {{ClassNames .Target.IDs}} }, {{PathItems .Path 0}}))
+	{{- else if IsSelfValue .Target.Kind}}
+	o.αcd.Observe({{.Expression}}, askew.NewBoundSelf(&o.αcd, {{PathItems .Path 0}}))
+	{{- else}}
+	o.αcd.Observe({{.Expression}}, askew.New{{TypeForKind .Target.Kind}}(&o.αcd, "{{.Target.ID}}", {{PathItems .Path 0}}))
+	{{- end}}
+	{{- end}}
+	{{- range BlockVariables .Block}}
+	{{- if .Loops}}
+	o.{{.Variable.Name}} = nil
+	{{- else}}
+	o.{{.Variable.Name}}.BoundValue = nil
+	{{- end}}
+	{{- end}}
 	{{- if BlockNotEmpty .Block}}
 	{
 		block := o.αcd.Walk()
+		{{- if BlockBinds .Block}}
+		αbind := func(node js.Value, fn func(node js.Value)) {
+			fn(node)
+		}
+		{{- end}}
 		{{- template "Block" .Block}}
 	}
 	{{- end}}
 	{{- range .Captures}}
 	{
 		src := o.αcd.Walk({{PathItems .Path 0}})
-		{{- range .Mappings}}
-		{
-			wrapper := js.FuncOf(func(this js.Value, arguments []js.Value) interface{} {
-				{{- if NeedsSelf .ParamMappings}}
-				self := arguments[0].Get("currentTarget")
-				{{- end}}
-				{{template "callHandler" .}}
-				return nil
-			})
-			src.Call("addEventListener", "{{.Event}}", wrapper)
-		}
-		{{- end}}
+		{{- template "Mappings" .}}
 	}
 	{{- end}}
 	{{- range .Embeds }}
@@ -339,31 +542,193 @@ func (o *{{.Name}}) askewInit({{GenComponentParams .Parameters}}) {
 		{{- if .Control}}
 		o.{{.Field}}.Controller = o
 		{{- end}}
+		{{- else if .Virtual}}
+		o.{{.Field}}.Init(container, {{Last .Path}}, {{.ItemHeight}})
+		{{- if .Control}}
+		o.{{.Field}}.DefaultController = o
+		{{- end}}
 		{{- else}}
 		o.{{.Field}}.Init(container, {{Last .Path}})
 		{{- if .Control}}
 		o.{{.Field}}.DefaultController = o
 		{{- end}}
-		{{$e := .}}
-		{{- range .ConstructorCalls}}
-		{{$cname := .ConstructorName}}
-		{{- if eq .Kind 1}}
-		if {{.Expression}} {
-		{{- else if eq .Kind 2}}
-		for {{.Index}}, {{.Variable}} := range {{.Expression}} {
+		{{- template "ConstructorCalls" .}}
 		{{- end}}
-		{{- if eq $e.Kind 2}}
-		o.{{$e.Field}}.Set(
-		{{- else}}
-		o.{{$e.Field}}.Append(
-		{{- end}}{{with $e.Ns}}{{.}}.{{end}}{{$cname}}({{.Args.Raw}}))
-		{{- if ne .Kind 0}}
+	}
+	{{- end}}
+	{{- range $i, $l := .Live}}
+	var αs{{$i}} askew.Observable = {{.Expression}}
+	αl{{$i}} := askew.NewLiveIf(o.αcd.Walk({{PathItems .Path 0}}))
+	{{- end}}
+	{{- range $i, $l := .Live}}
+	o.αcd.Observe(αs{{$i}}, αl{{$i}})
+	{{- end}}
+	{{- range $i, $f := .LiveFor}}
+	o.askewFor{{$i}}()
+	{{- end}}
+}
+
+// askewHydrate initializes the component from server-rendered nodes starting
+// at first, discarding all previous information. Instead of instantiating
+// the template, bindings, captures and embeds are attached to the existing
+// nodes. The component will be in inserted state afterwards.
+func (o *{{.Name}}) askewHydrate(first js.Value{{with GenComponentParams .Parameters}}, {{.}}{{end}}) {
+	tmpl := α{{.Name}}Template.Get("content").Call("cloneNode", true)
+	{{- range $i, $f := .LiveFor}}
+	o.αf{{$i}}.Init(askew.WalkPath(tmpl, {{PathItems .Path 0}}))
+	{{- end}}
+	{{- if or .Captures (BlockListens .Block)}}
+	αcd := &o.αcd
+	{{- end}}
+	{{- range $i, $v := .Variables }}
+	{{- if IsFormValue .Value.Kind}}
+	αv{{$i}} := askew.WalkPath(tmpl, {{PathItems .Path .Value.FormDepth}})
+	{{- else}}
+	αv{{$i}} := askew.WalkPath(tmpl, {{PathItems .Path 0}})
+	{{- end}}
+	{{- end}}
+	{{- range $i, $o := .Observed }}
+	{{- if IsFormValue .Target.Kind}}
+	αo{{$i}} := askew.WalkPath(tmpl, {{PathItems .Path .Target.FormDepth}})
+	{{- else}}
+	αo{{$i}} := askew.WalkPath(tmpl, {{PathItems .Path 0}})
+	{{- end}}
+	{{- end}}
+	{{- range $i, $l := .Live}}
+	αl{{$i}} := askew.WalkPath(tmpl, {{PathItems .Path 0}})
+	{{- end}}
+	{{- if BlockBinds .Block}}
+	var αbound []func(h *askew.Hydrator)
+	{{- end}}
+	{{- if BlockNotEmpty .Block}}
+	{
+		block := tmpl
+		{{- if BlockBinds .Block}}
+		αbind := func(node js.Value, fn func(node js.Value)) {
+			αbound = append(αbound, func(h *askew.Hydrator) {
+				fn(h.Locate(node))
+			})
 		}
 		{{- end}}
+		{{- template "Block" .Block}}
+	}
+	{{- end}}
+	h := askew.NewHydrator(tmpl, first)
+	o.αcd.Hydrate(h.First(), h.Last())
+	{{ range .Fields }}
+	{{- if .DefaultValue }}o.{{.Name}} = {{.DefaultValue}}
+	{{end}}
+	{{- end}}
+	{{- range $i, $l := .Live}}
+	var αs{{$i}} askew.Observable = {{.Expression}}
+	αh{{$i}} := askew.HydrateLiveIf(h, αl{{$i}}, αs{{$i}})
+	{{- end}}
+	{{- range $i, $v := .Variables }}
+	{{- if IsFormValue .Value.Kind}}
+	o.{{.Variable.Name}}.BoundValue = askew.BoundFormValueAt(h.Locate(αv{{$i}}), "{{.Value.ID}}", {{.Value.IsRadio}})
+	{{- else if IsClassValue .Value.Kind}}
+	o.{{.Variable.Name}}.BoundValue = askew.BoundClassesAt(h.Locate(αv{{$i}}), []string{ {{ClassNames .Value.IDs}} })
+	{{- else if IsSelfValue .Value.Kind}}
+	o.{{.Variable.Name}}.BoundValue = askew.BoundSelfAt(h.Locate(αv{{$i}}))
+	{{- else}}
+	o.{{.Variable.Name}}.BoundValue = askew.{{TypeForKind .Value.Kind}}At(h.Locate(αv{{$i}}), "{{.Value.ID}}")
+	{{- end}}
+	{{- end}}
+	{{- range $i, $o := .Observed }}
+	{{- if .TwoWay}}
+	{
+		node := h.Locate(αo{{$i}})
+		{{- template "Model" .}}
+	}
+	{{- else if IsFormValue .Target.Kind}}
+	o.αcd.Observe({{.Expression}}, askew.BoundFormValueAt(h.Locate(αo{{$i}}), "{{.Target.ID}}", {{.Target.IsRadio}}))
+	{{- else if IsClassValue .Target.Kind}}
+	o.αcd.Observe({{.Expression}}, askew.BoundClassesAt(h.Locate(αo{{$i}}), []string{ {{ClassNames .Target.IDs}} }))
+	{{- else if IsSelfValue .Target.Kind}}
+	o.αcd.Observe({{.Expression}}, askew.BoundSelfAt(h.Locate(αo{{$i}})))
+	{{- else}}
+	o.αcd.Observe({{.Expression}}, askew.{{TypeForKind .Target.Kind}}At(h.Locate(αo{{$i}}), "{{.Target.ID}}"))
+	{{- end}}
+	{{- end}}
+	{{- range BlockVariables .Block}}
+	{{- if .Loops}}
+	o.{{.Variable.Name}} = nil
+	{{- else}}
+	o.{{.Variable.Name}}.BoundValue = nil
+	{{- end}}
+	{{- end}}
+	{{- if BlockBinds .Block}}
+	for _, fn := range αbound {
+		fn(h)
+	}
+	{{- end}}
+	{{- range .Captures}}
+	{
+		src := h.Walk({{PathItems .Path 0}})
+		{{- template "Mappings" .}}
+	}
+	{{- end}}
+	{{- range .Embeds }}
+	{{- if eq .Kind 0}}
+	{{- if or .Value (not .Hydratable)}}
+	{
+		end := h.Discard({{PathItems .Path 0}})
+		{{- if .Value}}
+		o.{{.Field}} = {{.Value}}
+		{{- else}}
+		o.{{.Field}}.Init({{.Args.Raw}})
 		{{- end}}
+		o.{{.Field}}.InsertInto(end.Get("parentNode"), end)
+	}
+	{{- else}}
+	o.{{.Field}}.Hydrate(h.Embedded({{PathItems .Path 0}}){{with .Args.Raw}}, {{.}}{{end}})
+	{{- end}}
+	{{- if .Control}}
+	o.{{.Field}}.Controller = o
+	{{- end}}
+	{{- else if .Virtual}}
+	o.{{.Field}}.InitHydrated(h.Embedded({{PathItems .Path 0}}), h.Walk({{PathItems .Path 0}}), {{.ItemHeight}})
+	{{- if .Control}}
+	o.{{.Field}}.DefaultController = o
+	{{- end}}
+	{{- else if .Hydratable}}
+	o.{{.Field}}.InitHydrated(h.Embedded({{PathItems .Path 0}}), h.Walk({{PathItems .Path 0}}))
+	{{- if .Control}}
+	o.{{.Field}}.DefaultController = o
+	{{- end}}
+	{{- $e := .}}
+	{{- range .ConstructorCalls}}
+	{{- if eq .Kind 1}}
+	if {{.Expression}} {
+	{{- else if eq .Kind 2}}
+	for {{.Index}}, {{.Variable}} := range {{.Expression}} {
+	{{- end}}
+	o.{{$e.Field}}.Adopt({{with $e.Ns}}{{.}}.{{end}}{{HydrateName .ConstructorName}}(o.{{$e.Field}}.Pending(){{with .Args.Raw}}, {{.}}{{end}}))
+	{{- if ne .Kind 0}}
+	}
+	{{- end}}
+	{{- end}}
+	{{- else}}
+	{
+		end := h.Discard({{PathItems .Path 0}})
+		o.{{.Field}}.InitHydrated(end, end)
+		{{- if .Control}}
+		o.{{.Field}}.DefaultController = o
 		{{- end}}
+		{{- template "ConstructorCalls" .}}
 	}
 	{{- end}}
+	{{- end}}
+	{{- range $i, $l := .Live}}
+	o.αcd.Observe(αs{{$i}}, αh{{$i}})
+	{{- end}}
+	{{- range $i, $f := .LiveFor}}
+	o.αf{{$i}}.Hydrate(h.Embedded({{PathItems .Path 0}}), h.Walk({{PathItems .Path 0}}))
+	o.askewFor{{$i}}()
+	{{- end}}
+	if h, ok := interface{}(o).(askew.Inserted); ok {
+		h.OnInsert()
+	}
 }
 
 // InsertInto inserts this component into the given object.
@@ -374,35 +739,67 @@ func (o *{{.Name}}) InsertInto(parent js.Value, before js.Value) {
 	o.αcd.DoInsert(parent, before)
 	{{- range .Embeds}}
 	{{- if ne .Kind 0}}
-	{{- if .T}}
+	{{- if and .T (not .Virtual)}}
 	o.{{.Field}}.αmgr.UpdateParent(o.αcd.DocumentFragment(), parent, before)
 	{{- else}}
 	o.{{.Field}}.DoUpdateParent(o.αcd.DocumentFragment(), parent, before)
 	{{- end}}
 	{{- end}}
 	{{- end}}
+	if askew.IsConnected(parent) {
+		o.DoNotify(true)
+	}
 }
 
 // Extract removes this component from its current parent.
 // The component will be in initial state afterwards.
 func (o *{{.Name}}) Extract() {
+	connected := askew.IsConnected(o.αcd.First())
 	o.αcd.DoExtract()
 	{{- range .Embeds}}
 	{{- if ne .Kind 0}}
-	{{- if .T}}
+	{{- if and .T (not .Virtual)}}
 	o.{{.Field}}.αmgr.UpdateParent(o.αcd.First().Get("parentNode"), o.αcd.DocumentFragment(), js.Undefined())
 	{{- else}}
 	o.{{.Field}}.DoUpdateParent(o.αcd.First().Get("parentNode"), o.αcd.DocumentFragment(), js.Undefined())
 	{{- end}}
 	{{- end}}
 	{{- end}}
+	if connected {
+		o.DoNotify(false)
+	}
+}
+
+// DoNotify calls OnInsert on the embedded components and then on this
+// component if connected is true, and OnExtract on this component and then
+// on the embedded components otherwise.
+// This is an implementation detail and should not be called from user code.
+func (o *{{.Name}}) DoNotify(connected bool) {
+	if connected {
+		{{- range .Embeds}}
+		askew.Notify(&o.{{.Field}}, true)
+		{{- end}}
+		if h, ok := interface{}(o).(askew.Inserted); ok {
+			h.OnInsert()
+		}
+	} else {
+		if h, ok := interface{}(o).(askew.Extracted); ok {
+			h.OnExtract()
+		}
+		{{- range .Embeds}}
+		askew.Notify(&o.{{.Field}}, false)
+		{{- end}}
+	}
 }
 
 // Destroy destroys this element (and all contained components). If it is
 // currently inserted anywhere, it gets removed before.
 func (o *{{.Name}}) Destroy() {
+	if h, ok := interface{}(o).(askew.Destroyed); ok {
+		h.OnDestroy()
+	}
 	{{- range .Embeds}}
-	{{- if eq .Kind 0}}
+	{{- if or (eq .Kind 0) .Virtual}}
 	o.{{.Field}}.Destroy()
 	{{- else if eq .Kind 1}}
 	o.{{.Field}}.DestroyAll()
@@ -410,9 +807,67 @@ func (o *{{.Name}}) Destroy() {
 	o.{{.Field}}.Set(nil)
 	{{- end}}
 	{{- end}}
+	{{- range $i, $f := .LiveFor}}
+	o.αf{{$i}}.Destroy()
+	{{- end}}
 	o.αcd.DoDestroy()
 }
 
+{{- $cmp := .}}
+{{- if .LiveFor}}
+
+// Refresh re-evaluates the ranges of the a:for.live elements of this
+// component and re-creates their elements.
+func (o *{{.Name}}) Refresh() {
+	{{- range $i, $f := .LiveFor}}
+	o.askewFor{{$i}}()
+	{{- end}}
+}
+{{- end}}
+{{- range $i, $f := .LiveFor}}
+
+// askewFor{{$i}} creates the elements of an a:for.live, replacing the
+// previous ones.
+func (o *{{$cmp.Name}}) askewFor{{$i}}() {
+	{{if BlockListens .Block}}αcd := {{end}}o.αf{{$i}}.Reset()
+	{{- range BlockVariables .Block}}
+	o.{{.Variable.Name}} = nil
+	{{- end}}
+	for {{.Index}}{{with .Variable}}, {{.}}{{end}} := range {{.Expression}} {
+		{{- if BlockBinds .Block}}
+		{{- range LoopVars .ControlBlock}}
+		{{.}} := {{.}}
+		_ = {{.}}
+		{{- end}}
+		{{- end}}
+		{{if or (BlockNotEmpty .Block) (BlockBinds .Block)}}{{if BlockNotEmpty .Block}}block{{else}}_{{end}}, {{if BlockBinds .Block}}αbind{{else}}_{{end}} := {{end}}o.αf{{$i}}.Add()
+		{{- range LoopRows .ControlBlock}}
+		αbind(block, func(js.Value) {
+			{{.}} = append({{.}}, nil)
+		})
+		{{- end}}
+		{{- template "Block" .Block}}
+	}
+	o.αf{{$i}}.Commit()
+}
+{{- end}}
+{{- range $name, $event := .Events}}
+
+// {{EmitName $name}} dispatches the event ` + "`{{$name}}`" + ` at the first element of this
+// component. The event bubbles and can be captured by any ancestor.
+func (o *{{$cmp.Name}}) {{EmitName $name}}({{GenParams $event.Params}}) {
+	{{- if $event.Params}}
+	o.αcd.Emit("{{$name}}", map[string]interface{}{
+		{{- range $event.Params}}
+		"{{.Name}}": {{.Name}},
+		{{- end}}
+	})
+	{{- else}}
+	o.αcd.Emit("{{$name}}", nil)
+	{{- end}}
+}
+{{- end}}
+
 {{- end}}`))
 
 var list = template.Must(template.New("list").Parse(`
@@ -436,6 +891,31 @@ func (l *{{.Name}}List) Init(container js.Value, index int) {
 	l.αitems = nil
 }
 
+// InitHydrated initializes the list with server-rendered items, discarding
+// previous data. The items start at first and are followed by end.
+// They must be added to the list by hydrating them one by one, starting at
+// Pending(), and giving them to Adopt.
+func (l *{{.Name}}List) InitHydrated(first, end js.Value) {
+	l.αmgr = askew.HydrateListManager(first, end)
+	l.αitems = nil
+}
+
+// Pending returns the first node of the server-rendered items that have not
+// been adopted yet, or null if there are none.
+func (l *{{.Name}}List) Pending() js.Value {
+	return l.αmgr.Pending()
+}
+
+// Adopt appends the given item, which must have been hydrated starting at
+// Pending(), to the list.
+func (l *{{.Name}}List) Adopt(item *{{.Name}}) {
+	l.αmgr.Adopt(item)
+	l.αitems = append(l.αitems, item)
+	{{- if .Controller}}
+	item.Controller = l.DefaultController
+	{{- end}}
+}
+
 // Len returns the number of items in the list.
 func (l *{{.Name}}List) Len() int {
 	return len(l.αitems)
@@ -478,10 +958,94 @@ func (l *{{.Name}}List) Insert(index int, item *{{.Name}}) {
 	return
 }
 
+// Reconcile updates the list so that it contains one item for each of the
+// given keys, in that order. Keys must be comparable and unique; if they
+// contain a duplicate, an error is returned and the list is not changed.
+//
+// Items that have been created for a key by a previous call to Reconcile are
+// reused for that key and given to update, unless it is nil. Other items,
+// including those added with Append or Insert, are destroyed. For keys
+// without an item, a new item is created by create. Reused items are moved in
+// the document only if their order changes, so their state is kept.
+func (l *{{.Name}}List) Reconcile(keys []interface{},
+	create func(key interface{}) *{{.Name}},
+	update func(item *{{.Name}}, key interface{})) error {
+	var doUpdate func(item askew.Component, index int)
+	if update != nil {
+		doUpdate = func(item askew.Component, index int) {
+			update(item.(*{{.Name}}), keys[index])
+		}
+	}
+	items, err := l.αmgr.Reconcile(l.αcomponents(), keys, func(index int) askew.Component {
+		item := create(keys[index])
+		{{- if .Controller}}
+		item.Controller = l.DefaultController
+		{{- end}}
+		return item
+	}, doUpdate)
+	l.αset(items)
+	return err
+}
+
+// Move moves the item at index from to index to, shifting the items in
+// between. Only the nodes of the moved item are moved in the document.
+func (l *{{.Name}}List) Move(from, to int) {
+	l.αset(l.αmgr.Move(l.αcomponents(), from, to))
+}
+
+// Swap swaps the items at the indexes i and j.
+func (l *{{.Name}}List) Swap(i, j int) {
+	l.αset(l.αmgr.Swap(l.αcomponents(), i, j))
+}
+
+// SortFunc sorts the list with the given less function. The sort is stable.
+// As few items as possible are moved in the document.
+func (l *{{.Name}}List) SortFunc(less func(a, b *{{.Name}}) bool) {
+	l.αset(l.αmgr.Sort(l.αcomponents(), func(a, b askew.Component) bool {
+		return less(a.(*{{.Name}}), b.(*{{.Name}}))
+	}))
+}
+
+// Retain keeps the items for which keep returns true and destroys all other
+// items.
+func (l *{{.Name}}List) Retain(keep func(item *{{.Name}}) bool) {
+	kept := l.αitems[:0]
+	for _, item := range l.αitems {
+		if keep(item) {
+			kept = append(kept, item)
+		} else {
+			item.Destroy()
+			l.αmgr.Forget(item)
+		}
+	}
+	for i := len(kept); i < len(l.αitems); i++ {
+		l.αitems[i] = nil
+	}
+	l.αitems = kept
+}
+
+// αcomponents returns the items as askew.Component for the list manager.
+func (l *{{.Name}}List) αcomponents() []askew.Component {
+	ret := make([]askew.Component, len(l.αitems))
+	for i, item := range l.αitems {
+		ret[i] = item
+	}
+	return ret
+}
+
+// αset sets the items to the given items returned by the list manager.
+func (l *{{.Name}}List) αset(items []askew.Component) {
+	l.αitems = l.αitems[:0]
+	for _, item := range items {
+		l.αitems = append(l.αitems, item.(*{{.Name}}))
+	}
+}
+
 // Remove removes the item at the given index from the list and returns it.
 func (l *{{.Name}}List) Remove(index int) *{{.Name}} {
 	item := l.αitems[index]
 	item.Extract()
+	l.αmgr.Forget(item)
 	copy(l.αitems[index:], l.αitems[index+1:])
 	l.αitems = l.αitems[:len(l.αitems)-1]
 	return item
@@ -491,6 +1055,7 @@ func (l *{{.Name}}List) Remove(index int) *{{.Name}} {
 func (l *{{.Name}}List) Destroy(index int) {
 	item := l.αitems[index]
 	item.Destroy()
+	l.αmgr.Forget(item)
 	copy(l.αitems[index:], l.αitems[index+1:])
 	l.αitems = l.αitems[:len(l.αitems)-1]
 }
@@ -500,9 +1065,18 @@ func (l *{{.Name}}List) DestroyAll() {
 	for _, item := range l.αitems {
 		item.Destroy()
 	}
+	l.αmgr.ForgetAll()
 	l.αitems = l.αitems[:0]
 }
 
+// DoNotify passes the notification of the list's parent on to the items.
+// This is an implementation detail and should not be called from user code.
+func (l *{{.Name}}List) DoNotify(connected bool) {
+	for _, item := range l.αitems {
+		item.DoNotify(connected)
+	}
+}
+
 {{- end}}{{ end }}
 `))
 
@@ -526,6 +1100,30 @@ func (o *Optional{{.Name}}) Init(container js.Value, index int) {
 	o.αcur = nil
 }
 
+// InitHydrated initializes the container with a server-rendered item, if
+// any, which starts at first and is followed by end. The item must be
+// hydrated starting at Pending() and given to Adopt.
+func (o *Optional{{.Name}}) InitHydrated(first, end js.Value) {
+	o.αmgr = askew.HydrateListManager(first, end)
+	o.αcur = nil
+}
+
+// Pending returns the first node of the server-rendered item if it has not
+// been adopted yet, or null.
+func (o *Optional{{.Name}}) Pending() js.Value {
+	return o.αmgr.Pending()
+}
+
+// Adopt sets the contained item to the given item, which must have been
+// hydrated starting at Pending().
+func (o *Optional{{.Name}}) Adopt(value *{{.Name}}) {
+	o.αmgr.Adopt(value)
+	o.αcur = value
+	{{- if .Controller}}
+	value.Controller = o.DefaultController
+	{{- end}}
+}
+
 // Item returns the current item, or nil if no item is assigned
 func (o *Optional{{.Name}}) Item() *{{.Name}} {
 	return o.αcur
@@ -558,6 +1156,105 @@ func (o *Optional{{.Name}}) Remove() askew.Component {
 	return nil
 }
 
+// DoNotify passes the notification of the container's parent on to the
+// current item.
+// This is an implementation detail and should not be called from user code.
+func (o *Optional{{.Name}}) DoNotify(connected bool) {
+	if o.αcur != nil {
+		o.αcur.DoNotify(connected)
+	}
+}
+
+{{- end}}{{ end }}
+`))
+
+var virtual = template.Must(template.New("virtual").Parse(`
+{{- range .Components}}{{ if .GenVirtual }}
+
+// Virtual{{.Name}}List is a list of {{.Name}} that only renders the visible
+// items. Its content is defined by data, see askew.VirtualList.
+type Virtual{{.Name}}List struct {
+	αv askew.VirtualList
+	{{- if .Controller}}
+	DefaultController {{.Name}}Controller
+	{{- end}}
+}
+
+// Init initializes the list, discarding previous data.
+// The list's items will be placed in the given container, starting at the
+// given index. itemHeight is the height of each item in pixels.
+func (l *Virtual{{.Name}}List) Init(container js.Value, index int, itemHeight int) {
+	l.αv.Init(container, index, itemHeight)
+}
+
+// InitHydrated initializes the list in place of server-rendered items, which
+// start at first and are followed by end. The server-rendered items are
+// removed.
+func (l *Virtual{{.Name}}List) InitHydrated(first, end js.Value, itemHeight int) {
+	l.αv.InitHydrated(first, end, itemHeight)
+}
+
+// SetData sets the length of the list and the functions that create items
+// and bind them to an index. Items are reused for other indexes when they
+// leave the visible area, so bind must update all data the item shows.
+func (l *Virtual{{.Name}}List) SetData(n int, create func() *{{.Name}},
+	bind func(item *{{.Name}}, index int)) {
+	l.αv.SetData(n, func() askew.Component {
+		item := create()
+		{{- if .Controller}}
+		item.Controller = l.DefaultController
+		{{- end}}
+		return item
+	}, func(item askew.Component, index int) {
+		bind(item.(*{{.Name}}), index)
+	})
+}
+
+// SetLen sets the length of the list.
+func (l *Virtual{{.Name}}List) SetLen(n int) {
+	l.αv.SetLen(n)
+}
+
+// Refresh binds all rendered items anew. Call it when the data of the list
+// changes, or when the size of its container changes.
+func (l *Virtual{{.Name}}List) Refresh() {
+	l.αv.Refresh()
+}
+
+// Len returns the number of items in the list.
+func (l *Virtual{{.Name}}List) Len() int {
+	return l.αv.Len()
+}
+
+// Item returns the item currently rendered for the given index, or nil if
+// the index is not rendered, which is the case for all indexes outside of the
+// visible area and its buffer.
+func (l *Virtual{{.Name}}List) Item(index int) *{{.Name}} {
+	if item := l.αv.Item(index); item != nil {
+		return item.(*{{.Name}})
+	}
+	return nil
+}
+
+// Destroy destroys all items and removes the list from the document.
+func (l *Virtual{{.Name}}List) Destroy() {
+	l.αv.Destroy()
+}
+
+// DoUpdateParent calls the underlying list's DoUpdateParent.
+// This is an implementation detail and should not be called from user code.
+func (l *Virtual{{.Name}}List) DoUpdateParent(oldParent, newParent, newEnd js.Value) {
+	l.αv.DoUpdateParent(oldParent, newParent, newEnd)
+}
+
+// DoNotify passes the notification of the list's parent on to the rendered
+// items and renders the visible items after the list has been connected to
+// the document.
+// This is an implementation detail and should not be called from user code.
+func (l *Virtual{{.Name}}List) DoNotify(connected bool) {
+	l.αv.DoNotify(connected)
+}
+
 {{- end}}{{ end }}
 `))
 
@@ -592,7 +1289,7 @@ func init() {
 		{{with $varName}}{{.}}.{{end}}{{.Field}}.InsertInto(container, container.Get("childNodes").Index({{Last .Path}}))
 	}
 	{{- else}}
-	{{with $varName}}{{.}}.{{end}}{{.Field}}.Init(askew.WalkPath(html, {{PathItems .Path 1}}), {{Last .Path}})
+	{{with $varName}}{{.}}.{{end}}{{.Field}}.Init(askew.WalkPath(html, {{PathItems .Path 1}}), {{Last .Path}}{{if .Virtual}}, {{.ItemHeight}}{{end}})
 	{{- end}}
 	{{- end}}
 }
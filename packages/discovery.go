@@ -98,9 +98,56 @@ func descend(start *html.Node, path []atom.Atom) (*html.Node, error) {
 	return cur, nil
 }
 
+// walkSources walks through the file system starting at the cwd and calls f
+// for each askew source file that is not inside an excluded directory.
+func walkSources(excludes []string,
+	f func(path string, info os.FileInfo, kind suffix) error) error {
+	return filepath.Walk(".", func(path string, info os.FileInfo, err error) error {
+		if err != nil {
+			// files may vanish while walking, e.g. in watch mode.
+			if os.IsNotExist(err) {
+				return nil
+			}
+			return err
+		}
+		if info.IsDir() {
+			for _, exclude := range excludes {
+				if matched, _ := filepath.Match(exclude, path); matched {
+					return filepath.SkipDir
+				}
+			}
+		}
+		kind := fileKind(info.Name())
+		if info.IsDir() || kind == dotOther {
+			return nil
+		}
+		return f(path, info, kind)
+	})
+}
+
+func addFile(base *data.BaseDir, path string, info os.FileInfo, kind suffix,
+	tmplData interface{}) {
+	os.Stdout.WriteString("[info] discovered: " + path + "\n")
+	relPath := filepath.Dir(path)
+	pkg, ok := base.Packages[relPath]
+	if !ok {
+		// the .Name of the package is only set when the first file inside that
+		// package is processed.
+		pkg = &data.Package{Files: make([]*data.AskewFile, 0, 32),
+			ImportPath: filepath.ToSlash(filepath.Join(base.ImportPath, relPath))}
+		base.Packages[relPath] = pkg
+	}
+	if err := discoverFile(base, pkg, path, info, kind, tmplData); err != nil {
+		base.Report(path, err)
+		pkg.Incomplete = true
+	}
+}
+
 // Discover searches for a go.mod in the cwd, then walks through the file system
 // to discover .askew files.
-// For each file, the imports are parsed.
+// For each file, the imports are parsed. Errors in files are added to the
+// returned BaseDir's Diagnostics, the returned error is only set if the
+// discovery itself failed.
 func Discover(excludes []string, tmplData interface{}) (*data.BaseDir, error) {
 	var err error
 	ret := &data.BaseDir{}
@@ -110,147 +157,168 @@ func Discover(excludes []string, tmplData interface{}) (*data.BaseDir, error) {
 	}
 
 	ret.Packages = make(map[string]*data.Package)
-	err = filepath.Walk(".", func(path string, info os.FileInfo, err error) error {
-		if info.IsDir() {
-			for _, exclude := range excludes {
-				if matched, _ := filepath.Match(exclude, path); matched {
-					return filepath.SkipDir
-				}
-			}
+	err = walkSources(excludes, func(path string, info os.FileInfo, kind suffix) error {
+		addFile(ret, path, info, kind, tmplData)
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return ret, nil
+}
+
+// DiscoverPackage discards the package at relPath and discovers its files
+// anew. If the directory does not contain any askew files anymore, the package
+// is removed from base.
+func DiscoverPackage(base *data.BaseDir, relPath string, tmplData interface{}) error {
+	delete(base.Packages, relPath)
+	infos, err := ioutil.ReadDir(relPath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return nil
 		}
+		return err
+	}
+	for _, info := range infos {
 		kind := fileKind(info.Name())
 		if info.IsDir() || kind == dotOther {
-			return nil
-		}
-		os.Stdout.WriteString("[info] discovered: " + path + "\n")
-		relPath := filepath.Dir(path)
-		assumedPkgName := filepath.Base(relPath)
-		if assumedPkgName == "." {
-			assumedPkgName = filepath.Base(ret.ImportPath)
-		}
-		pkg, ok := ret.Packages[relPath]
-		if !ok {
-			// the .Name of the package is only set when the first file inside that
-			// package is processed.
-			pkg = &data.Package{Files: make([]*data.AskewFile, 0, 32),
-				ImportPath: filepath.ToSlash(filepath.Join(ret.ImportPath, relPath))}
-			ret.Packages[relPath] = pkg
+			continue
 		}
+		addFile(base, filepath.Join(relPath, info.Name()), info, kind, tmplData)
+	}
+	return nil
+}
 
-		var contents []byte
+func discoverFile(base *data.BaseDir, pkg *data.Package, path string,
+	info os.FileInfo, kind suffix, tmplData interface{}) error {
+	var err error
+	assumedPkgName := filepath.Base(filepath.Dir(path))
+	if assumedPkgName == "." {
+		assumedPkgName = filepath.Base(base.ImportPath)
+	}
 
-		var baseName string
-		if kind == dotAskewTmpl || kind == dotAsiteTmpl {
-			var tmpl *template.Template
-			tmpl, err = template.New(filepath.Base(path)).ParseFiles(path)
-			if err != nil {
-				return err
-			}
-			var writer bytes.Buffer
-			if err = tmpl.Execute(&writer, tmplData); err != nil {
-				return err
-			}
-			contents = writer.Bytes()
-			kind--
-			baseName = info.Name()[:len(info.Name())-11]
-		} else {
-			contents, err = ioutil.ReadFile(path)
-			if err != nil {
-				return err
-			}
-			baseName = info.Name()[:len(info.Name())-6]
+	var contents []byte
+
+	var baseName string
+	if kind == dotAskewTmpl || kind == dotAsiteTmpl {
+		var tmpl *template.Template
+		tmpl, err = template.New(filepath.Base(path)).ParseFiles(path)
+		if err != nil {
+			return err
+		}
+		var writer bytes.Buffer
+		if err = tmpl.Execute(&writer, tmplData); err != nil {
+			return err
 		}
+		contents = writer.Bytes()
+		kind--
+		baseName = info.Name()[:len(info.Name())-11]
+	} else {
+		contents, err = ioutil.ReadFile(path)
+		if err != nil {
+			return err
+		}
+		baseName = info.Name()[:len(info.Name())-6]
+	}
 
-		if kind == dotAskew {
-			askewFile := &data.AskewFile{File: data.File{BaseName: baseName, Path: path}}
-			askewFile.Content, err = html.ParseFragmentWithOptions(
-				bytes.NewReader(contents), &data.BodyEnv,
-				html.ParseOptionCustomElements(walker.AskewElements))
-			if err != nil {
-				return fmt.Errorf("%s: %s", path, err.Error())
-			}
-			pHandler := &packageHandler{pkg: pkg, seen: false}
-			w := walker.Walker{
-				Package:   pHandler,
-				Import:    &importHandler{file: &askewFile.File},
-				Component: walker.DontDescend{},
-				Macro:     walker.DontDescend{},
-				TextNode:  walker.WhitespaceOnly{}}
-			_, _, err = w.WalkChildren(nil, &walker.NodeSlice{Items: askewFile.Content})
-			if err != nil {
-				return fmt.Errorf("%s: %s", path, err.Error())
-			}
-			if !pHandler.seen {
-				if pkg.Name == "" {
-					pkg.Name = assumedPkgName
-				} else if pkg.Name != assumedPkgName {
-					return fmt.Errorf(
-						"%s: <a:package> missing, another file has already set the package name to '%s'",
-						path, pkg.Name)
-				}
-			}
-			if askewFile.File.Imports == nil {
-				askewFile.File.Imports = make(map[string]string)
-			}
-			if url, ok := askewFile.File.Imports["askew"]; ok {
-				if url != "github.com/flyx/askew/runtime" {
-					return fmt.Errorf(
-						"%s: if the alias `askew` is given in imports, it must link to \"github.com/flyx/askew/runtime\"",
-						path)
-				}
-			} else {
-				askewFile.File.Imports["askew"] = "github.com/flyx/askew/runtime"
-			}
-			pkg.Files = append(pkg.Files, askewFile)
-		} else {
-			if pkg.Site != nil {
-				return fmt.Errorf("%s: a package cannot contain multiple sites", path)
+	if kind == dotAskew {
+		askewFile := &data.AskewFile{File: data.File{BaseName: baseName, Path: path}}
+		askewFile.Content, err = html.ParseFragmentWithOptions(
+			bytes.NewReader(contents), &data.BodyEnv,
+			html.ParseOptionCustomElements(walker.AskewElements))
+		if err != nil {
+			return err
+		}
+		base.Positions.Record(path, contents, askewFile.Content...)
+		pHandler := &packageHandler{pkg: pkg, seen: false}
+		w := walker.Walker{
+			Package:   pHandler,
+			Import:    &importHandler{file: &askewFile.File},
+			Component: walker.DontDescend{},
+			Macro:     walker.DontDescend{},
+			TextNode:  walker.WhitespaceOnly{}}
+		_, _, err = w.WalkChildren(nil, &walker.NodeSlice{Items: askewFile.Content})
+		if err != nil {
+			return err
+		}
+		if !pHandler.seen {
+			if pkg.Name == "" {
+				pkg.Name = assumedPkgName
+			} else if pkg.Name != assumedPkgName {
+				return fmt.Errorf(
+					"<a:package> missing, another file has already set the package name to '%s'",
+					pkg.Name)
 			}
+		}
+		if err = addRuntimeImport(&askewFile.File); err != nil {
+			return err
+		}
+		pkg.Files = append(pkg.Files, askewFile)
+	} else {
+		if pkg.Site != nil {
+			return errors.New("a package cannot contain multiple sites")
+		}
 
-			asiteFile := &data.ASiteFile{File: data.File{BaseName: baseName, Path: path}}
-			asiteFile.Document, err = html.ParseWithOptions(bytes.NewReader(contents),
-				html.ParseOptionCustomElements(walker.AskewElements))
-			if err != nil {
-				return fmt.Errorf("%s: %s", path, err.Error())
-			}
-			if asiteFile.Document.Type != html.DocumentNode ||
-				asiteFile.Document.FirstChild.Type != html.DoctypeNode {
-				return fmt.Errorf("%s: does not contain a complete HTML 5 document (doctype missing?)", path)
-			}
-			rootNode := asiteFile.Document.FirstChild.NextSibling
-			if rootNode.Type != html.ElementNode || rootNode.Data != "a:site" {
-				return fmt.Errorf("%s: root is not a <a:site> node", path)
-			}
-			head, err := descend(rootNode, []atom.Atom{atom.Head})
-			if err != nil {
-				return fmt.Errorf("%s: %s", path, err.Error())
-			}
-			pHandler := &packageHandler{pkg: pkg, seen: false, remove: true}
-			w := walker.Walker{
-				Package:     pHandler,
-				Import:      &importHandler{file: &asiteFile.File, remove: true},
-				TextNode:    walker.WhitespaceOnly{},
-				StdElements: walker.DontDescend{}}
-			_, _, err = w.WalkChildren(head, &walker.Siblings{Cur: head.FirstChild})
-			if err != nil {
-				return fmt.Errorf("%s: %s", path, err.Error())
-			}
-			if !pHandler.seen {
-				if pkg.Name == "" {
-					pkg.Name = assumedPkgName
-				} else if pkg.Name != assumedPkgName {
-					return fmt.Errorf("%s: <a:package> missing, has been set to %s in another file", path, pkg.Name)
-				}
+		asiteFile := &data.ASiteFile{File: data.File{BaseName: baseName, Path: path}}
+		asiteFile.Document, err = html.ParseWithOptions(bytes.NewReader(contents),
+			html.ParseOptionCustomElements(walker.AskewElements))
+		if err != nil {
+			return err
+		}
+		base.Positions.Record(path, contents, asiteFile.Document)
+		if asiteFile.Document.Type != html.DocumentNode ||
+			asiteFile.Document.FirstChild.Type != html.DoctypeNode {
+			return errors.New("does not contain a complete HTML 5 document (doctype missing?)")
+		}
+		rootNode := asiteFile.Document.FirstChild.NextSibling
+		if rootNode.Type != html.ElementNode || rootNode.Data != "a:site" {
+			return &data.NodeError{Node: rootNode, Err: errors.New("root is not a <a:site> node")}
+		}
+		head, err := descend(rootNode, []atom.Atom{atom.Head})
+		if err != nil {
+			return &data.NodeError{Node: rootNode, Err: err}
+		}
+		pHandler := &packageHandler{pkg: pkg, seen: false, remove: true}
+		w := walker.Walker{
+			Package:     pHandler,
+			Import:      &importHandler{file: &asiteFile.File, remove: true},
+			TextNode:    walker.WhitespaceOnly{},
+			StdElements: walker.DontDescend{}}
+		_, _, err = w.WalkChildren(head, &walker.Siblings{Cur: head.FirstChild})
+		if err != nil {
+			return err
+		}
+		if !pHandler.seen {
+			if pkg.Name == "" {
+				pkg.Name = assumedPkgName
+			} else if pkg.Name != assumedPkgName {
+				return fmt.Errorf("<a:package> missing, has been set to %s in another file", pkg.Name)
 			}
-			pkg.Site = asiteFile
 		}
+		if err = addRuntimeImport(&asiteFile.File); err != nil {
+			return err
+		}
+		pkg.Site = asiteFile
+	}
 
-		return nil
-	})
-	if err != nil {
-		return nil, err
+	return nil
+}
+
+// addRuntimeImport adds the askew runtime to the imports of the given file
+// since the generated code uses it.
+func addRuntimeImport(file *data.File) error {
+	if file.Imports == nil {
+		file.Imports = make(map[string]string)
 	}
-	return ret, nil
+	if url, ok := file.Imports["askew"]; ok {
+		if url != "github.com/flyx/askew/runtime" {
+			return errors.New(
+				"if the alias `askew` is given in imports, it must link to \"github.com/flyx/askew/runtime\"")
+		}
+	} else {
+		file.Imports["askew"] = "github.com/flyx/askew/runtime"
+	}
+	return nil
 }
 
 type importHandler struct {
@@ -268,7 +336,7 @@ func (ih *importHandler) Process(n *html.Node) (descend bool, replacement *html.
 	}
 	imports, err := parsers.ParseImports(raw)
 	if err != nil {
-		return false, nil, errors.New(": " + err.Error())
+		return false, nil, parsers.TextError(": ", raw, err)
 	}
 	if ih.file.Imports != nil {
 		return false, nil, errors.New(": cannot have more than one <a:import> per file")
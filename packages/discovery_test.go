@@ -0,0 +1,91 @@
+package packages
+
+import (
+	"os"
+	"testing"
+)
+
+const goMod = "module example.com/proj\n\ngo 1.12\n"
+
+func TestDiscover(t *testing.T) {
+	inDir(t, map[string]string{
+		"go.mod": goMod,
+		"ui/a.askew": `<a:import>
+  "example.com/proj/other"
+</a:import>
+<a:component name="A"></a:component>`,
+		"ui/b.askew":    `<a:package></a:package>`,
+		"other/c.askew": `<a:component name="C"></a:component>`,
+	}, func() {
+		base, err := Discover(nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if base.ImportPath != "example.com/proj" {
+			t.Errorf("unexpected import path: %s", base.ImportPath)
+		}
+		ui, ok := base.Packages["ui"]
+		if !ok || ui.Name != "ui" || !ui.Incomplete || len(ui.Files) != 1 {
+			t.Fatalf("unexpected package ui: %+v", ui)
+		}
+		if ui.Files[0].Imports["other"] != "example.com/proj/other" ||
+			ui.Files[0].Imports["askew"] != "github.com/flyx/askew/runtime" {
+			t.Errorf("unexpected imports: %v", ui.Files[0].Imports)
+		}
+		if other, ok := base.Packages["other"]; !ok || other.Incomplete {
+			t.Errorf("unexpected package other: %+v", other)
+		}
+		items := base.Diagnostics.Sorted()
+		if len(items) != 1 || items[0].Error() != "ui/b.askew:1:1: must contain text content" {
+			t.Errorf("unexpected diagnostics: %v", items)
+		}
+	})
+}
+
+func TestDiscoverImportError(t *testing.T) {
+	inDir(t, map[string]string{
+		"go.mod": goMod,
+		"ui/a.askew": `<a:import>
+  "example.com/&amp;" x
+</a:import>
+<a:component name="A"></a:component>`,
+	}, func() {
+		base, err := Discover(nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		// the column refers to the source, where &amp; takes five bytes.
+		items := base.Diagnostics.Sorted()
+		if len(items) != 1 || items[0].Error() != "ui/a.askew:2:23: parse error near 'x'" {
+			t.Errorf("unexpected diagnostics: %v", items)
+		}
+	})
+}
+
+func TestDiscoverPackage(t *testing.T) {
+	inDir(t, map[string]string{
+		"go.mod":     goMod,
+		"ui/a.askew": `<a:component name="A"></a:component>`,
+	}, func() {
+		base, err := Discover(nil, nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		old := base.Packages["ui"]
+		if err = DiscoverPackage(base, "ui", nil); err != nil {
+			t.Fatal(err)
+		}
+		if pkg := base.Packages["ui"]; pkg == old || len(pkg.Files) != 1 {
+			t.Errorf("package has not been discovered again: %+v", pkg)
+		}
+		if err = os.RemoveAll("ui"); err != nil {
+			t.Fatal(err)
+		}
+		if err = DiscoverPackage(base, "ui", nil); err != nil {
+			t.Fatal(err)
+		}
+		if _, ok := base.Packages["ui"]; ok {
+			t.Error("removed package is still known")
+		}
+	})
+}
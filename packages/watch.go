@@ -0,0 +1,100 @@
+package packages
+
+import (
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/flyx/askew/data"
+)
+
+// Snapshot maps the paths of all askew source files to their modification
+// time.
+type Snapshot map[string]time.Time
+
+// TakeSnapshot records the modification time of all askew source files that
+// are not inside an excluded directory.
+func TakeSnapshot(excludes []string) (Snapshot, error) {
+	ret := make(Snapshot)
+	err := walkSources(excludes, func(path string, info os.FileInfo, kind suffix) error {
+		ret[path] = info.ModTime()
+		return nil
+	})
+	return ret, err
+}
+
+// ChangedPackages returns the relative paths of all packages that contain a
+// file which has been added, removed or modified since the snapshot old.
+func (s Snapshot) ChangedPackages(old Snapshot) []string {
+	dirs := make(map[string]struct{})
+	for path, t := range s {
+		if prev, ok := old[path]; !ok || !prev.Equal(t) {
+			dirs[filepath.Dir(path)] = struct{}{}
+		}
+	}
+	for path := range old {
+		if _, ok := s[path]; !ok {
+			dirs[filepath.Dir(path)] = struct{}{}
+		}
+	}
+	ret := make([]string, 0, len(dirs))
+	for dir := range dirs {
+		ret = append(ret, dir)
+	}
+	return ret
+}
+
+// TemplatePackages returns the relative paths of all packages that contain
+// a *.askew.tmpl or *.asite.tmpl file and therefore depend on the data file.
+func (s Snapshot) TemplatePackages() []string {
+	dirs := make(map[string]struct{})
+	for path := range s {
+		if kind := fileKind(path); kind == dotAskewTmpl || kind == dotAsiteTmpl {
+			dirs[filepath.Dir(path)] = struct{}{}
+		}
+	}
+	ret := make([]string, 0, len(dirs))
+	for dir := range dirs {
+		ret = append(ret, dir)
+	}
+	return ret
+}
+
+// Dependents returns the given packages along with all packages that
+// transitively import any of them.
+func Dependents(importPath string, packages map[string]*data.Package,
+	changed []string) []string {
+	importers := make(map[string][]string)
+	addImports := func(name string, imports map[string]string) {
+		for _, item := range imports {
+			relPath, err := filepath.Rel(importPath, item)
+			if err != nil {
+				continue
+			}
+			importers[relPath] = append(importers[relPath], name)
+		}
+	}
+	for name, pkg := range packages {
+		for _, file := range pkg.Files {
+			addImports(name, file.Imports)
+		}
+		if pkg.Site != nil {
+			addImports(name, pkg.Site.Imports)
+		}
+	}
+
+	seen := make(map[string]struct{})
+	ret := make([]string, 0, len(changed))
+	queue := append([]string(nil), changed...)
+	for len(queue) > 0 {
+		cur := queue[0]
+		queue = queue[1:]
+		if _, ok := seen[cur]; ok {
+			continue
+		}
+		seen[cur] = struct{}{}
+		ret = append(ret, cur)
+		queue = append(queue, importers[cur]...)
+	}
+	return ret
+}
@@ -0,0 +1,111 @@
+package packages
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"testing"
+	"time"
+
+	"github.com/flyx/askew/data"
+)
+
+// inDir creates a temporary directory containing the given files and changes
+// into it while running fn.
+func inDir(t *testing.T, files map[string]string, fn func()) {
+	t.Helper()
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	dir, err := ioutil.TempDir("", "askew")
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer os.RemoveAll(dir)
+	for path, content := range files {
+		path = filepath.Join(dir, filepath.FromSlash(path))
+		if err = os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+			t.Fatal(err)
+		}
+		if err = ioutil.WriteFile(path, []byte(content), 0644); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err = os.Chdir(dir); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Chdir(wd)
+	fn()
+}
+
+func sorted(items []string) []string {
+	sort.Strings(items)
+	return items
+}
+
+func TestTakeSnapshot(t *testing.T) {
+	inDir(t, map[string]string{
+		"a/x.askew":       "",
+		"a/y.go":          "",
+		"b/z.askew.tmpl":  "",
+		"skip/w.askew":    "",
+		"c/d/site.asite":  "",
+		"c/d/README.md":   "",
+		"c/d/e/v.askew":   "",
+		"c/d/e/more.html": "",
+	}, func() {
+		s, err := TakeSnapshot([]string{"skip"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		var paths []string
+		for path := range s {
+			paths = append(paths, filepath.ToSlash(path))
+		}
+		expected := []string{"a/x.askew", "b/z.askew.tmpl", "c/d/e/v.askew", "c/d/site.asite"}
+		if !reflect.DeepEqual(sorted(paths), expected) {
+			t.Errorf("unexpected snapshot: %v", paths)
+		}
+		if tmpl := s.TemplatePackages(); !reflect.DeepEqual(tmpl, []string{"b"}) {
+			t.Errorf("unexpected template packages: %v", tmpl)
+		}
+	})
+}
+
+func TestChangedPackages(t *testing.T) {
+	t0 := time.Unix(1000, 0)
+	t1 := time.Unix(2000, 0)
+	old := Snapshot{"a/x.askew": t0, "b/y.askew": t0, "c/z.askew": t0, "d/w.askew": t0}
+	cur := Snapshot{"a/x.askew": t0, "b/y.askew": t1, "d/w.askew": t0, "e/v.askew": t0}
+	changed := sorted(cur.ChangedPackages(old))
+	if !reflect.DeepEqual(changed, []string{"b", "c", "e"}) {
+		t.Errorf("unexpected changed packages: %v", changed)
+	}
+	if changed = cur.ChangedPackages(cur); len(changed) != 0 {
+		t.Errorf("unexpected changes in unmodified snapshot: %v", changed)
+	}
+}
+
+func TestDependents(t *testing.T) {
+	pkgs := map[string]*data.Package{
+		"a": {Files: []*data.AskewFile{}},
+		"b": {Files: []*data.AskewFile{{File: data.File{
+			Imports: map[string]string{"a": "example.com/proj/a"}}}}},
+		"c": {Files: []*data.AskewFile{{File: data.File{
+			Imports: map[string]string{"b": "example.com/proj/b"}}}}},
+		"d": {Files: []*data.AskewFile{}},
+		".": {Site: &data.ASiteFile{File: data.File{
+			Imports: map[string]string{"c": "example.com/proj/c"}}}},
+	}
+	deps := sorted(Dependents("example.com/proj", pkgs, []string{"a"}))
+	if !reflect.DeepEqual(deps, []string{".", "a", "b", "c"}) {
+		t.Errorf("unexpected dependents of a: %v", deps)
+	}
+	deps = Dependents("example.com/proj", pkgs, []string{"d"})
+	if !reflect.DeepEqual(deps, []string{"d"}) {
+		t.Errorf("unexpected dependents of d: %v", deps)
+	}
+}
@@ -7,7 +7,7 @@ func AnalyseArguments(s string) (data.Arguments, error) {
 	p := GeneralParser{Buffer: s}
 	p.Init()
 	if err := p.Parse(int(ruleargs)); err != nil {
-		return data.Arguments{}, err
+		return data.Arguments{}, p.syntaxError(err)
 	}
 	p.Execute()
 	return data.Arguments{Raw: s, Count: len(p.names)}, nil
@@ -9,19 +9,21 @@ func ParseAssignments(s string) ([]data.Assignment, error) {
 	p := GeneralParser{Buffer: s}
 	p.Init()
 	if err := p.Parse(int(ruleassignments)); err != nil {
-		return nil, err
+		return nil, p.syntaxError(err)
 	}
 	p.Execute()
 	return p.assignments, nil
 }
 
-// ParseBindings parses a list of bindings in an a:bindings attribute
-func ParseBindings(s string) ([]data.VariableMapping, error) {
+// ParseBindings parses a list of bindings in an a:bindings attribute.
+// Returns the bindings to variables and the bindings to observables given
+// with `<-` or `<->`, whose expressions yield the observable.
+func ParseBindings(s string) ([]data.VariableMapping, []data.Assignment, error) {
 	p := GeneralParser{Buffer: s}
 	p.Init()
 	if err := p.Parse(int(rulebindings)); err != nil {
-		return nil, err
+		return nil, nil, p.syntaxError(err)
 	}
 	p.Execute()
-	return p.varMappings, nil
+	return p.varMappings, p.observed, nil
 }
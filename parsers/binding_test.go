@@ -0,0 +1,41 @@
+package parsers
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/flyx/askew/data"
+)
+
+func TestParseBindings(t *testing.T) {
+	vars, observed, err := ParseBindings(
+		"prop(value):Name, prop(textContent) <- o.Title, form(Age) <-> o.Age")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(vars) != 1 || vars[0].Variable.Name != "Name" ||
+		!reflect.DeepEqual(vars[0].Value, data.BoundValue{
+			Kind: data.BoundProperty, IDs: []string{"value"}}) {
+		t.Errorf("unexpected variable bindings: %+v", vars)
+	}
+	expected := []data.Assignment{
+		{Expression: "o.Title", Target: data.BoundValue{
+			Kind: data.BoundProperty, IDs: []string{"textContent"}}},
+		{Expression: "o.Age", Target: data.BoundValue{
+			Kind: data.BoundFormValue, IDs: []string{"Age"}}, TwoWay: true},
+	}
+	if !reflect.DeepEqual(observed, expected) {
+		t.Errorf("unexpected observed bindings: %+v", observed)
+	}
+}
+
+func TestParseBindingsErrors(t *testing.T) {
+	for _, input := range []string{
+		"prop(value) <-", "prop(value) <-> ", "prop(value) -> o.Name",
+		"prop(value):Name <- o.Name",
+	} {
+		if _, _, err := ParseBindings(input); err == nil {
+			t.Errorf("%q: expected error", input)
+		}
+	}
+}
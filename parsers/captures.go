@@ -1,6 +1,12 @@
 package parsers
 
-import "github.com/flyx/askew/data"
+import (
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/flyx/askew/data"
+)
 
 // ParseCapture parses the content of an a:capture attribute.
 func ParseCapture(s string) ([]data.UnboundEventMapping, error) {
@@ -8,8 +14,74 @@ func ParseCapture(s string) ([]data.UnboundEventMapping, error) {
 		eventHandling: data.AutoPreventDefault}
 	p.Init()
 	if err := p.Parse(int(rulecaptures)); err != nil {
-		return nil, err
+		return nil, p.syntaxError(err)
 	}
 	p.Execute()
 	return p.eventMappings, p.err
 }
+
+// mouseButtons maps the names usable in the button tag to values of a mouse
+// event's `button`.
+var mouseButtons = map[string]int{
+	"left": 0, "middle": 1, "right": 2, "back": 3, "forward": 4}
+
+// keyAliases maps names usable in the key tag to values of a keyboard event's
+// `key` that are not identifiers.
+var keyAliases = map[string]string{"Space": " "}
+
+// eventFilter creates the filter of a key or button tag with the given
+// parameters, which are keys or buttons and modifiers.
+func eventFilter(tag string, params []string) (*data.EventFilter, error) {
+	ret := &data.EventFilter{}
+	for _, p := range params {
+		switch p {
+		case "ctrl":
+			ret.Ctrl = true
+			continue
+		case "shift":
+			ret.Shift = true
+			continue
+		case "alt":
+			ret.Alt = true
+			continue
+		case "meta":
+			ret.Meta = true
+			continue
+		}
+		if tag == "button" {
+			b, ok := mouseButtons[p]
+			if !ok {
+				return nil, errors.New("unknown button: " + p +
+					" (must be left, middle, right, back or forward)")
+			}
+			ret.Buttons = append(ret.Buttons, b)
+		} else if alias, ok := keyAliases[p]; ok {
+			ret.Keys = append(ret.Keys, alias)
+		} else {
+			ret.Keys = append(ret.Keys, p)
+		}
+	}
+	if len(ret.Keys) == 0 && len(ret.Buttons) == 0 {
+		return nil, errors.New(tag + " requires at least one " + tag)
+	}
+	for i := range ret.Keys {
+		for j := range ret.Keys[:i] {
+			if strings.EqualFold(ret.Keys[i], ret.Keys[j]) {
+				return nil, errors.New("duplicate key: " + ret.Keys[i])
+			}
+		}
+	}
+	return ret, nil
+}
+
+// duration parses the parameter of a debounce or throttle tag.
+func duration(tag string, params []string) (time.Duration, error) {
+	if len(params) != 1 {
+		return 0, errors.New(tag + " requires exactly one duration")
+	}
+	d, err := time.ParseDuration(params[0])
+	if err != nil || d <= 0 {
+		return 0, errors.New("invalid duration for " + tag + ": " + params[0])
+	}
+	return d, nil
+}
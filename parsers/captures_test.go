@@ -0,0 +1,105 @@
+package parsers
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/flyx/askew/data"
+)
+
+func TestParseCaptureTags(t *testing.T) {
+	mappings, err := ParseCapture("click:Go(a=prop(value)) {once, passive, capture}, " +
+		"submit:Submit {preventDefault, stopPropagation, self}, " +
+		"input:Input {preventDefault(ask), stopImmediatePropagation}, " +
+		"change:Change {preventDefault(false)}")
+	if err != nil {
+		t.Fatal(err)
+	}
+	expected := []data.UnboundEventMapping{
+		{Event: "click", Handler: "Go", ParamMappings: map[string]data.BoundValue{
+			"a": {Kind: data.BoundProperty, IDs: []string{"value"}}},
+			Handling: data.AutoPreventDefault,
+			Options:  data.EventOptions{Once: true, Passive: true, Capture: true}},
+		{Event: "submit", Handler: "Submit", ParamMappings: map[string]data.BoundValue{},
+			Handling: data.PreventDefault,
+			Options:  data.EventOptions{StopPropagation: true, Self: true}},
+		{Event: "input", Handler: "Input", ParamMappings: map[string]data.BoundValue{},
+			Handling: data.AskPreventDefault,
+			Options:  data.EventOptions{StopImmediatePropagation: true}},
+		{Event: "change", Handler: "Change", ParamMappings: map[string]data.BoundValue{},
+			Handling: data.DontPreventDefault},
+	}
+	if !reflect.DeepEqual(mappings, expected) {
+		t.Errorf("unexpected mappings:\n%+v\nexpected:\n%+v", mappings, expected)
+	}
+}
+
+func TestParseCaptureFilters(t *testing.T) {
+	mappings, err := ParseCapture("keydown:Key {key(Enter, Space, ctrl, shift)}, " +
+		"click:Click {button(left, right, alt, meta), debounce(300ms)}, " +
+		"scroll:Scroll {throttle(1s)}")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f := mappings[0].Options.Filter; f == nil || !reflect.DeepEqual(*f, data.EventFilter{
+		Keys: []string{"Enter", " "}, Ctrl: true, Shift: true}) {
+		t.Errorf("unexpected key filter: %+v", f)
+	}
+	if f := mappings[1].Options.Filter; f == nil || !reflect.DeepEqual(*f, data.EventFilter{
+		Buttons: []int{0, 2}, Alt: true, Meta: true}) {
+		t.Errorf("unexpected button filter: %+v", f)
+	}
+	if mappings[1].Options.Debounce != 300*time.Millisecond ||
+		mappings[2].Options.Throttle != time.Second {
+		t.Errorf("unexpected durations: %v, %v", mappings[1].Options.Debounce,
+			mappings[2].Options.Throttle)
+	}
+}
+
+func TestParseCaptureErrors(t *testing.T) {
+	for _, c := range []struct {
+		input, err string
+	}{
+		{"click:Go {bogus}", "unknown tag: bogus"},
+		{"click:Go {once, once}", "duplicate once"},
+		{"click:Go {self(x)}", "too many parameters for self"},
+		{"click:Go {preventDefault, preventDefault(false)}", "duplicate preventDefault"},
+		{"click:Go {preventDefault(maybe)}", "unsupported value for preventDefault: maybe"},
+		{"click:Go {passive, preventDefault}", "passive listener cannot prevent default"},
+		{"click:Go {passive, preventDefault(ask)}", "passive listener cannot prevent default"},
+		{"click:Go {debounce(1s), preventDefault}", "debounced or throttled handler cannot prevent default"},
+		{"click:Go {key(a), button(left)}", "cannot have more than one of key and button"},
+		{"click:Go {button(top)}", "unknown button: top (must be left, middle, right, back or forward)"},
+		{"click:Go {key(ctrl)}", "key requires at least one key"},
+		{"click:Go {key(a, A)}", "duplicate key: A"},
+		{"click:Go {debounce(1s), throttle(1s)}", "cannot have more than one of debounce and throttle"},
+		{"click:Go {debounce(soon)}", "invalid duration for debounce: soon"},
+		{"click:Go {throttle()}", "throttle requires exactly one duration"},
+	} {
+		_, err := ParseCapture(c.input)
+		if err == nil || err.Error() != c.err {
+			t.Errorf("%q: expected error %q, got %v", c.input, c.err, err)
+		}
+	}
+}
+
+func TestParseCaptureQuotedKeys(t *testing.T) {
+	mappings, err := ParseCapture(`keydown:Key {key('?', ',', ")", "'", Enter, 'Space')}, ` +
+		`input:Input {debounce('1.5s')}`)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if f := mappings[0].Options.Filter; f == nil || !reflect.DeepEqual(f.Keys,
+		[]string{"?", ",", ")", "'", "Enter", " "}) {
+		t.Errorf("unexpected key filter: %+v", f)
+	}
+	if d := mappings[1].Options.Debounce; d != 1500*time.Millisecond {
+		t.Errorf("unexpected duration: %v", d)
+	}
+	for _, input := range []string{"k:K {key('')}", "k:K {key('?)}", "k:K {key(?)}"} {
+		if _, err := ParseCapture(input); err == nil {
+			t.Errorf("%q: expected error", input)
+		}
+	}
+}
@@ -0,0 +1,56 @@
+package parsers
+
+import (
+	"errors"
+	"strconv"
+
+	"github.com/flyx/askew/data"
+)
+
+// Error is a syntax error in a parsed string.
+type Error struct {
+	// Offset is the byte offset in the parsed string at which parsing failed.
+	Offset int
+	Msg    string
+}
+
+func (e *Error) Error() string {
+	return e.Msg
+}
+
+// Wrap prefixes the message of err with the given prefix.
+// If err is an *Error, the result is an *Error with the same offset.
+func Wrap(prefix string, err error) error {
+	if pe, ok := err.(*Error); ok {
+		return &Error{Offset: pe.Offset, Msg: prefix + pe.Msg}
+	}
+	return errors.New(prefix + err.Error())
+}
+
+// TextError wraps err, which occurred while parsing the given text content of
+// a node, so that it can be located in the source. The message of err is
+// prefixed with the given prefix.
+func TextError(prefix, text string, err error) error {
+	ret := &data.TextError{Text: text, Offset: -1, Err: errors.New(prefix + err.Error())}
+	if pe, ok := err.(*Error); ok {
+		ret.Offset = pe.Offset
+	}
+	return ret
+}
+
+// syntaxError converts an error returned by the generated parser into an
+// *Error that points at the first character that could not be parsed.
+func (p *GeneralParser) syntaxError(err error) error {
+	pe, ok := err.(*parseError)
+	if !ok {
+		return err
+	}
+	end := int(pe.max.end)
+	ret := &Error{Offset: len(string(p.buffer[:end]))}
+	if end >= len(p.buffer) || p.buffer[end] == endSymbol {
+		ret.Msg = "parse error at end of input"
+	} else {
+		ret.Msg = "parse error near " + strconv.QuoteRune(p.buffer[end])
+	}
+	return ret
+}
@@ -9,7 +9,7 @@ func ParseFields(s string) ([]*data.Field, error) {
 	p := GeneralParser{Buffer: s}
 	p.Init()
 	if err := p.Parse(int(rulefields)); err != nil {
-		return nil, err
+		return nil, p.syntaxError(err)
 	}
 	p.Execute()
 	return p.fields, nil
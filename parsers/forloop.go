@@ -7,7 +7,7 @@ func ParseFor(s string) (*data.ControlBlock, error) {
 	p := GeneralParser{Buffer: s}
 	p.Init()
 	if err := p.Parse(int(rulefor)); err != nil {
-		return nil, err
+		return nil, p.syntaxError(err)
 	}
 	p.Execute()
 	ret := &data.ControlBlock{
@@ -3,6 +3,7 @@ package parsers
 import (
 	"errors"
 	"strings"
+	"time"
 	"github.com/flyx/askew/data"
 	"fmt"
 	"math"
@@ -21,6 +22,9 @@ const (
 	ruleassignments
 	rulebindings
 	rulebinding
+	rulevarbinding
+	rulemodeling
+	ruleobserving
 	ruleautovar
 	ruletypedvar
 	ruleisp
@@ -34,6 +38,7 @@ const (
 	ruleform
 	rulegoExpr
 	ruleevent
+	ruledetail
 	rulehtmlid
 	rulejsid
 	ruleexpr
@@ -86,9 +91,9 @@ const (
 	ruleimports
 	ruleimport
 	ruleAction0
-	rulePegText
 	ruleAction1
 	ruleAction2
+	rulePegText
 	ruleAction3
 	ruleAction4
 	ruleAction5
@@ -128,6 +133,9 @@ const (
 	ruleAction39
 	ruleAction40
 	ruleAction41
+	ruleAction42
+	ruleAction43
+	ruleAction44
 
 	rulePre
 	ruleIn
@@ -140,6 +148,9 @@ var rul3s = [...]string{
 	"assignments",
 	"bindings",
 	"binding",
+	"varbinding",
+	"modeling",
+	"observing",
 	"autovar",
 	"typedvar",
 	"isp",
@@ -153,6 +164,7 @@ var rul3s = [...]string{
 	"form",
 	"goExpr",
 	"event",
+	"detail",
 	"htmlid",
 	"jsid",
 	"expr",
@@ -205,9 +217,9 @@ var rul3s = [...]string{
 	"imports",
 	"import",
 	"Action0",
-	"PegText",
 	"Action1",
 	"Action2",
+	"PegText",
 	"Action3",
 	"Action4",
 	"Action5",
@@ -247,6 +259,9 @@ var rul3s = [...]string{
 	"Action39",
 	"Action40",
 	"Action41",
+	"Action42",
+	"Action43",
+	"Action44",
 
 	"Pre_",
 	"_In_",
@@ -539,6 +554,7 @@ func (t *tokens32) Expand(index int) {
 
 type GeneralParser struct {
 	eventHandling                         data.EventHandling
+	eventOptions                          data.EventOptions
 	expr, tagname, handlername, eventName string
 	paramnames                            []string
 	names                                 []string
@@ -554,6 +570,7 @@ type GeneralParser struct {
 
 	assignments   []data.Assignment
 	varMappings   []data.VariableMapping
+	observed      []data.Assignment
 	eventMappings []data.UnboundEventMapping
 	handlers      []HandlerSpec
 	cParams       []data.ComponentParam
@@ -561,7 +578,7 @@ type GeneralParser struct {
 
 	Buffer string
 	buffer []rune
-	rules  [112]func() bool
+	rules  [119]func() bool
 	Parse  func(rule ...int) error
 	Reset  func()
 	Pretty bool
@@ -654,68 +671,84 @@ func (p *GeneralParser) Execute() {
 
 		case ruleAction1:
 
-			p.goVal.Name = buffer[begin:end]
+			p.observed = append(p.observed, data.Assignment{Expression: p.expr,
+				Target: p.bv, TwoWay: true})
+			p.bv.IDs = nil
 
 		case ruleAction2:
 
+			p.observed = append(p.observed, data.Assignment{Expression: p.expr,
+				Target: p.bv})
+			p.bv.IDs = nil
+
+		case ruleAction3:
+
+			p.goVal.Name = buffer[begin:end]
+
+		case ruleAction4:
+
 			p.goVal.Type = p.valuetype
 			p.valuetype = nil
 
-		case ruleAction3:
+		case ruleAction5:
 
 			p.assignments = append(p.assignments, data.Assignment{Expression: p.expr,
 				Target: p.bv})
 			p.bv.IDs = nil
 
-		case ruleAction4:
+		case ruleAction6:
 
 			p.bv.Kind = data.BoundSelf
 
-		case ruleAction5:
+		case ruleAction7:
 
 			p.bv.Kind = data.BoundDataset
 
-		case ruleAction6:
+		case ruleAction8:
 
 			p.bv.Kind = data.BoundProperty
 
-		case ruleAction7:
+		case ruleAction9:
 
 			p.bv.Kind = data.BoundStyle
 
-		case ruleAction8:
+		case ruleAction10:
 
 			p.bv.Kind = data.BoundClass
 
-		case ruleAction9:
+		case ruleAction11:
 
 			p.bv.Kind = data.BoundFormValue
 
-		case ruleAction10:
+		case ruleAction12:
 
 			p.bv.Kind = data.BoundExpr
 			p.bv.IDs = append(p.bv.IDs, p.expr)
 
-		case ruleAction11:
+		case ruleAction13:
 
 			p.bv.Kind = data.BoundEventValue
 			if len(p.bv.IDs) == 0 {
 				p.bv.IDs = append(p.bv.IDs, "")
 			}
 
-		case ruleAction12:
+		case ruleAction14:
+
+			p.bv.Kind = data.BoundEventDetail
+
+		case ruleAction15:
 
 			p.bv.IDs = append(p.bv.IDs, buffer[begin:end])
 
-		case ruleAction13:
+		case ruleAction16:
 
 			p.bv.IDs = append(p.bv.IDs, buffer[begin:end])
 
-		case ruleAction14:
+		case ruleAction17:
 
 			p.expr = buffer[begin:end]
 
-		case ruleAction15:
+		case ruleAction18:
 
 			var expr *string
 			if p.expr != "" {
@@ -729,11 +762,11 @@ func (p *GeneralParser) Execute() {
 			p.valuetype = nil
 			p.names = nil
 
-		case ruleAction16:
+		case ruleAction19:
 
 			p.names = append(p.names, buffer[begin:end])
 
-		case ruleAction17:
+		case ruleAction20:
 
 			switch name := buffer[begin:end]; name {
 			case "int":
@@ -746,7 +779,7 @@ func (p *GeneralParser) Execute() {
 				p.valuetype = &data.ParamType{Kind: data.NamedType, Name: name}
 			}
 
-		case ruleAction18:
+		case ruleAction21:
 
 			name := buffer[begin:end]
 			if name == "js.Value" {
@@ -755,55 +788,66 @@ func (p *GeneralParser) Execute() {
 				p.valuetype = &data.ParamType{Kind: data.NamedType, Name: name}
 			}
 
-		case ruleAction19:
+		case ruleAction22:
 
 			p.valuetype = &data.ParamType{Kind: data.ArrayType, ValueType: p.valuetype}
 
-		case ruleAction20:
+		case ruleAction23:
 
 			p.valuetype = &data.ParamType{Kind: data.MapType, KeyType: p.keytype, ValueType: p.valuetype}
 
-		case ruleAction21:
+		case ruleAction24:
 
 			p.valuetype = &data.ParamType{Kind: data.ChanType, ValueType: p.valuetype}
 
-		case ruleAction22:
+		case ruleAction25:
 
 			p.valuetype = &data.ParamType{Kind: data.FuncType, ValueType: p.valuetype,
 				Params: p.params}
 			p.params = nil
 
-		case ruleAction23:
+		case ruleAction26:
 
 			p.keytype = p.valuetype
 
-		case ruleAction24:
+		case ruleAction27:
 
 			p.valuetype = &data.ParamType{Kind: data.PointerType, ValueType: p.valuetype}
 
-		case ruleAction25:
+		case ruleAction28:
 
+			if p.eventOptions.Passive && (p.eventHandling == data.PreventDefault ||
+				p.eventHandling == data.AskPreventDefault) {
+				p.err = errors.New("passive listener cannot prevent default")
+				return
+			}
+			if p.eventOptions.IsLimited() && (p.eventHandling == data.PreventDefault ||
+				p.eventHandling == data.AskPreventDefault) {
+				p.err = errors.New("debounced or throttled handler cannot prevent default")
+				return
+			}
 			p.eventMappings = append(p.eventMappings, data.UnboundEventMapping{
 				Event: p.eventName, Handler: p.handlername, ParamMappings: p.paramMappings,
-				Handling: p.eventHandling})
+				Handling: p.eventHandling, Options: p.eventOptions})
 			p.eventHandling = data.AutoPreventDefault
+			p.eventOptions = data.EventOptions{}
 			p.expr = ""
 			p.paramMappings = make(map[string]data.BoundValue)
 
-		case ruleAction26:
+		case ruleAction29:
 
 			p.handlername = buffer[begin:end]
 
-		case ruleAction27:
+		case ruleAction30:
 
 			p.eventName = buffer[begin:end]
 
-		case ruleAction28:
+		case ruleAction31:
 
 			p.paramIndex = 0
 			p.tagname = ""
 
-		case ruleAction29:
+		case ruleAction32:
 
 			if p.tagname == "" {
 				if p.paramIndex == -1 {
@@ -823,11 +867,11 @@ func (p *GeneralParser) Execute() {
 			p.tagname = ""
 			p.bv.IDs = nil
 
-		case ruleAction30:
+		case ruleAction33:
 
 			p.tagname = buffer[begin:end]
 
-		case ruleAction31:
+		case ruleAction34:
 
 			switch p.tagname {
 			case "preventDefault":
@@ -854,36 +898,89 @@ func (p *GeneralParser) Execute() {
 					p.err = errors.New("too many parameters for preventDefault")
 					return
 				}
+			case "key", "button":
+				if p.eventOptions.Filter != nil {
+					p.err = errors.New("cannot have more than one of key and button")
+					return
+				}
+				p.eventOptions.Filter, p.err = eventFilter(p.tagname, p.names)
+				if p.err != nil {
+					return
+				}
+			case "debounce", "throttle":
+				if p.eventOptions.IsLimited() {
+					p.err = errors.New("cannot have more than one of debounce and throttle")
+					return
+				}
+				var d time.Duration
+				if d, p.err = duration(p.tagname, p.names); p.err != nil {
+					return
+				}
+				if p.tagname == "debounce" {
+					p.eventOptions.Debounce = d
+				} else {
+					p.eventOptions.Throttle = d
+				}
+			case "once", "passive", "capture", "stopPropagation",
+				"stopImmediatePropagation", "self":
+				if len(p.names) != 0 {
+					p.err = errors.New("too many parameters for " + p.tagname)
+					return
+				}
+				var flag *bool
+				switch p.tagname {
+				case "once":
+					flag = &p.eventOptions.Once
+				case "passive":
+					flag = &p.eventOptions.Passive
+				case "capture":
+					flag = &p.eventOptions.Capture
+				case "stopPropagation":
+					flag = &p.eventOptions.StopPropagation
+				case "stopImmediatePropagation":
+					flag = &p.eventOptions.StopImmediatePropagation
+				default:
+					flag = &p.eventOptions.Self
+				}
+				if *flag {
+					p.err = errors.New("duplicate " + p.tagname)
+					return
+				}
+				*flag = true
 			default:
 				p.err = errors.New("unknown tag: " + p.tagname)
 				return
 			}
 			p.names = nil
 
-		case ruleAction32:
+		case ruleAction35:
 
 			p.tagname = buffer[begin:end]
 
-		case ruleAction33:
+		case ruleAction36:
 
 			p.names = append(p.names, buffer[begin:end])
 
-		case ruleAction34:
+		case ruleAction37:
 
 			p.names = append(p.names, buffer[begin:end])
 
-		case ruleAction35:
+		case ruleAction38:
 
+			if err := checkName(p.handlername); err != nil {
+				p.err = err
+				return
+			}
 			p.handlers = append(p.handlers, HandlerSpec{
 				Name: p.handlername, Params: p.params, Returns: p.valuetype})
 			p.valuetype = nil
 			p.params = nil
 
-		case ruleAction36:
+		case ruleAction39:
 
 			p.paramnames = append(p.paramnames, buffer[begin:end])
 
-		case ruleAction37:
+		case ruleAction40:
 
 			name := p.paramnames[len(p.paramnames)-1]
 			p.paramnames = p.paramnames[:len(p.paramnames)-1]
@@ -897,22 +994,22 @@ func (p *GeneralParser) Execute() {
 			p.params = append(p.params, data.Param{Name: name, Type: p.valuetype})
 			p.valuetype = nil
 
-		case ruleAction38:
+		case ruleAction41:
 
 			p.cParams = append(p.cParams, data.ComponentParam{
 				Name: p.tagname, Type: *p.valuetype, IsVar: p.isVar})
 			p.valuetype = nil
 			p.isVar = false
 
-		case ruleAction39:
+		case ruleAction42:
 
 			p.isVar = true
 
-		case ruleAction40:
+		case ruleAction43:
 
 			p.names = append(p.names, p.expr)
 
-		case ruleAction41:
+		case ruleAction44:
 
 			path := buffer[begin:end]
 			if p.tagname == "" {
@@ -1249,7 +1346,7 @@ func (p *GeneralParser) Init() {
 			position, tokenIndex, depth = position26, tokenIndex26, depth26
 			return false
 		},
-		/* 3 binding <- <(bound isp* ':' isp* (autovar / typedvar) Action0)> */
+		/* 3 binding <- <(bound isp* (varbinding / modeling / observing))> */
 		func() bool {
 			position41, tokenIndex41, depth41 := position, tokenIndex, depth
 			{
@@ -1268,36 +1365,25 @@ func (p *GeneralParser) Init() {
 				l44:
 					position, tokenIndex, depth = position44, tokenIndex44, depth44
 				}
-				if buffer[position] != rune(':') {
-					goto l41
-				}
-				position++
-			l45:
 				{
-					position46, tokenIndex46, depth46 := position, tokenIndex, depth
-					if !_rules[ruleisp]() {
+					position45, tokenIndex45, depth45 := position, tokenIndex, depth
+					if !_rules[rulevarbinding]() {
 						goto l46
 					}
 					goto l45
 				l46:
-					position, tokenIndex, depth = position46, tokenIndex46, depth46
-				}
-				{
-					position47, tokenIndex47, depth47 := position, tokenIndex, depth
-					if !_rules[ruleautovar]() {
-						goto l48
+					position, tokenIndex, depth = position45, tokenIndex45, depth45
+					if !_rules[rulemodeling]() {
+						goto l47
 					}
-					goto l47
-				l48:
-					position, tokenIndex, depth = position47, tokenIndex47, depth47
-					if !_rules[ruletypedvar]() {
+					goto l45
+				l47:
+					position, tokenIndex, depth = position45, tokenIndex45, depth45
+					if !_rules[ruleobserving]() {
 						goto l41
 					}
 				}
-			l47:
-				if !_rules[ruleAction0]() {
-					goto l41
-				}
+			l45:
 				depth--
 				add(rulebinding, position42)
 			}
@@ -1306,58 +1392,68 @@ func (p *GeneralParser) Init() {
 			position, tokenIndex, depth = position41, tokenIndex41, depth41
 			return false
 		},
-		/* 4 autovar <- <(<identifier> Action1)> */
+		/* 4 varbinding <- <(':' isp* (autovar / typedvar) Action0)> */
 		func() bool {
-			position49, tokenIndex49, depth49 := position, tokenIndex, depth
+			position48, tokenIndex48, depth48 := position, tokenIndex, depth
 			{
-				position50 := position
+				position49 := position
 				depth++
+				if buffer[position] != rune(':') {
+					goto l48
+				}
+				position++
+			l50:
 				{
-					position51 := position
-					depth++
-					if !_rules[ruleidentifier]() {
-						goto l49
+					position51, tokenIndex51, depth51 := position, tokenIndex, depth
+					if !_rules[ruleisp]() {
+						goto l51
 					}
-					depth--
-					add(rulePegText, position51)
+					goto l50
+				l51:
+					position, tokenIndex, depth = position51, tokenIndex51, depth51
 				}
-				if !_rules[ruleAction1]() {
-					goto l49
+				{
+					position52, tokenIndex52, depth52 := position, tokenIndex, depth
+					if !_rules[ruleautovar]() {
+						goto l53
+					}
+					goto l52
+				l53:
+					position, tokenIndex, depth = position52, tokenIndex52, depth52
+					if !_rules[ruletypedvar]() {
+						goto l48
+					}
+				}
+			l52:
+				if !_rules[ruleAction0]() {
+					goto l48
 				}
 				depth--
-				add(ruleautovar, position50)
+				add(rulevarbinding, position49)
 			}
 			return true
-		l49:
-			position, tokenIndex, depth = position49, tokenIndex49, depth49
+		l48:
+			position, tokenIndex, depth = position48, tokenIndex48, depth48
 			return false
 		},
-		/* 5 typedvar <- <('(' isp* autovar isp+ type isp* ')' Action2)> */
+		/* 5 modeling <- <('<' '-' '>' isp* expr Action1)> */
 		func() bool {
-			position52, tokenIndex52, depth52 := position, tokenIndex, depth
+			position54, tokenIndex54, depth54 := position, tokenIndex, depth
 			{
-				position53 := position
+				position55 := position
 				depth++
-				if buffer[position] != rune('(') {
-					goto l52
+				if buffer[position] != rune('<') {
+					goto l54
 				}
 				position++
-			l54:
-				{
-					position55, tokenIndex55, depth55 := position, tokenIndex, depth
-					if !_rules[ruleisp]() {
-						goto l55
-					}
+				if buffer[position] != rune('-') {
 					goto l54
-				l55:
-					position, tokenIndex, depth = position55, tokenIndex55, depth55
-				}
-				if !_rules[ruleautovar]() {
-					goto l52
 				}
-				if !_rules[ruleisp]() {
-					goto l52
+				position++
+				if buffer[position] != rune('>') {
+					goto l54
 				}
+				position++
 			l56:
 				{
 					position57, tokenIndex57, depth57 := position, tokenIndex, depth
@@ -1368,82 +1464,104 @@ func (p *GeneralParser) Init() {
 				l57:
 					position, tokenIndex, depth = position57, tokenIndex57, depth57
 				}
-				if !_rules[ruletype]() {
-					goto l52
+				if !_rules[ruleexpr]() {
+					goto l54
+				}
+				if !_rules[ruleAction1]() {
+					goto l54
+				}
+				depth--
+				add(rulemodeling, position55)
+			}
+			return true
+		l54:
+			position, tokenIndex, depth = position54, tokenIndex54, depth54
+			return false
+		},
+		/* 6 observing <- <('<' '-' !'>' isp* expr Action2)> */
+		func() bool {
+			position58, tokenIndex58, depth58 := position, tokenIndex, depth
+			{
+				position59 := position
+				depth++
+				if buffer[position] != rune('<') {
+					goto l58
+				}
+				position++
+				if buffer[position] != rune('-') {
+					goto l58
 				}
-			l58:
+				position++
 				{
-					position59, tokenIndex59, depth59 := position, tokenIndex, depth
-					if !_rules[ruleisp]() {
-						goto l59
+					position60, tokenIndex60, depth60 := position, tokenIndex, depth
+					if buffer[position] != rune('>') {
+						goto l60
 					}
+					position++
 					goto l58
-				l59:
-					position, tokenIndex, depth = position59, tokenIndex59, depth59
+				l60:
+					position, tokenIndex, depth = position60, tokenIndex60, depth60
 				}
-				if buffer[position] != rune(')') {
-					goto l52
+			l61:
+				{
+					position62, tokenIndex62, depth62 := position, tokenIndex, depth
+					if !_rules[ruleisp]() {
+						goto l62
+					}
+					goto l61
+				l62:
+					position, tokenIndex, depth = position62, tokenIndex62, depth62
+				}
+				if !_rules[ruleexpr]() {
+					goto l58
 				}
-				position++
 				if !_rules[ruleAction2]() {
-					goto l52
+					goto l58
 				}
 				depth--
-				add(ruletypedvar, position53)
+				add(ruleobserving, position59)
 			}
 			return true
-		l52:
-			position, tokenIndex, depth = position52, tokenIndex52, depth52
+		l58:
+			position, tokenIndex, depth = position58, tokenIndex58, depth58
 			return false
 		},
-		/* 6 isp <- <(' ' / '\t')> */
+		/* 7 autovar <- <(<identifier> Action3)> */
 		func() bool {
-			position60, tokenIndex60, depth60 := position, tokenIndex, depth
+			position63, tokenIndex63, depth63 := position, tokenIndex, depth
 			{
-				position61 := position
+				position64 := position
 				depth++
 				{
-					position62, tokenIndex62, depth62 := position, tokenIndex, depth
-					if buffer[position] != rune(' ') {
+					position65 := position
+					depth++
+					if !_rules[ruleidentifier]() {
 						goto l63
 					}
-					position++
-					goto l62
-				l63:
-					position, tokenIndex, depth = position62, tokenIndex62, depth62
-					if buffer[position] != rune('\t') {
-						goto l60
-					}
-					position++
+					depth--
+					add(rulePegText, position65)
+				}
+				if !_rules[ruleAction3]() {
+					goto l63
 				}
-			l62:
 				depth--
-				add(ruleisp, position61)
+				add(ruleautovar, position64)
 			}
 			return true
-		l60:
-			position, tokenIndex, depth = position60, tokenIndex60, depth60
+		l63:
+			position, tokenIndex, depth = position63, tokenIndex63, depth63
 			return false
 		},
-		/* 7 assignment <- <(isp* bound isp* '=' isp* expr Action3)> */
+		/* 8 typedvar <- <('(' isp* autovar isp+ type isp* ')' Action4)> */
 		func() bool {
-			position64, tokenIndex64, depth64 := position, tokenIndex, depth
+			position66, tokenIndex66, depth66 := position, tokenIndex, depth
 			{
-				position65 := position
+				position67 := position
 				depth++
-			l66:
-				{
-					position67, tokenIndex67, depth67 := position, tokenIndex, depth
-					if !_rules[ruleisp]() {
-						goto l67
-					}
+				if buffer[position] != rune('(') {
 					goto l66
-				l67:
-					position, tokenIndex, depth = position67, tokenIndex67, depth67
-				}
-				if !_rules[rulebound]() {
-					goto l64
 				}
+				position++
 			l68:
 				{
 					position69, tokenIndex69, depth69 := position, tokenIndex, depth
@@ -1454,10 +1572,12 @@ func (p *GeneralParser) Init() {
 				l69:
 					position, tokenIndex, depth = position69, tokenIndex69, depth69
 				}
-				if buffer[position] != rune('=') {
-					goto l64
+				if !_rules[ruleautovar]() {
+					goto l66
+				}
+				if !_rules[ruleisp]() {
+					goto l66
 				}
-				position++
 			l70:
 				{
 					position71, tokenIndex71, depth71 := position, tokenIndex, depth
@@ -1468,4848 +1588,5279 @@ func (p *GeneralParser) Init() {
 				l71:
 					position, tokenIndex, depth = position71, tokenIndex71, depth71
 				}
-				if !_rules[ruleexpr]() {
-					goto l64
+				if !_rules[ruletype]() {
+					goto l66
 				}
-				if !_rules[ruleAction3]() {
-					goto l64
+			l72:
+				{
+					position73, tokenIndex73, depth73 := position, tokenIndex, depth
+					if !_rules[ruleisp]() {
+						goto l73
+					}
+					goto l72
+				l73:
+					position, tokenIndex, depth = position73, tokenIndex73, depth73
+				}
+				if buffer[position] != rune(')') {
+					goto l66
+				}
+				position++
+				if !_rules[ruleAction4]() {
+					goto l66
 				}
 				depth--
-				add(ruleassignment, position65)
+				add(ruletypedvar, position67)
 			}
 			return true
-		l64:
-			position, tokenIndex, depth = position64, tokenIndex64, depth64
+		l66:
+			position, tokenIndex, depth = position66, tokenIndex66, depth66
 			return false
 		},
-		/* 8 bound <- <(self / ((&('E' | 'e') event) | (&('F' | 'f') form) | (&('G' | 'g') goExpr) | (&('C' | 'c') class) | (&('S' | 's') style) | (&('P' | 'p') prop) | (&('D' | 'd') dataset)))> */
+		/* 9 isp <- <(' ' / '\t')> */
 		func() bool {
-			position72, tokenIndex72, depth72 := position, tokenIndex, depth
+			position74, tokenIndex74, depth74 := position, tokenIndex, depth
 			{
-				position73 := position
+				position75 := position
 				depth++
 				{
-					position74, tokenIndex74, depth74 := position, tokenIndex, depth
-					if !_rules[ruleself]() {
-						goto l75
+					position76, tokenIndex76, depth76 := position, tokenIndex, depth
+					if buffer[position] != rune(' ') {
+						goto l77
 					}
-					goto l74
-				l75:
-					position, tokenIndex, depth = position74, tokenIndex74, depth74
-					{
-						switch buffer[position] {
-						case 'E', 'e':
-							if !_rules[ruleevent]() {
-								goto l72
-							}
-							break
-						case 'F', 'f':
-							if !_rules[ruleform]() {
-								goto l72
-							}
-							break
-						case 'G', 'g':
-							if !_rules[rulegoExpr]() {
-								goto l72
-							}
+					position++
+					goto l76
+				l77:
+					position, tokenIndex, depth = position76, tokenIndex76, depth76
+					if buffer[position] != rune('\t') {
+						goto l74
+					}
+					position++
+				}
+			l76:
+				depth--
+				add(ruleisp, position75)
+			}
+			return true
+		l74:
+			position, tokenIndex, depth = position74, tokenIndex74, depth74
+			return false
+		},
+		/* 10 assignment <- <(isp* bound isp* '=' isp* expr Action5)> */
+		func() bool {
+			position78, tokenIndex78, depth78 := position, tokenIndex, depth
+			{
+				position79 := position
+				depth++
+			l80:
+				{
+					position81, tokenIndex81, depth81 := position, tokenIndex, depth
+					if !_rules[ruleisp]() {
+						goto l81
+					}
+					goto l80
+				l81:
+					position, tokenIndex, depth = position81, tokenIndex81, depth81
+				}
+				if !_rules[rulebound]() {
+					goto l78
+				}
+			l82:
+				{
+					position83, tokenIndex83, depth83 := position, tokenIndex, depth
+					if !_rules[ruleisp]() {
+						goto l83
+					}
+					goto l82
+				l83:
+					position, tokenIndex, depth = position83, tokenIndex83, depth83
+				}
+				if buffer[position] != rune('=') {
+					goto l78
+				}
+				position++
+			l84:
+				{
+					position85, tokenIndex85, depth85 := position, tokenIndex, depth
+					if !_rules[ruleisp]() {
+						goto l85
+					}
+					goto l84
+				l85:
+					position, tokenIndex, depth = position85, tokenIndex85, depth85
+				}
+				if !_rules[ruleexpr]() {
+					goto l78
+				}
+				if !_rules[ruleAction5]() {
+					goto l78
+				}
+				depth--
+				add(ruleassignment, position79)
+			}
+			return true
+		l78:
+			position, tokenIndex, depth = position78, tokenIndex78, depth78
+			return false
+		},
+		/* 11 bound <- <(self / dataset / ((&('D' | 'd') detail) | (&('E' | 'e') event) | (&('F' | 'f') form) | (&('G' | 'g') goExpr) | (&('C' | 'c') class) | (&('S' | 's') style) | (&('P' | 'p') prop)))> */
+		func() bool {
+			position86, tokenIndex86, depth86 := position, tokenIndex, depth
+			{
+				position87 := position
+				depth++
+				{
+					position88, tokenIndex88, depth88 := position, tokenIndex, depth
+					if !_rules[ruleself]() {
+						goto l89
+					}
+					goto l88
+				l89:
+					position, tokenIndex, depth = position88, tokenIndex88, depth88
+					if !_rules[ruledataset]() {
+						goto l90
+					}
+					goto l88
+				l90:
+					position, tokenIndex, depth = position88, tokenIndex88, depth88
+					{
+						switch buffer[position] {
+						case 'D', 'd':
+							if !_rules[ruledetail]() {
+								goto l86
+							}
+							break
+						case 'E', 'e':
+							if !_rules[ruleevent]() {
+								goto l86
+							}
+							break
+						case 'F', 'f':
+							if !_rules[ruleform]() {
+								goto l86
+							}
+							break
+						case 'G', 'g':
+							if !_rules[rulegoExpr]() {
+								goto l86
+							}
 							break
 						case 'C', 'c':
 							if !_rules[ruleclass]() {
-								goto l72
+								goto l86
 							}
 							break
 						case 'S', 's':
 							if !_rules[rulestyle]() {
-								goto l72
-							}
-							break
-						case 'P', 'p':
-							if !_rules[ruleprop]() {
-								goto l72
+								goto l86
 							}
 							break
 						default:
-							if !_rules[ruledataset]() {
-								goto l72
+							if !_rules[ruleprop]() {
+								goto l86
 							}
 							break
 						}
 					}
 
 				}
-			l74:
+			l88:
 				depth--
-				add(rulebound, position73)
+				add(rulebound, position87)
 			}
 			return true
-		l72:
-			position, tokenIndex, depth = position72, tokenIndex72, depth72
+		l86:
+			position, tokenIndex, depth = position86, tokenIndex86, depth86
 			return false
 		},
-		/* 9 self <- <(('s' / 'S') ('e' / 'E') ('l' / 'L') ('f' / 'F') isp* '(' isp* ')' Action4)> */
+		/* 12 self <- <(('s' / 'S') ('e' / 'E') ('l' / 'L') ('f' / 'F') isp* '(' isp* ')' Action6)> */
 		func() bool {
-			position77, tokenIndex77, depth77 := position, tokenIndex, depth
+			position92, tokenIndex92, depth92 := position, tokenIndex, depth
 			{
-				position78 := position
+				position93 := position
 				depth++
 				{
-					position79, tokenIndex79, depth79 := position, tokenIndex, depth
+					position94, tokenIndex94, depth94 := position, tokenIndex, depth
 					if buffer[position] != rune('s') {
-						goto l80
+						goto l95
 					}
 					position++
-					goto l79
-				l80:
-					position, tokenIndex, depth = position79, tokenIndex79, depth79
+					goto l94
+				l95:
+					position, tokenIndex, depth = position94, tokenIndex94, depth94
 					if buffer[position] != rune('S') {
-						goto l77
+						goto l92
 					}
 					position++
 				}
-			l79:
+			l94:
 				{
-					position81, tokenIndex81, depth81 := position, tokenIndex, depth
+					position96, tokenIndex96, depth96 := position, tokenIndex, depth
 					if buffer[position] != rune('e') {
-						goto l82
+						goto l97
 					}
 					position++
-					goto l81
-				l82:
-					position, tokenIndex, depth = position81, tokenIndex81, depth81
+					goto l96
+				l97:
+					position, tokenIndex, depth = position96, tokenIndex96, depth96
 					if buffer[position] != rune('E') {
-						goto l77
+						goto l92
 					}
 					position++
 				}
-			l81:
+			l96:
 				{
-					position83, tokenIndex83, depth83 := position, tokenIndex, depth
+					position98, tokenIndex98, depth98 := position, tokenIndex, depth
 					if buffer[position] != rune('l') {
-						goto l84
+						goto l99
 					}
 					position++
-					goto l83
-				l84:
-					position, tokenIndex, depth = position83, tokenIndex83, depth83
+					goto l98
+				l99:
+					position, tokenIndex, depth = position98, tokenIndex98, depth98
 					if buffer[position] != rune('L') {
-						goto l77
+						goto l92
 					}
 					position++
 				}
-			l83:
+			l98:
 				{
-					position85, tokenIndex85, depth85 := position, tokenIndex, depth
+					position100, tokenIndex100, depth100 := position, tokenIndex, depth
 					if buffer[position] != rune('f') {
-						goto l86
+						goto l101
 					}
 					position++
-					goto l85
-				l86:
-					position, tokenIndex, depth = position85, tokenIndex85, depth85
+					goto l100
+				l101:
+					position, tokenIndex, depth = position100, tokenIndex100, depth100
 					if buffer[position] != rune('F') {
-						goto l77
+						goto l92
 					}
 					position++
 				}
-			l85:
-			l87:
+			l100:
+			l102:
 				{
-					position88, tokenIndex88, depth88 := position, tokenIndex, depth
+					position103, tokenIndex103, depth103 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l88
+						goto l103
 					}
-					goto l87
-				l88:
-					position, tokenIndex, depth = position88, tokenIndex88, depth88
+					goto l102
+				l103:
+					position, tokenIndex, depth = position103, tokenIndex103, depth103
 				}
 				if buffer[position] != rune('(') {
-					goto l77
+					goto l92
 				}
 				position++
-			l89:
+			l104:
 				{
-					position90, tokenIndex90, depth90 := position, tokenIndex, depth
+					position105, tokenIndex105, depth105 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l90
+						goto l105
 					}
-					goto l89
-				l90:
-					position, tokenIndex, depth = position90, tokenIndex90, depth90
+					goto l104
+				l105:
+					position, tokenIndex, depth = position105, tokenIndex105, depth105
 				}
 				if buffer[position] != rune(')') {
-					goto l77
+					goto l92
 				}
 				position++
-				if !_rules[ruleAction4]() {
-					goto l77
+				if !_rules[ruleAction6]() {
+					goto l92
 				}
 				depth--
-				add(ruleself, position78)
+				add(ruleself, position93)
 			}
 			return true
-		l77:
-			position, tokenIndex, depth = position77, tokenIndex77, depth77
+		l92:
+			position, tokenIndex, depth = position92, tokenIndex92, depth92
 			return false
 		},
-		/* 10 dataset <- <(('d' / 'D') ('a' / 'A') ('t' / 'T') ('a' / 'A') ('s' / 'S') ('e' / 'E') ('t' / 'T') isp* '(' isp* htmlid isp* ')' Action5)> */
+		/* 13 dataset <- <(('d' / 'D') ('a' / 'A') ('t' / 'T') ('a' / 'A') ('s' / 'S') ('e' / 'E') ('t' / 'T') isp* '(' isp* htmlid isp* ')' Action7)> */
 		func() bool {
-			position91, tokenIndex91, depth91 := position, tokenIndex, depth
+			position106, tokenIndex106, depth106 := position, tokenIndex, depth
 			{
-				position92 := position
+				position107 := position
 				depth++
 				{
-					position93, tokenIndex93, depth93 := position, tokenIndex, depth
+					position108, tokenIndex108, depth108 := position, tokenIndex, depth
 					if buffer[position] != rune('d') {
-						goto l94
+						goto l109
 					}
 					position++
-					goto l93
-				l94:
-					position, tokenIndex, depth = position93, tokenIndex93, depth93
+					goto l108
+				l109:
+					position, tokenIndex, depth = position108, tokenIndex108, depth108
 					if buffer[position] != rune('D') {
-						goto l91
+						goto l106
 					}
 					position++
 				}
-			l93:
+			l108:
 				{
-					position95, tokenIndex95, depth95 := position, tokenIndex, depth
+					position110, tokenIndex110, depth110 := position, tokenIndex, depth
 					if buffer[position] != rune('a') {
-						goto l96
+						goto l111
 					}
 					position++
-					goto l95
-				l96:
-					position, tokenIndex, depth = position95, tokenIndex95, depth95
+					goto l110
+				l111:
+					position, tokenIndex, depth = position110, tokenIndex110, depth110
 					if buffer[position] != rune('A') {
-						goto l91
+						goto l106
 					}
 					position++
 				}
-			l95:
+			l110:
 				{
-					position97, tokenIndex97, depth97 := position, tokenIndex, depth
+					position112, tokenIndex112, depth112 := position, tokenIndex, depth
 					if buffer[position] != rune('t') {
-						goto l98
+						goto l113
 					}
 					position++
-					goto l97
-				l98:
-					position, tokenIndex, depth = position97, tokenIndex97, depth97
+					goto l112
+				l113:
+					position, tokenIndex, depth = position112, tokenIndex112, depth112
 					if buffer[position] != rune('T') {
-						goto l91
+						goto l106
 					}
 					position++
 				}
-			l97:
+			l112:
 				{
-					position99, tokenIndex99, depth99 := position, tokenIndex, depth
+					position114, tokenIndex114, depth114 := position, tokenIndex, depth
 					if buffer[position] != rune('a') {
-						goto l100
+						goto l115
 					}
 					position++
-					goto l99
-				l100:
-					position, tokenIndex, depth = position99, tokenIndex99, depth99
+					goto l114
+				l115:
+					position, tokenIndex, depth = position114, tokenIndex114, depth114
 					if buffer[position] != rune('A') {
-						goto l91
+						goto l106
 					}
 					position++
 				}
-			l99:
+			l114:
 				{
-					position101, tokenIndex101, depth101 := position, tokenIndex, depth
+					position116, tokenIndex116, depth116 := position, tokenIndex, depth
 					if buffer[position] != rune('s') {
-						goto l102
+						goto l117
 					}
 					position++
-					goto l101
-				l102:
-					position, tokenIndex, depth = position101, tokenIndex101, depth101
+					goto l116
+				l117:
+					position, tokenIndex, depth = position116, tokenIndex116, depth116
 					if buffer[position] != rune('S') {
-						goto l91
+						goto l106
 					}
 					position++
 				}
-			l101:
+			l116:
 				{
-					position103, tokenIndex103, depth103 := position, tokenIndex, depth
+					position118, tokenIndex118, depth118 := position, tokenIndex, depth
 					if buffer[position] != rune('e') {
-						goto l104
+						goto l119
 					}
 					position++
-					goto l103
-				l104:
-					position, tokenIndex, depth = position103, tokenIndex103, depth103
+					goto l118
+				l119:
+					position, tokenIndex, depth = position118, tokenIndex118, depth118
 					if buffer[position] != rune('E') {
-						goto l91
+						goto l106
 					}
 					position++
 				}
-			l103:
+			l118:
 				{
-					position105, tokenIndex105, depth105 := position, tokenIndex, depth
+					position120, tokenIndex120, depth120 := position, tokenIndex, depth
 					if buffer[position] != rune('t') {
-						goto l106
+						goto l121
 					}
 					position++
-					goto l105
-				l106:
-					position, tokenIndex, depth = position105, tokenIndex105, depth105
+					goto l120
+				l121:
+					position, tokenIndex, depth = position120, tokenIndex120, depth120
 					if buffer[position] != rune('T') {
-						goto l91
+						goto l106
 					}
 					position++
 				}
-			l105:
-			l107:
+			l120:
+			l122:
 				{
-					position108, tokenIndex108, depth108 := position, tokenIndex, depth
+					position123, tokenIndex123, depth123 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l108
+						goto l123
 					}
-					goto l107
-				l108:
-					position, tokenIndex, depth = position108, tokenIndex108, depth108
+					goto l122
+				l123:
+					position, tokenIndex, depth = position123, tokenIndex123, depth123
 				}
 				if buffer[position] != rune('(') {
-					goto l91
+					goto l106
 				}
 				position++
-			l109:
+			l124:
 				{
-					position110, tokenIndex110, depth110 := position, tokenIndex, depth
+					position125, tokenIndex125, depth125 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l110
+						goto l125
 					}
-					goto l109
-				l110:
-					position, tokenIndex, depth = position110, tokenIndex110, depth110
+					goto l124
+				l125:
+					position, tokenIndex, depth = position125, tokenIndex125, depth125
 				}
 				if !_rules[rulehtmlid]() {
-					goto l91
+					goto l106
 				}
-			l111:
+			l126:
 				{
-					position112, tokenIndex112, depth112 := position, tokenIndex, depth
+					position127, tokenIndex127, depth127 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l112
+						goto l127
 					}
-					goto l111
-				l112:
-					position, tokenIndex, depth = position112, tokenIndex112, depth112
+					goto l126
+				l127:
+					position, tokenIndex, depth = position127, tokenIndex127, depth127
 				}
 				if buffer[position] != rune(')') {
-					goto l91
+					goto l106
 				}
 				position++
-				if !_rules[ruleAction5]() {
-					goto l91
+				if !_rules[ruleAction7]() {
+					goto l106
 				}
 				depth--
-				add(ruledataset, position92)
+				add(ruledataset, position107)
 			}
 			return true
-		l91:
-			position, tokenIndex, depth = position91, tokenIndex91, depth91
+		l106:
+			position, tokenIndex, depth = position106, tokenIndex106, depth106
 			return false
 		},
-		/* 11 prop <- <(('p' / 'P') ('r' / 'R') ('o' / 'O') ('p' / 'P') isp* '(' isp* htmlid isp* ')' Action6)> */
+		/* 14 prop <- <(('p' / 'P') ('r' / 'R') ('o' / 'O') ('p' / 'P') isp* '(' isp* htmlid isp* ')' Action8)> */
 		func() bool {
-			position113, tokenIndex113, depth113 := position, tokenIndex, depth
+			position128, tokenIndex128, depth128 := position, tokenIndex, depth
 			{
-				position114 := position
+				position129 := position
 				depth++
 				{
-					position115, tokenIndex115, depth115 := position, tokenIndex, depth
+					position130, tokenIndex130, depth130 := position, tokenIndex, depth
 					if buffer[position] != rune('p') {
-						goto l116
+						goto l131
 					}
 					position++
-					goto l115
-				l116:
-					position, tokenIndex, depth = position115, tokenIndex115, depth115
+					goto l130
+				l131:
+					position, tokenIndex, depth = position130, tokenIndex130, depth130
 					if buffer[position] != rune('P') {
-						goto l113
+						goto l128
 					}
 					position++
 				}
-			l115:
+			l130:
 				{
-					position117, tokenIndex117, depth117 := position, tokenIndex, depth
+					position132, tokenIndex132, depth132 := position, tokenIndex, depth
 					if buffer[position] != rune('r') {
-						goto l118
+						goto l133
 					}
 					position++
-					goto l117
-				l118:
-					position, tokenIndex, depth = position117, tokenIndex117, depth117
+					goto l132
+				l133:
+					position, tokenIndex, depth = position132, tokenIndex132, depth132
 					if buffer[position] != rune('R') {
-						goto l113
+						goto l128
 					}
 					position++
 				}
-			l117:
+			l132:
 				{
-					position119, tokenIndex119, depth119 := position, tokenIndex, depth
+					position134, tokenIndex134, depth134 := position, tokenIndex, depth
 					if buffer[position] != rune('o') {
-						goto l120
+						goto l135
 					}
 					position++
-					goto l119
-				l120:
-					position, tokenIndex, depth = position119, tokenIndex119, depth119
+					goto l134
+				l135:
+					position, tokenIndex, depth = position134, tokenIndex134, depth134
 					if buffer[position] != rune('O') {
-						goto l113
+						goto l128
 					}
 					position++
 				}
-			l119:
+			l134:
 				{
-					position121, tokenIndex121, depth121 := position, tokenIndex, depth
+					position136, tokenIndex136, depth136 := position, tokenIndex, depth
 					if buffer[position] != rune('p') {
-						goto l122
+						goto l137
 					}
 					position++
-					goto l121
-				l122:
-					position, tokenIndex, depth = position121, tokenIndex121, depth121
+					goto l136
+				l137:
+					position, tokenIndex, depth = position136, tokenIndex136, depth136
 					if buffer[position] != rune('P') {
-						goto l113
+						goto l128
 					}
 					position++
 				}
-			l121:
-			l123:
+			l136:
+			l138:
 				{
-					position124, tokenIndex124, depth124 := position, tokenIndex, depth
+					position139, tokenIndex139, depth139 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l124
+						goto l139
 					}
-					goto l123
-				l124:
-					position, tokenIndex, depth = position124, tokenIndex124, depth124
+					goto l138
+				l139:
+					position, tokenIndex, depth = position139, tokenIndex139, depth139
 				}
 				if buffer[position] != rune('(') {
-					goto l113
+					goto l128
 				}
 				position++
-			l125:
+			l140:
 				{
-					position126, tokenIndex126, depth126 := position, tokenIndex, depth
+					position141, tokenIndex141, depth141 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l126
+						goto l141
 					}
-					goto l125
-				l126:
-					position, tokenIndex, depth = position126, tokenIndex126, depth126
+					goto l140
+				l141:
+					position, tokenIndex, depth = position141, tokenIndex141, depth141
 				}
 				if !_rules[rulehtmlid]() {
-					goto l113
+					goto l128
 				}
-			l127:
+			l142:
 				{
-					position128, tokenIndex128, depth128 := position, tokenIndex, depth
+					position143, tokenIndex143, depth143 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l128
+						goto l143
 					}
-					goto l127
-				l128:
-					position, tokenIndex, depth = position128, tokenIndex128, depth128
+					goto l142
+				l143:
+					position, tokenIndex, depth = position143, tokenIndex143, depth143
 				}
 				if buffer[position] != rune(')') {
-					goto l113
+					goto l128
 				}
 				position++
-				if !_rules[ruleAction6]() {
-					goto l113
+				if !_rules[ruleAction8]() {
+					goto l128
 				}
 				depth--
-				add(ruleprop, position114)
+				add(ruleprop, position129)
 			}
 			return true
-		l113:
-			position, tokenIndex, depth = position113, tokenIndex113, depth113
+		l128:
+			position, tokenIndex, depth = position128, tokenIndex128, depth128
 			return false
 		},
-		/* 12 style <- <(('s' / 'S') ('t' / 'T') ('y' / 'Y') ('l' / 'L') ('e' / 'E') isp* '(' isp* htmlid isp* ')' Action7)> */
+		/* 15 style <- <(('s' / 'S') ('t' / 'T') ('y' / 'Y') ('l' / 'L') ('e' / 'E') isp* '(' isp* htmlid isp* ')' Action9)> */
 		func() bool {
-			position129, tokenIndex129, depth129 := position, tokenIndex, depth
+			position144, tokenIndex144, depth144 := position, tokenIndex, depth
 			{
-				position130 := position
+				position145 := position
 				depth++
 				{
-					position131, tokenIndex131, depth131 := position, tokenIndex, depth
+					position146, tokenIndex146, depth146 := position, tokenIndex, depth
 					if buffer[position] != rune('s') {
-						goto l132
+						goto l147
 					}
 					position++
-					goto l131
-				l132:
-					position, tokenIndex, depth = position131, tokenIndex131, depth131
+					goto l146
+				l147:
+					position, tokenIndex, depth = position146, tokenIndex146, depth146
 					if buffer[position] != rune('S') {
-						goto l129
+						goto l144
 					}
 					position++
 				}
-			l131:
+			l146:
 				{
-					position133, tokenIndex133, depth133 := position, tokenIndex, depth
+					position148, tokenIndex148, depth148 := position, tokenIndex, depth
 					if buffer[position] != rune('t') {
-						goto l134
+						goto l149
 					}
 					position++
-					goto l133
-				l134:
-					position, tokenIndex, depth = position133, tokenIndex133, depth133
+					goto l148
+				l149:
+					position, tokenIndex, depth = position148, tokenIndex148, depth148
 					if buffer[position] != rune('T') {
-						goto l129
+						goto l144
 					}
 					position++
 				}
-			l133:
+			l148:
 				{
-					position135, tokenIndex135, depth135 := position, tokenIndex, depth
+					position150, tokenIndex150, depth150 := position, tokenIndex, depth
 					if buffer[position] != rune('y') {
-						goto l136
+						goto l151
 					}
 					position++
-					goto l135
-				l136:
-					position, tokenIndex, depth = position135, tokenIndex135, depth135
+					goto l150
+				l151:
+					position, tokenIndex, depth = position150, tokenIndex150, depth150
 					if buffer[position] != rune('Y') {
-						goto l129
+						goto l144
 					}
 					position++
 				}
-			l135:
+			l150:
 				{
-					position137, tokenIndex137, depth137 := position, tokenIndex, depth
+					position152, tokenIndex152, depth152 := position, tokenIndex, depth
 					if buffer[position] != rune('l') {
-						goto l138
+						goto l153
 					}
 					position++
-					goto l137
-				l138:
-					position, tokenIndex, depth = position137, tokenIndex137, depth137
+					goto l152
+				l153:
+					position, tokenIndex, depth = position152, tokenIndex152, depth152
 					if buffer[position] != rune('L') {
-						goto l129
+						goto l144
 					}
 					position++
 				}
-			l137:
+			l152:
 				{
-					position139, tokenIndex139, depth139 := position, tokenIndex, depth
+					position154, tokenIndex154, depth154 := position, tokenIndex, depth
 					if buffer[position] != rune('e') {
-						goto l140
+						goto l155
 					}
 					position++
-					goto l139
-				l140:
-					position, tokenIndex, depth = position139, tokenIndex139, depth139
+					goto l154
+				l155:
+					position, tokenIndex, depth = position154, tokenIndex154, depth154
 					if buffer[position] != rune('E') {
-						goto l129
+						goto l144
 					}
 					position++
 				}
-			l139:
-			l141:
+			l154:
+			l156:
 				{
-					position142, tokenIndex142, depth142 := position, tokenIndex, depth
+					position157, tokenIndex157, depth157 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l142
+						goto l157
 					}
-					goto l141
-				l142:
-					position, tokenIndex, depth = position142, tokenIndex142, depth142
+					goto l156
+				l157:
+					position, tokenIndex, depth = position157, tokenIndex157, depth157
 				}
 				if buffer[position] != rune('(') {
-					goto l129
+					goto l144
 				}
 				position++
-			l143:
+			l158:
 				{
-					position144, tokenIndex144, depth144 := position, tokenIndex, depth
+					position159, tokenIndex159, depth159 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l144
+						goto l159
 					}
-					goto l143
-				l144:
-					position, tokenIndex, depth = position144, tokenIndex144, depth144
+					goto l158
+				l159:
+					position, tokenIndex, depth = position159, tokenIndex159, depth159
 				}
 				if !_rules[rulehtmlid]() {
-					goto l129
+					goto l144
 				}
-			l145:
+			l160:
 				{
-					position146, tokenIndex146, depth146 := position, tokenIndex, depth
+					position161, tokenIndex161, depth161 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l146
+						goto l161
 					}
-					goto l145
-				l146:
-					position, tokenIndex, depth = position146, tokenIndex146, depth146
+					goto l160
+				l161:
+					position, tokenIndex, depth = position161, tokenIndex161, depth161
 				}
 				if buffer[position] != rune(')') {
-					goto l129
+					goto l144
 				}
 				position++
-				if !_rules[ruleAction7]() {
-					goto l129
+				if !_rules[ruleAction9]() {
+					goto l144
 				}
 				depth--
-				add(rulestyle, position130)
+				add(rulestyle, position145)
 			}
 			return true
-		l129:
-			position, tokenIndex, depth = position129, tokenIndex129, depth129
+		l144:
+			position, tokenIndex, depth = position144, tokenIndex144, depth144
 			return false
 		},
-		/* 13 class <- <(('c' / 'C') ('l' / 'L') ('a' / 'A') ('s' / 'S') ('s' / 'S') isp* '(' isp* htmlid isp* (',' isp* htmlid isp*)* ')' Action8)> */
+		/* 16 class <- <(('c' / 'C') ('l' / 'L') ('a' / 'A') ('s' / 'S') ('s' / 'S') isp* '(' isp* htmlid isp* (',' isp* htmlid isp*)* ')' Action10)> */
 		func() bool {
-			position147, tokenIndex147, depth147 := position, tokenIndex, depth
+			position162, tokenIndex162, depth162 := position, tokenIndex, depth
 			{
-				position148 := position
+				position163 := position
 				depth++
 				{
-					position149, tokenIndex149, depth149 := position, tokenIndex, depth
+					position164, tokenIndex164, depth164 := position, tokenIndex, depth
 					if buffer[position] != rune('c') {
-						goto l150
+						goto l165
 					}
 					position++
-					goto l149
-				l150:
-					position, tokenIndex, depth = position149, tokenIndex149, depth149
+					goto l164
+				l165:
+					position, tokenIndex, depth = position164, tokenIndex164, depth164
 					if buffer[position] != rune('C') {
-						goto l147
+						goto l162
 					}
 					position++
 				}
-			l149:
+			l164:
 				{
-					position151, tokenIndex151, depth151 := position, tokenIndex, depth
+					position166, tokenIndex166, depth166 := position, tokenIndex, depth
 					if buffer[position] != rune('l') {
-						goto l152
+						goto l167
 					}
 					position++
-					goto l151
-				l152:
-					position, tokenIndex, depth = position151, tokenIndex151, depth151
+					goto l166
+				l167:
+					position, tokenIndex, depth = position166, tokenIndex166, depth166
 					if buffer[position] != rune('L') {
-						goto l147
+						goto l162
 					}
 					position++
 				}
-			l151:
+			l166:
 				{
-					position153, tokenIndex153, depth153 := position, tokenIndex, depth
+					position168, tokenIndex168, depth168 := position, tokenIndex, depth
 					if buffer[position] != rune('a') {
-						goto l154
+						goto l169
 					}
 					position++
-					goto l153
-				l154:
-					position, tokenIndex, depth = position153, tokenIndex153, depth153
+					goto l168
+				l169:
+					position, tokenIndex, depth = position168, tokenIndex168, depth168
 					if buffer[position] != rune('A') {
-						goto l147
+						goto l162
 					}
 					position++
 				}
-			l153:
+			l168:
 				{
-					position155, tokenIndex155, depth155 := position, tokenIndex, depth
+					position170, tokenIndex170, depth170 := position, tokenIndex, depth
 					if buffer[position] != rune('s') {
-						goto l156
+						goto l171
 					}
 					position++
-					goto l155
-				l156:
-					position, tokenIndex, depth = position155, tokenIndex155, depth155
+					goto l170
+				l171:
+					position, tokenIndex, depth = position170, tokenIndex170, depth170
 					if buffer[position] != rune('S') {
-						goto l147
+						goto l162
 					}
 					position++
 				}
-			l155:
+			l170:
 				{
-					position157, tokenIndex157, depth157 := position, tokenIndex, depth
+					position172, tokenIndex172, depth172 := position, tokenIndex, depth
 					if buffer[position] != rune('s') {
-						goto l158
+						goto l173
 					}
 					position++
-					goto l157
-				l158:
-					position, tokenIndex, depth = position157, tokenIndex157, depth157
+					goto l172
+				l173:
+					position, tokenIndex, depth = position172, tokenIndex172, depth172
 					if buffer[position] != rune('S') {
-						goto l147
+						goto l162
 					}
 					position++
 				}
-			l157:
-			l159:
+			l172:
+			l174:
 				{
-					position160, tokenIndex160, depth160 := position, tokenIndex, depth
+					position175, tokenIndex175, depth175 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l160
+						goto l175
 					}
-					goto l159
-				l160:
-					position, tokenIndex, depth = position160, tokenIndex160, depth160
+					goto l174
+				l175:
+					position, tokenIndex, depth = position175, tokenIndex175, depth175
 				}
 				if buffer[position] != rune('(') {
-					goto l147
+					goto l162
 				}
 				position++
-			l161:
+			l176:
 				{
-					position162, tokenIndex162, depth162 := position, tokenIndex, depth
+					position177, tokenIndex177, depth177 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l162
+						goto l177
 					}
-					goto l161
-				l162:
-					position, tokenIndex, depth = position162, tokenIndex162, depth162
+					goto l176
+				l177:
+					position, tokenIndex, depth = position177, tokenIndex177, depth177
 				}
 				if !_rules[rulehtmlid]() {
-					goto l147
+					goto l162
 				}
-			l163:
+			l178:
 				{
-					position164, tokenIndex164, depth164 := position, tokenIndex, depth
+					position179, tokenIndex179, depth179 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l164
+						goto l179
 					}
-					goto l163
-				l164:
-					position, tokenIndex, depth = position164, tokenIndex164, depth164
+					goto l178
+				l179:
+					position, tokenIndex, depth = position179, tokenIndex179, depth179
 				}
-			l165:
+			l180:
 				{
-					position166, tokenIndex166, depth166 := position, tokenIndex, depth
+					position181, tokenIndex181, depth181 := position, tokenIndex, depth
 					if buffer[position] != rune(',') {
-						goto l166
+						goto l181
 					}
 					position++
-				l167:
+				l182:
 					{
-						position168, tokenIndex168, depth168 := position, tokenIndex, depth
+						position183, tokenIndex183, depth183 := position, tokenIndex, depth
 						if !_rules[ruleisp]() {
-							goto l168
+							goto l183
 						}
-						goto l167
-					l168:
-						position, tokenIndex, depth = position168, tokenIndex168, depth168
+						goto l182
+					l183:
+						position, tokenIndex, depth = position183, tokenIndex183, depth183
 					}
 					if !_rules[rulehtmlid]() {
-						goto l166
+						goto l181
 					}
-				l169:
+				l184:
 					{
-						position170, tokenIndex170, depth170 := position, tokenIndex, depth
+						position185, tokenIndex185, depth185 := position, tokenIndex, depth
 						if !_rules[ruleisp]() {
-							goto l170
+							goto l185
 						}
-						goto l169
-					l170:
-						position, tokenIndex, depth = position170, tokenIndex170, depth170
+						goto l184
+					l185:
+						position, tokenIndex, depth = position185, tokenIndex185, depth185
 					}
-					goto l165
-				l166:
-					position, tokenIndex, depth = position166, tokenIndex166, depth166
+					goto l180
+				l181:
+					position, tokenIndex, depth = position181, tokenIndex181, depth181
 				}
 				if buffer[position] != rune(')') {
-					goto l147
+					goto l162
 				}
 				position++
-				if !_rules[ruleAction8]() {
-					goto l147
+				if !_rules[ruleAction10]() {
+					goto l162
 				}
 				depth--
-				add(ruleclass, position148)
+				add(ruleclass, position163)
 			}
 			return true
-		l147:
-			position, tokenIndex, depth = position147, tokenIndex147, depth147
+		l162:
+			position, tokenIndex, depth = position162, tokenIndex162, depth162
 			return false
 		},
-		/* 14 form <- <(('f' / 'F') ('o' / 'O') ('r' / 'R') ('m' / 'M') isp* '(' isp* htmlid isp* ')' Action9)> */
+		/* 17 form <- <(('f' / 'F') ('o' / 'O') ('r' / 'R') ('m' / 'M') isp* '(' isp* htmlid isp* ')' Action11)> */
 		func() bool {
-			position171, tokenIndex171, depth171 := position, tokenIndex, depth
+			position186, tokenIndex186, depth186 := position, tokenIndex, depth
 			{
-				position172 := position
+				position187 := position
 				depth++
 				{
-					position173, tokenIndex173, depth173 := position, tokenIndex, depth
+					position188, tokenIndex188, depth188 := position, tokenIndex, depth
 					if buffer[position] != rune('f') {
-						goto l174
+						goto l189
 					}
 					position++
-					goto l173
-				l174:
-					position, tokenIndex, depth = position173, tokenIndex173, depth173
+					goto l188
+				l189:
+					position, tokenIndex, depth = position188, tokenIndex188, depth188
 					if buffer[position] != rune('F') {
-						goto l171
+						goto l186
 					}
 					position++
 				}
-			l173:
+			l188:
 				{
-					position175, tokenIndex175, depth175 := position, tokenIndex, depth
+					position190, tokenIndex190, depth190 := position, tokenIndex, depth
 					if buffer[position] != rune('o') {
-						goto l176
+						goto l191
 					}
 					position++
-					goto l175
-				l176:
-					position, tokenIndex, depth = position175, tokenIndex175, depth175
+					goto l190
+				l191:
+					position, tokenIndex, depth = position190, tokenIndex190, depth190
 					if buffer[position] != rune('O') {
-						goto l171
+						goto l186
 					}
 					position++
 				}
-			l175:
+			l190:
 				{
-					position177, tokenIndex177, depth177 := position, tokenIndex, depth
+					position192, tokenIndex192, depth192 := position, tokenIndex, depth
 					if buffer[position] != rune('r') {
-						goto l178
+						goto l193
 					}
 					position++
-					goto l177
-				l178:
-					position, tokenIndex, depth = position177, tokenIndex177, depth177
+					goto l192
+				l193:
+					position, tokenIndex, depth = position192, tokenIndex192, depth192
 					if buffer[position] != rune('R') {
-						goto l171
+						goto l186
 					}
 					position++
 				}
-			l177:
+			l192:
 				{
-					position179, tokenIndex179, depth179 := position, tokenIndex, depth
+					position194, tokenIndex194, depth194 := position, tokenIndex, depth
 					if buffer[position] != rune('m') {
-						goto l180
+						goto l195
 					}
 					position++
-					goto l179
-				l180:
-					position, tokenIndex, depth = position179, tokenIndex179, depth179
+					goto l194
+				l195:
+					position, tokenIndex, depth = position194, tokenIndex194, depth194
 					if buffer[position] != rune('M') {
-						goto l171
+						goto l186
 					}
 					position++
 				}
-			l179:
-			l181:
+			l194:
+			l196:
 				{
-					position182, tokenIndex182, depth182 := position, tokenIndex, depth
+					position197, tokenIndex197, depth197 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l182
+						goto l197
 					}
-					goto l181
-				l182:
-					position, tokenIndex, depth = position182, tokenIndex182, depth182
+					goto l196
+				l197:
+					position, tokenIndex, depth = position197, tokenIndex197, depth197
 				}
 				if buffer[position] != rune('(') {
-					goto l171
+					goto l186
 				}
 				position++
-			l183:
+			l198:
 				{
-					position184, tokenIndex184, depth184 := position, tokenIndex, depth
+					position199, tokenIndex199, depth199 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l184
+						goto l199
 					}
-					goto l183
-				l184:
-					position, tokenIndex, depth = position184, tokenIndex184, depth184
+					goto l198
+				l199:
+					position, tokenIndex, depth = position199, tokenIndex199, depth199
 				}
 				if !_rules[rulehtmlid]() {
-					goto l171
+					goto l186
 				}
-			l185:
+			l200:
 				{
-					position186, tokenIndex186, depth186 := position, tokenIndex, depth
+					position201, tokenIndex201, depth201 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l186
+						goto l201
 					}
-					goto l185
-				l186:
-					position, tokenIndex, depth = position186, tokenIndex186, depth186
+					goto l200
+				l201:
+					position, tokenIndex, depth = position201, tokenIndex201, depth201
 				}
 				if buffer[position] != rune(')') {
-					goto l171
+					goto l186
 				}
 				position++
-				if !_rules[ruleAction9]() {
-					goto l171
+				if !_rules[ruleAction11]() {
+					goto l186
 				}
 				depth--
-				add(ruleform, position172)
+				add(ruleform, position187)
 			}
 			return true
-		l171:
-			position, tokenIndex, depth = position171, tokenIndex171, depth171
+		l186:
+			position, tokenIndex, depth = position186, tokenIndex186, depth186
 			return false
 		},
-		/* 15 goExpr <- <(('g' / 'G') ('o' / 'O') isp* '(' isp* expr isp* ')' Action10)> */
+		/* 18 goExpr <- <(('g' / 'G') ('o' / 'O') isp* '(' isp* expr isp* ')' Action12)> */
 		func() bool {
-			position187, tokenIndex187, depth187 := position, tokenIndex, depth
+			position202, tokenIndex202, depth202 := position, tokenIndex, depth
 			{
-				position188 := position
+				position203 := position
 				depth++
 				{
-					position189, tokenIndex189, depth189 := position, tokenIndex, depth
+					position204, tokenIndex204, depth204 := position, tokenIndex, depth
 					if buffer[position] != rune('g') {
-						goto l190
+						goto l205
 					}
 					position++
-					goto l189
-				l190:
-					position, tokenIndex, depth = position189, tokenIndex189, depth189
+					goto l204
+				l205:
+					position, tokenIndex, depth = position204, tokenIndex204, depth204
 					if buffer[position] != rune('G') {
-						goto l187
+						goto l202
 					}
 					position++
 				}
-			l189:
+			l204:
 				{
-					position191, tokenIndex191, depth191 := position, tokenIndex, depth
+					position206, tokenIndex206, depth206 := position, tokenIndex, depth
 					if buffer[position] != rune('o') {
-						goto l192
+						goto l207
 					}
 					position++
-					goto l191
-				l192:
-					position, tokenIndex, depth = position191, tokenIndex191, depth191
+					goto l206
+				l207:
+					position, tokenIndex, depth = position206, tokenIndex206, depth206
 					if buffer[position] != rune('O') {
-						goto l187
+						goto l202
 					}
 					position++
 				}
-			l191:
-			l193:
+			l206:
+			l208:
 				{
-					position194, tokenIndex194, depth194 := position, tokenIndex, depth
+					position209, tokenIndex209, depth209 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l194
+						goto l209
 					}
-					goto l193
-				l194:
-					position, tokenIndex, depth = position194, tokenIndex194, depth194
+					goto l208
+				l209:
+					position, tokenIndex, depth = position209, tokenIndex209, depth209
 				}
 				if buffer[position] != rune('(') {
-					goto l187
+					goto l202
 				}
 				position++
-			l195:
+			l210:
 				{
-					position196, tokenIndex196, depth196 := position, tokenIndex, depth
+					position211, tokenIndex211, depth211 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l196
+						goto l211
 					}
-					goto l195
-				l196:
-					position, tokenIndex, depth = position196, tokenIndex196, depth196
+					goto l210
+				l211:
+					position, tokenIndex, depth = position211, tokenIndex211, depth211
 				}
 				if !_rules[ruleexpr]() {
-					goto l187
+					goto l202
 				}
-			l197:
+			l212:
 				{
-					position198, tokenIndex198, depth198 := position, tokenIndex, depth
+					position213, tokenIndex213, depth213 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l198
+						goto l213
 					}
-					goto l197
-				l198:
-					position, tokenIndex, depth = position198, tokenIndex198, depth198
+					goto l212
+				l213:
+					position, tokenIndex, depth = position213, tokenIndex213, depth213
 				}
 				if buffer[position] != rune(')') {
-					goto l187
+					goto l202
 				}
 				position++
-				if !_rules[ruleAction10]() {
-					goto l187
+				if !_rules[ruleAction12]() {
+					goto l202
 				}
 				depth--
-				add(rulegoExpr, position188)
+				add(rulegoExpr, position203)
 			}
 			return true
-		l187:
-			position, tokenIndex, depth = position187, tokenIndex187, depth187
+		l202:
+			position, tokenIndex, depth = position202, tokenIndex202, depth202
 			return false
 		},
-		/* 16 event <- <(('e' / 'E') ('v' / 'V') ('e' / 'E') ('n' / 'N') ('t' / 'T') isp* '(' isp* jsid? isp* ')' Action11)> */
+		/* 19 event <- <(('e' / 'E') ('v' / 'V') ('e' / 'E') ('n' / 'N') ('t' / 'T') isp* '(' isp* jsid? isp* ')' Action13)> */
 		func() bool {
-			position199, tokenIndex199, depth199 := position, tokenIndex, depth
+			position214, tokenIndex214, depth214 := position, tokenIndex, depth
 			{
-				position200 := position
+				position215 := position
 				depth++
 				{
-					position201, tokenIndex201, depth201 := position, tokenIndex, depth
+					position216, tokenIndex216, depth216 := position, tokenIndex, depth
 					if buffer[position] != rune('e') {
-						goto l202
+						goto l217
 					}
 					position++
-					goto l201
-				l202:
-					position, tokenIndex, depth = position201, tokenIndex201, depth201
+					goto l216
+				l217:
+					position, tokenIndex, depth = position216, tokenIndex216, depth216
 					if buffer[position] != rune('E') {
-						goto l199
+						goto l214
 					}
 					position++
 				}
-			l201:
+			l216:
 				{
-					position203, tokenIndex203, depth203 := position, tokenIndex, depth
+					position218, tokenIndex218, depth218 := position, tokenIndex, depth
 					if buffer[position] != rune('v') {
-						goto l204
+						goto l219
 					}
 					position++
-					goto l203
-				l204:
-					position, tokenIndex, depth = position203, tokenIndex203, depth203
+					goto l218
+				l219:
+					position, tokenIndex, depth = position218, tokenIndex218, depth218
 					if buffer[position] != rune('V') {
-						goto l199
+						goto l214
 					}
 					position++
 				}
-			l203:
+			l218:
 				{
-					position205, tokenIndex205, depth205 := position, tokenIndex, depth
+					position220, tokenIndex220, depth220 := position, tokenIndex, depth
 					if buffer[position] != rune('e') {
-						goto l206
+						goto l221
 					}
 					position++
-					goto l205
-				l206:
-					position, tokenIndex, depth = position205, tokenIndex205, depth205
+					goto l220
+				l221:
+					position, tokenIndex, depth = position220, tokenIndex220, depth220
 					if buffer[position] != rune('E') {
-						goto l199
+						goto l214
 					}
 					position++
 				}
-			l205:
+			l220:
 				{
-					position207, tokenIndex207, depth207 := position, tokenIndex, depth
+					position222, tokenIndex222, depth222 := position, tokenIndex, depth
 					if buffer[position] != rune('n') {
-						goto l208
+						goto l223
 					}
 					position++
-					goto l207
-				l208:
-					position, tokenIndex, depth = position207, tokenIndex207, depth207
+					goto l222
+				l223:
+					position, tokenIndex, depth = position222, tokenIndex222, depth222
 					if buffer[position] != rune('N') {
-						goto l199
+						goto l214
 					}
 					position++
 				}
-			l207:
+			l222:
 				{
-					position209, tokenIndex209, depth209 := position, tokenIndex, depth
+					position224, tokenIndex224, depth224 := position, tokenIndex, depth
 					if buffer[position] != rune('t') {
-						goto l210
+						goto l225
 					}
 					position++
-					goto l209
-				l210:
-					position, tokenIndex, depth = position209, tokenIndex209, depth209
+					goto l224
+				l225:
+					position, tokenIndex, depth = position224, tokenIndex224, depth224
 					if buffer[position] != rune('T') {
-						goto l199
+						goto l214
 					}
 					position++
 				}
-			l209:
-			l211:
+			l224:
+			l226:
 				{
-					position212, tokenIndex212, depth212 := position, tokenIndex, depth
+					position227, tokenIndex227, depth227 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l212
+						goto l227
 					}
-					goto l211
-				l212:
-					position, tokenIndex, depth = position212, tokenIndex212, depth212
+					goto l226
+				l227:
+					position, tokenIndex, depth = position227, tokenIndex227, depth227
 				}
 				if buffer[position] != rune('(') {
-					goto l199
+					goto l214
 				}
 				position++
-			l213:
+			l228:
 				{
-					position214, tokenIndex214, depth214 := position, tokenIndex, depth
+					position229, tokenIndex229, depth229 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l214
+						goto l229
 					}
-					goto l213
-				l214:
-					position, tokenIndex, depth = position214, tokenIndex214, depth214
+					goto l228
+				l229:
+					position, tokenIndex, depth = position229, tokenIndex229, depth229
 				}
 				{
-					position215, tokenIndex215, depth215 := position, tokenIndex, depth
+					position230, tokenIndex230, depth230 := position, tokenIndex, depth
 					if !_rules[rulejsid]() {
-						goto l215
+						goto l230
 					}
-					goto l216
-				l215:
-					position, tokenIndex, depth = position215, tokenIndex215, depth215
+					goto l231
+				l230:
+					position, tokenIndex, depth = position230, tokenIndex230, depth230
 				}
-			l216:
-			l217:
+			l231:
+			l232:
 				{
-					position218, tokenIndex218, depth218 := position, tokenIndex, depth
+					position233, tokenIndex233, depth233 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l218
+						goto l233
 					}
-					goto l217
-				l218:
-					position, tokenIndex, depth = position218, tokenIndex218, depth218
+					goto l232
+				l233:
+					position, tokenIndex, depth = position233, tokenIndex233, depth233
 				}
 				if buffer[position] != rune(')') {
-					goto l199
+					goto l214
 				}
 				position++
-				if !_rules[ruleAction11]() {
-					goto l199
+				if !_rules[ruleAction13]() {
+					goto l214
+				}
+				depth--
+				add(ruleevent, position215)
+			}
+			return true
+		l214:
+			position, tokenIndex, depth = position214, tokenIndex214, depth214
+			return false
+		},
+		/* 20 detail <- <(('d' / 'D') ('e' / 'E') ('t' / 'T') ('a' / 'A') ('i' / 'I') ('l' / 'L') isp* '(' isp* jsid isp* ')' Action14)> */
+		func() bool {
+			position234, tokenIndex234, depth234 := position, tokenIndex, depth
+			{
+				position235 := position
+				depth++
+				{
+					position236, tokenIndex236, depth236 := position, tokenIndex, depth
+					if buffer[position] != rune('d') {
+						goto l237
+					}
+					position++
+					goto l236
+				l237:
+					position, tokenIndex, depth = position236, tokenIndex236, depth236
+					if buffer[position] != rune('D') {
+						goto l234
+					}
+					position++
+				}
+			l236:
+				{
+					position238, tokenIndex238, depth238 := position, tokenIndex, depth
+					if buffer[position] != rune('e') {
+						goto l239
+					}
+					position++
+					goto l238
+				l239:
+					position, tokenIndex, depth = position238, tokenIndex238, depth238
+					if buffer[position] != rune('E') {
+						goto l234
+					}
+					position++
+				}
+			l238:
+				{
+					position240, tokenIndex240, depth240 := position, tokenIndex, depth
+					if buffer[position] != rune('t') {
+						goto l241
+					}
+					position++
+					goto l240
+				l241:
+					position, tokenIndex, depth = position240, tokenIndex240, depth240
+					if buffer[position] != rune('T') {
+						goto l234
+					}
+					position++
+				}
+			l240:
+				{
+					position242, tokenIndex242, depth242 := position, tokenIndex, depth
+					if buffer[position] != rune('a') {
+						goto l243
+					}
+					position++
+					goto l242
+				l243:
+					position, tokenIndex, depth = position242, tokenIndex242, depth242
+					if buffer[position] != rune('A') {
+						goto l234
+					}
+					position++
+				}
+			l242:
+				{
+					position244, tokenIndex244, depth244 := position, tokenIndex, depth
+					if buffer[position] != rune('i') {
+						goto l245
+					}
+					position++
+					goto l244
+				l245:
+					position, tokenIndex, depth = position244, tokenIndex244, depth244
+					if buffer[position] != rune('I') {
+						goto l234
+					}
+					position++
+				}
+			l244:
+				{
+					position246, tokenIndex246, depth246 := position, tokenIndex, depth
+					if buffer[position] != rune('l') {
+						goto l247
+					}
+					position++
+					goto l246
+				l247:
+					position, tokenIndex, depth = position246, tokenIndex246, depth246
+					if buffer[position] != rune('L') {
+						goto l234
+					}
+					position++
+				}
+			l246:
+			l248:
+				{
+					position249, tokenIndex249, depth249 := position, tokenIndex, depth
+					if !_rules[ruleisp]() {
+						goto l249
+					}
+					goto l248
+				l249:
+					position, tokenIndex, depth = position249, tokenIndex249, depth249
+				}
+				if buffer[position] != rune('(') {
+					goto l234
+				}
+				position++
+			l250:
+				{
+					position251, tokenIndex251, depth251 := position, tokenIndex, depth
+					if !_rules[ruleisp]() {
+						goto l251
+					}
+					goto l250
+				l251:
+					position, tokenIndex, depth = position251, tokenIndex251, depth251
+				}
+				if !_rules[rulejsid]() {
+					goto l234
+				}
+			l252:
+				{
+					position253, tokenIndex253, depth253 := position, tokenIndex, depth
+					if !_rules[ruleisp]() {
+						goto l253
+					}
+					goto l252
+				l253:
+					position, tokenIndex, depth = position253, tokenIndex253, depth253
+				}
+				if buffer[position] != rune(')') {
+					goto l234
+				}
+				position++
+				if !_rules[ruleAction14]() {
+					goto l234
 				}
 				depth--
-				add(ruleevent, position200)
+				add(ruledetail, position235)
 			}
 			return true
-		l199:
-			position, tokenIndex, depth = position199, tokenIndex199, depth199
+		l234:
+			position, tokenIndex, depth = position234, tokenIndex234, depth234
 			return false
 		},
-		/* 17 htmlid <- <(<((&('-') '-') | (&('_') '_') | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') [a-z]))+> Action12)> */
+		/* 21 htmlid <- <(<((&('-') '-') | (&('_') '_') | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') [a-z]))+> Action15)> */
 		func() bool {
-			position219, tokenIndex219, depth219 := position, tokenIndex, depth
+			position254, tokenIndex254, depth254 := position, tokenIndex, depth
 			{
-				position220 := position
+				position255 := position
 				depth++
 				{
-					position221 := position
+					position256 := position
 					depth++
 					{
 						switch buffer[position] {
 						case '-':
 							if buffer[position] != rune('-') {
-								goto l219
+								goto l254
 							}
 							position++
 							break
 						case '_':
 							if buffer[position] != rune('_') {
-								goto l219
+								goto l254
 							}
 							position++
 							break
 						case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
 							if c := buffer[position]; c < rune('A') || c > rune('Z') {
-								goto l219
+								goto l254
 							}
 							position++
 							break
 						case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
 							if c := buffer[position]; c < rune('0') || c > rune('9') {
-								goto l219
+								goto l254
 							}
 							position++
 							break
 						default:
 							if c := buffer[position]; c < rune('a') || c > rune('z') {
-								goto l219
+								goto l254
 							}
 							position++
 							break
 						}
 					}
 
-				l222:
+				l257:
 					{
-						position223, tokenIndex223, depth223 := position, tokenIndex, depth
+						position258, tokenIndex258, depth258 := position, tokenIndex, depth
 						{
 							switch buffer[position] {
 							case '-':
 								if buffer[position] != rune('-') {
-									goto l223
+									goto l258
 								}
 								position++
 								break
 							case '_':
 								if buffer[position] != rune('_') {
-									goto l223
+									goto l258
 								}
 								position++
 								break
 							case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
 								if c := buffer[position]; c < rune('A') || c > rune('Z') {
-									goto l223
+									goto l258
 								}
 								position++
 								break
 							case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
 								if c := buffer[position]; c < rune('0') || c > rune('9') {
-									goto l223
+									goto l258
 								}
 								position++
 								break
 							default:
 								if c := buffer[position]; c < rune('a') || c > rune('z') {
-									goto l223
+									goto l258
 								}
 								position++
 								break
 							}
 						}
 
-						goto l222
-					l223:
-						position, tokenIndex, depth = position223, tokenIndex223, depth223
+						goto l257
+					l258:
+						position, tokenIndex, depth = position258, tokenIndex258, depth258
 					}
 					depth--
-					add(rulePegText, position221)
+					add(rulePegText, position256)
 				}
-				if !_rules[ruleAction12]() {
-					goto l219
+				if !_rules[ruleAction15]() {
+					goto l254
 				}
 				depth--
-				add(rulehtmlid, position220)
+				add(rulehtmlid, position255)
 			}
 			return true
-		l219:
-			position, tokenIndex, depth = position219, tokenIndex219, depth219
+		l254:
+			position, tokenIndex, depth = position254, tokenIndex254, depth254
 			return false
 		},
-		/* 18 jsid <- <(<(((&('_') '_') | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') [a-z])) ((&('_') '_') | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') [a-z]))*)> Action13)> */
+		/* 22 jsid <- <(<(((&('_') '_') | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') [a-z])) ((&('_') '_') | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') [0-9]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') [a-z]))*)> Action16)> */
 		func() bool {
-			position226, tokenIndex226, depth226 := position, tokenIndex, depth
+			position261, tokenIndex261, depth261 := position, tokenIndex, depth
 			{
-				position227 := position
+				position262 := position
 				depth++
 				{
-					position228 := position
+					position263 := position
 					depth++
 					{
 						switch buffer[position] {
 						case '_':
 							if buffer[position] != rune('_') {
-								goto l226
+								goto l261
 							}
 							position++
 							break
 						case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
 							if c := buffer[position]; c < rune('A') || c > rune('Z') {
-								goto l226
+								goto l261
 							}
 							position++
 							break
 						default:
 							if c := buffer[position]; c < rune('a') || c > rune('z') {
-								goto l226
+								goto l261
 							}
 							position++
 							break
 						}
 					}
 
-				l230:
+				l265:
 					{
-						position231, tokenIndex231, depth231 := position, tokenIndex, depth
+						position266, tokenIndex266, depth266 := position, tokenIndex, depth
 						{
 							switch buffer[position] {
 							case '_':
 								if buffer[position] != rune('_') {
-									goto l231
+									goto l266
 								}
 								position++
 								break
 							case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
 								if c := buffer[position]; c < rune('A') || c > rune('Z') {
-									goto l231
+									goto l266
 								}
 								position++
 								break
 							case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
 								if c := buffer[position]; c < rune('0') || c > rune('9') {
-									goto l231
+									goto l266
 								}
 								position++
 								break
 							default:
 								if c := buffer[position]; c < rune('a') || c > rune('z') {
-									goto l231
+									goto l266
 								}
 								position++
 								break
 							}
 						}
 
-						goto l230
-					l231:
-						position, tokenIndex, depth = position231, tokenIndex231, depth231
+						goto l265
+					l266:
+						position, tokenIndex, depth = position266, tokenIndex266, depth266
 					}
 					depth--
-					add(rulePegText, position228)
+					add(rulePegText, position263)
 				}
-				if !_rules[ruleAction13]() {
-					goto l226
+				if !_rules[ruleAction16]() {
+					goto l261
 				}
 				depth--
-				add(rulejsid, position227)
+				add(rulejsid, position262)
 			}
 			return true
-		l226:
-			position, tokenIndex, depth = position226, tokenIndex226, depth226
+		l261:
+			position, tokenIndex, depth = position261, tokenIndex261, depth261
 			return false
 		},
-		/* 19 expr <- <(<((&('\t' | ' ') isp+) | (&('(' | '[' | '{') enclosed) | (&('!' | '"' | '&' | '*' | '+' | '-' | '.' | '/' | '0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9' | ':' | '<' | '=' | '>' | 'A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z' | '^' | '_' | '`' | 'a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z' | '|') commaless))+> Action14)> */
+		/* 23 expr <- <(<((&('\t' | ' ') isp+) | (&('(' | '[' | '{') enclosed) | (&('!' | '"' | '&' | '*' | '+' | '-' | '.' | '/' | '0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9' | ':' | '<' | '=' | '>' | 'A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z' | '^' | '_' | '`' | 'a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z' | '|') commaless))+> Action17)> */
 		func() bool {
-			position233, tokenIndex233, depth233 := position, tokenIndex, depth
+			position268, tokenIndex268, depth268 := position, tokenIndex, depth
 			{
-				position234 := position
+				position269 := position
 				depth++
 				{
-					position235 := position
+					position270 := position
 					depth++
 					{
 						switch buffer[position] {
 						case '\t', ' ':
 							if !_rules[ruleisp]() {
-								goto l233
+								goto l268
 							}
-						l239:
+						l274:
 							{
-								position240, tokenIndex240, depth240 := position, tokenIndex, depth
+								position275, tokenIndex275, depth275 := position, tokenIndex, depth
 								if !_rules[ruleisp]() {
-									goto l240
+									goto l275
 								}
-								goto l239
-							l240:
-								position, tokenIndex, depth = position240, tokenIndex240, depth240
+								goto l274
+							l275:
+								position, tokenIndex, depth = position275, tokenIndex275, depth275
 							}
 							break
 						case '(', '[', '{':
 							if !_rules[ruleenclosed]() {
-								goto l233
+								goto l268
 							}
 							break
 						default:
 							if !_rules[rulecommaless]() {
-								goto l233
+								goto l268
 							}
 							break
 						}
 					}
 
-				l236:
+				l271:
 					{
-						position237, tokenIndex237, depth237 := position, tokenIndex, depth
+						position272, tokenIndex272, depth272 := position, tokenIndex, depth
 						{
 							switch buffer[position] {
 							case '\t', ' ':
 								if !_rules[ruleisp]() {
-									goto l237
+									goto l272
 								}
-							l242:
+							l277:
 								{
-									position243, tokenIndex243, depth243 := position, tokenIndex, depth
+									position278, tokenIndex278, depth278 := position, tokenIndex, depth
 									if !_rules[ruleisp]() {
-										goto l243
+										goto l278
 									}
-									goto l242
-								l243:
-									position, tokenIndex, depth = position243, tokenIndex243, depth243
+									goto l277
+								l278:
+									position, tokenIndex, depth = position278, tokenIndex278, depth278
 								}
 								break
 							case '(', '[', '{':
 								if !_rules[ruleenclosed]() {
-									goto l237
+									goto l272
 								}
 								break
 							default:
 								if !_rules[rulecommaless]() {
-									goto l237
+									goto l272
 								}
 								break
 							}
 						}
 
-						goto l236
-					l237:
-						position, tokenIndex, depth = position237, tokenIndex237, depth237
+						goto l271
+					l272:
+						position, tokenIndex, depth = position272, tokenIndex272, depth272
 					}
 					depth--
-					add(rulePegText, position235)
+					add(rulePegText, position270)
 				}
-				if !_rules[ruleAction14]() {
-					goto l233
+				if !_rules[ruleAction17]() {
+					goto l268
 				}
 				depth--
-				add(ruleexpr, position234)
+				add(ruleexpr, position269)
 			}
 			return true
-		l233:
-			position, tokenIndex, depth = position233, tokenIndex233, depth233
+		l268:
+			position, tokenIndex, depth = position268, tokenIndex268, depth268
 			return false
 		},
-		/* 20 commaless <- <((((&('_') '_') | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') [a-z]))+ '.' ((&('_') '_') | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') [a-z]))+) / ((&('"' | '`') string) | (&('!' | '&' | '*' | '+' | '-' | '.' | '/' | ':' | '<' | '=' | '>' | '^' | '|') operators) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') number) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z' | '_' | 'a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') identifier)))> */
+		/* 24 commaless <- <((((&('_') '_') | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') [a-z]))+ '.' ((&('_') '_') | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') [a-z]))+) / ((&('"' | '`') string) | (&('!' | '&' | '*' | '+' | '-' | '.' | '/' | ':' | '<' | '=' | '>' | '^' | '|') operators) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') number) | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z' | '_' | 'a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') identifier)))> */
 		func() bool {
-			position244, tokenIndex244, depth244 := position, tokenIndex, depth
+			position279, tokenIndex279, depth279 := position, tokenIndex, depth
 			{
-				position245 := position
+				position280 := position
 				depth++
 				{
-					position246, tokenIndex246, depth246 := position, tokenIndex, depth
+					position281, tokenIndex281, depth281 := position, tokenIndex, depth
 					{
 						switch buffer[position] {
 						case '_':
 							if buffer[position] != rune('_') {
-								goto l247
+								goto l282
 							}
 							position++
 							break
 						case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
 							if c := buffer[position]; c < rune('A') || c > rune('Z') {
-								goto l247
+								goto l282
 							}
 							position++
 							break
 						default:
 							if c := buffer[position]; c < rune('a') || c > rune('z') {
-								goto l247
+								goto l282
 							}
 							position++
 							break
 						}
 					}
 
-				l248:
+				l283:
 					{
-						position249, tokenIndex249, depth249 := position, tokenIndex, depth
+						position284, tokenIndex284, depth284 := position, tokenIndex, depth
 						{
 							switch buffer[position] {
 							case '_':
 								if buffer[position] != rune('_') {
-									goto l249
+									goto l284
 								}
 								position++
 								break
 							case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
 								if c := buffer[position]; c < rune('A') || c > rune('Z') {
-									goto l249
+									goto l284
 								}
 								position++
 								break
 							default:
 								if c := buffer[position]; c < rune('a') || c > rune('z') {
-									goto l249
+									goto l284
 								}
 								position++
 								break
 							}
 						}
 
-						goto l248
-					l249:
-						position, tokenIndex, depth = position249, tokenIndex249, depth249
+						goto l283
+					l284:
+						position, tokenIndex, depth = position284, tokenIndex284, depth284
 					}
 					if buffer[position] != rune('.') {
-						goto l247
+						goto l282
 					}
 					position++
 					{
 						switch buffer[position] {
 						case '_':
 							if buffer[position] != rune('_') {
-								goto l247
+								goto l282
 							}
 							position++
 							break
 						case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
 							if c := buffer[position]; c < rune('A') || c > rune('Z') {
-								goto l247
+								goto l282
 							}
 							position++
 							break
 						default:
 							if c := buffer[position]; c < rune('a') || c > rune('z') {
-								goto l247
+								goto l282
 							}
 							position++
 							break
 						}
 					}
 
-				l252:
+				l287:
 					{
-						position253, tokenIndex253, depth253 := position, tokenIndex, depth
+						position288, tokenIndex288, depth288 := position, tokenIndex, depth
 						{
 							switch buffer[position] {
 							case '_':
 								if buffer[position] != rune('_') {
-									goto l253
+									goto l288
 								}
 								position++
 								break
 							case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
 								if c := buffer[position]; c < rune('A') || c > rune('Z') {
-									goto l253
+									goto l288
 								}
 								position++
 								break
 							default:
 								if c := buffer[position]; c < rune('a') || c > rune('z') {
-									goto l253
+									goto l288
 								}
 								position++
 								break
 							}
 						}
 
-						goto l252
-					l253:
-						position, tokenIndex, depth = position253, tokenIndex253, depth253
+						goto l287
+					l288:
+						position, tokenIndex, depth = position288, tokenIndex288, depth288
 					}
-					goto l246
-				l247:
-					position, tokenIndex, depth = position246, tokenIndex246, depth246
+					goto l281
+				l282:
+					position, tokenIndex, depth = position281, tokenIndex281, depth281
 					{
 						switch buffer[position] {
 						case '"', '`':
 							if !_rules[rulestring]() {
-								goto l244
+								goto l279
 							}
 							break
 						case '!', '&', '*', '+', '-', '.', '/', ':', '<', '=', '>', '^', '|':
 							if !_rules[ruleoperators]() {
-								goto l244
+								goto l279
 							}
 							break
 						case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
 							if !_rules[rulenumber]() {
-								goto l244
+								goto l279
 							}
 							break
 						default:
 							if !_rules[ruleidentifier]() {
-								goto l244
+								goto l279
 							}
 							break
 						}
 					}
 
 				}
-			l246:
+			l281:
 				depth--
-				add(rulecommaless, position245)
+				add(rulecommaless, position280)
 			}
 			return true
-		l244:
-			position, tokenIndex, depth = position244, tokenIndex244, depth244
+		l279:
+			position, tokenIndex, depth = position279, tokenIndex279, depth279
 			return false
 		},
-		/* 21 number <- <[0-9]+> */
+		/* 25 number <- <[0-9]+> */
 		func() bool {
-			position257, tokenIndex257, depth257 := position, tokenIndex, depth
+			position292, tokenIndex292, depth292 := position, tokenIndex, depth
 			{
-				position258 := position
+				position293 := position
 				depth++
 				if c := buffer[position]; c < rune('0') || c > rune('9') {
-					goto l257
+					goto l292
 				}
 				position++
-			l259:
+			l294:
 				{
-					position260, tokenIndex260, depth260 := position, tokenIndex, depth
+					position295, tokenIndex295, depth295 := position, tokenIndex, depth
 					if c := buffer[position]; c < rune('0') || c > rune('9') {
-						goto l260
+						goto l295
 					}
 					position++
-					goto l259
-				l260:
-					position, tokenIndex, depth = position260, tokenIndex260, depth260
+					goto l294
+				l295:
+					position, tokenIndex, depth = position295, tokenIndex295, depth295
 				}
 				depth--
-				add(rulenumber, position258)
+				add(rulenumber, position293)
 			}
 			return true
-		l257:
-			position, tokenIndex, depth = position257, tokenIndex257, depth257
+		l292:
+			position, tokenIndex, depth = position292, tokenIndex292, depth292
 			return false
 		},
-		/* 22 operators <- <((&('>') '>') | (&('<') '<') | (&('!') '!') | (&('.') '.') | (&('=') '=') | (&(':') ':') | (&('^') '^') | (&('&') '&') | (&('|') '|') | (&('/') '/') | (&('*') '*') | (&('-') '-') | (&('+') '+'))+> */
+		/* 26 operators <- <((&('>') '>') | (&('<') '<') | (&('!') '!') | (&('.') '.') | (&('=') '=') | (&(':') ':') | (&('^') '^') | (&('&') '&') | (&('|') '|') | (&('/') '/') | (&('*') '*') | (&('-') '-') | (&('+') '+'))+> */
 		func() bool {
-			position261, tokenIndex261, depth261 := position, tokenIndex, depth
+			position296, tokenIndex296, depth296 := position, tokenIndex, depth
 			{
-				position262 := position
+				position297 := position
 				depth++
 				{
 					switch buffer[position] {
 					case '>':
 						if buffer[position] != rune('>') {
-							goto l261
+							goto l296
 						}
 						position++
 						break
 					case '<':
 						if buffer[position] != rune('<') {
-							goto l261
+							goto l296
 						}
 						position++
 						break
 					case '!':
 						if buffer[position] != rune('!') {
-							goto l261
+							goto l296
 						}
 						position++
 						break
 					case '.':
 						if buffer[position] != rune('.') {
-							goto l261
+							goto l296
 						}
 						position++
 						break
 					case '=':
 						if buffer[position] != rune('=') {
-							goto l261
+							goto l296
 						}
 						position++
 						break
 					case ':':
 						if buffer[position] != rune(':') {
-							goto l261
+							goto l296
 						}
 						position++
 						break
 					case '^':
 						if buffer[position] != rune('^') {
-							goto l261
+							goto l296
 						}
 						position++
 						break
 					case '&':
 						if buffer[position] != rune('&') {
-							goto l261
+							goto l296
 						}
 						position++
 						break
 					case '|':
 						if buffer[position] != rune('|') {
-							goto l261
+							goto l296
 						}
 						position++
 						break
 					case '/':
 						if buffer[position] != rune('/') {
-							goto l261
+							goto l296
 						}
 						position++
 						break
 					case '*':
 						if buffer[position] != rune('*') {
-							goto l261
+							goto l296
 						}
 						position++
 						break
 					case '-':
 						if buffer[position] != rune('-') {
-							goto l261
+							goto l296
 						}
 						position++
 						break
 					default:
 						if buffer[position] != rune('+') {
-							goto l261
+							goto l296
 						}
 						position++
 						break
 					}
 				}
 
-			l263:
+			l298:
 				{
-					position264, tokenIndex264, depth264 := position, tokenIndex, depth
+					position299, tokenIndex299, depth299 := position, tokenIndex, depth
 					{
 						switch buffer[position] {
 						case '>':
 							if buffer[position] != rune('>') {
-								goto l264
+								goto l299
 							}
 							position++
 							break
 						case '<':
 							if buffer[position] != rune('<') {
-								goto l264
+								goto l299
 							}
 							position++
 							break
 						case '!':
 							if buffer[position] != rune('!') {
-								goto l264
+								goto l299
 							}
 							position++
 							break
 						case '.':
 							if buffer[position] != rune('.') {
-								goto l264
+								goto l299
 							}
 							position++
 							break
 						case '=':
 							if buffer[position] != rune('=') {
-								goto l264
+								goto l299
 							}
 							position++
 							break
 						case ':':
 							if buffer[position] != rune(':') {
-								goto l264
+								goto l299
 							}
 							position++
 							break
 						case '^':
 							if buffer[position] != rune('^') {
-								goto l264
+								goto l299
 							}
 							position++
 							break
 						case '&':
 							if buffer[position] != rune('&') {
-								goto l264
+								goto l299
 							}
 							position++
 							break
 						case '|':
 							if buffer[position] != rune('|') {
-								goto l264
+								goto l299
 							}
 							position++
 							break
 						case '/':
 							if buffer[position] != rune('/') {
-								goto l264
+								goto l299
 							}
 							position++
 							break
 						case '*':
 							if buffer[position] != rune('*') {
-								goto l264
+								goto l299
 							}
 							position++
 							break
 						case '-':
 							if buffer[position] != rune('-') {
-								goto l264
+								goto l299
 							}
 							position++
 							break
 						default:
 							if buffer[position] != rune('+') {
-								goto l264
+								goto l299
 							}
 							position++
 							break
 						}
 					}
 
-					goto l263
-				l264:
-					position, tokenIndex, depth = position264, tokenIndex264, depth264
+					goto l298
+				l299:
+					position, tokenIndex, depth = position299, tokenIndex299, depth299
 				}
 				depth--
-				add(ruleoperators, position262)
+				add(ruleoperators, position297)
 			}
 			return true
-		l261:
-			position, tokenIndex, depth = position261, tokenIndex261, depth261
+		l296:
+			position, tokenIndex, depth = position296, tokenIndex296, depth296
 			return false
 		},
-		/* 23 string <- <(('`' (!'`' .)* '`') / ('"' ((!'"' .) / ('\\' '"'))* '"'))> */
+		/* 27 string <- <(('`' (!'`' .)* '`') / ('"' ((!'"' .) / ('\\' '"'))* '"'))> */
 		func() bool {
-			position267, tokenIndex267, depth267 := position, tokenIndex, depth
+			position302, tokenIndex302, depth302 := position, tokenIndex, depth
 			{
-				position268 := position
+				position303 := position
 				depth++
 				{
-					position269, tokenIndex269, depth269 := position, tokenIndex, depth
+					position304, tokenIndex304, depth304 := position, tokenIndex, depth
 					if buffer[position] != rune('`') {
-						goto l270
+						goto l305
 					}
 					position++
-				l271:
+				l306:
 					{
-						position272, tokenIndex272, depth272 := position, tokenIndex, depth
+						position307, tokenIndex307, depth307 := position, tokenIndex, depth
 						{
-							position273, tokenIndex273, depth273 := position, tokenIndex, depth
+							position308, tokenIndex308, depth308 := position, tokenIndex, depth
 							if buffer[position] != rune('`') {
-								goto l273
+								goto l308
 							}
 							position++
-							goto l272
-						l273:
-							position, tokenIndex, depth = position273, tokenIndex273, depth273
+							goto l307
+						l308:
+							position, tokenIndex, depth = position308, tokenIndex308, depth308
 						}
 						if !matchDot() {
-							goto l272
+							goto l307
 						}
-						goto l271
-					l272:
-						position, tokenIndex, depth = position272, tokenIndex272, depth272
+						goto l306
+					l307:
+						position, tokenIndex, depth = position307, tokenIndex307, depth307
 					}
 					if buffer[position] != rune('`') {
-						goto l270
+						goto l305
 					}
 					position++
-					goto l269
-				l270:
-					position, tokenIndex, depth = position269, tokenIndex269, depth269
+					goto l304
+				l305:
+					position, tokenIndex, depth = position304, tokenIndex304, depth304
 					if buffer[position] != rune('"') {
-						goto l267
+						goto l302
 					}
 					position++
-				l274:
+				l309:
 					{
-						position275, tokenIndex275, depth275 := position, tokenIndex, depth
+						position310, tokenIndex310, depth310 := position, tokenIndex, depth
 						{
-							position276, tokenIndex276, depth276 := position, tokenIndex, depth
+							position311, tokenIndex311, depth311 := position, tokenIndex, depth
 							{
-								position278, tokenIndex278, depth278 := position, tokenIndex, depth
+								position313, tokenIndex313, depth313 := position, tokenIndex, depth
 								if buffer[position] != rune('"') {
-									goto l278
+									goto l313
 								}
 								position++
-								goto l277
-							l278:
-								position, tokenIndex, depth = position278, tokenIndex278, depth278
+								goto l312
+							l313:
+								position, tokenIndex, depth = position313, tokenIndex313, depth313
 							}
 							if !matchDot() {
-								goto l277
+								goto l312
 							}
-							goto l276
-						l277:
-							position, tokenIndex, depth = position276, tokenIndex276, depth276
+							goto l311
+						l312:
+							position, tokenIndex, depth = position311, tokenIndex311, depth311
 							if buffer[position] != rune('\\') {
-								goto l275
+								goto l310
 							}
 							position++
 							if buffer[position] != rune('"') {
-								goto l275
+								goto l310
 							}
 							position++
 						}
-					l276:
-						goto l274
-					l275:
-						position, tokenIndex, depth = position275, tokenIndex275, depth275
+					l311:
+						goto l309
+					l310:
+						position, tokenIndex, depth = position310, tokenIndex310, depth310
 					}
 					if buffer[position] != rune('"') {
-						goto l267
+						goto l302
 					}
 					position++
 				}
-			l269:
+			l304:
 				depth--
-				add(rulestring, position268)
+				add(rulestring, position303)
 			}
 			return true
-		l267:
-			position, tokenIndex, depth = position267, tokenIndex267, depth267
+		l302:
+			position, tokenIndex, depth = position302, tokenIndex302, depth302
 			return false
 		},
-		/* 24 enclosed <- <((&('[') brackets) | (&('{') braces) | (&('(') parens))> */
+		/* 28 enclosed <- <((&('[') brackets) | (&('{') braces) | (&('(') parens))> */
 		func() bool {
-			position279, tokenIndex279, depth279 := position, tokenIndex, depth
+			position314, tokenIndex314, depth314 := position, tokenIndex, depth
 			{
-				position280 := position
+				position315 := position
 				depth++
 				{
 					switch buffer[position] {
 					case '[':
 						if !_rules[rulebrackets]() {
-							goto l279
+							goto l314
 						}
 						break
 					case '{':
 						if !_rules[rulebraces]() {
-							goto l279
+							goto l314
 						}
 						break
 					default:
 						if !_rules[ruleparens]() {
-							goto l279
+							goto l314
 						}
 						break
 					}
 				}
 
 				depth--
-				add(ruleenclosed, position280)
+				add(ruleenclosed, position315)
 			}
 			return true
-		l279:
-			position, tokenIndex, depth = position279, tokenIndex279, depth279
+		l314:
+			position, tokenIndex, depth = position314, tokenIndex314, depth314
 			return false
 		},
-		/* 25 parens <- <('(' inner ')')> */
+		/* 29 parens <- <('(' inner ')')> */
 		func() bool {
-			position282, tokenIndex282, depth282 := position, tokenIndex, depth
+			position317, tokenIndex317, depth317 := position, tokenIndex, depth
 			{
-				position283 := position
+				position318 := position
 				depth++
 				if buffer[position] != rune('(') {
-					goto l282
+					goto l317
 				}
 				position++
 				if !_rules[ruleinner]() {
-					goto l282
+					goto l317
 				}
 				if buffer[position] != rune(')') {
-					goto l282
+					goto l317
 				}
 				position++
 				depth--
-				add(ruleparens, position283)
+				add(ruleparens, position318)
 			}
 			return true
-		l282:
-			position, tokenIndex, depth = position282, tokenIndex282, depth282
+		l317:
+			position, tokenIndex, depth = position317, tokenIndex317, depth317
 			return false
 		},
-		/* 26 braces <- <('{' inner '}')> */
+		/* 30 braces <- <('{' inner '}')> */
 		func() bool {
-			position284, tokenIndex284, depth284 := position, tokenIndex, depth
+			position319, tokenIndex319, depth319 := position, tokenIndex, depth
 			{
-				position285 := position
+				position320 := position
 				depth++
 				if buffer[position] != rune('{') {
-					goto l284
+					goto l319
 				}
 				position++
 				if !_rules[ruleinner]() {
-					goto l284
+					goto l319
 				}
 				if buffer[position] != rune('}') {
-					goto l284
+					goto l319
 				}
 				position++
 				depth--
-				add(rulebraces, position285)
+				add(rulebraces, position320)
 			}
 			return true
-		l284:
-			position, tokenIndex, depth = position284, tokenIndex284, depth284
+		l319:
+			position, tokenIndex, depth = position319, tokenIndex319, depth319
 			return false
 		},
-		/* 27 brackets <- <('[' inner ']')> */
+		/* 31 brackets <- <('[' inner ']')> */
 		func() bool {
-			position286, tokenIndex286, depth286 := position, tokenIndex, depth
+			position321, tokenIndex321, depth321 := position, tokenIndex, depth
 			{
-				position287 := position
+				position322 := position
 				depth++
 				if buffer[position] != rune('[') {
-					goto l286
+					goto l321
 				}
 				position++
 				if !_rules[ruleinner]() {
-					goto l286
+					goto l321
 				}
 				if buffer[position] != rune(']') {
-					goto l286
+					goto l321
 				}
 				position++
 				depth--
-				add(rulebrackets, position287)
+				add(rulebrackets, position322)
 			}
 			return true
-		l286:
-			position, tokenIndex, depth = position286, tokenIndex286, depth286
+		l321:
+			position, tokenIndex, depth = position321, tokenIndex321, depth321
 			return false
 		},
-		/* 28 inner <- <((&('\t' | ' ') isp+) | (&(',') ',') | (&('(' | '[' | '{') enclosed) | (&('!' | '"' | '&' | '*' | '+' | '-' | '.' | '/' | '0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9' | ':' | '<' | '=' | '>' | 'A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z' | '^' | '_' | '`' | 'a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z' | '|') commaless))*> */
+		/* 32 inner <- <((&('\t' | ' ') isp+) | (&(',') ',') | (&('(' | '[' | '{') enclosed) | (&('!' | '"' | '&' | '*' | '+' | '-' | '.' | '/' | '0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9' | ':' | '<' | '=' | '>' | 'A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z' | '^' | '_' | '`' | 'a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z' | '|') commaless))*> */
 		func() bool {
 			{
-				position289 := position
+				position324 := position
 				depth++
-			l290:
+			l325:
 				{
-					position291, tokenIndex291, depth291 := position, tokenIndex, depth
+					position326, tokenIndex326, depth326 := position, tokenIndex, depth
 					{
 						switch buffer[position] {
 						case '\t', ' ':
 							if !_rules[ruleisp]() {
-								goto l291
+								goto l326
 							}
-						l293:
+						l328:
 							{
-								position294, tokenIndex294, depth294 := position, tokenIndex, depth
+								position329, tokenIndex329, depth329 := position, tokenIndex, depth
 								if !_rules[ruleisp]() {
-									goto l294
+									goto l329
 								}
-								goto l293
-							l294:
-								position, tokenIndex, depth = position294, tokenIndex294, depth294
+								goto l328
+							l329:
+								position, tokenIndex, depth = position329, tokenIndex329, depth329
 							}
 							break
 						case ',':
 							if buffer[position] != rune(',') {
-								goto l291
+								goto l326
 							}
 							position++
 							break
 						case '(', '[', '{':
 							if !_rules[ruleenclosed]() {
-								goto l291
+								goto l326
 							}
 							break
 						default:
 							if !_rules[rulecommaless]() {
-								goto l291
+								goto l326
 							}
 							break
 						}
 					}
 
-					goto l290
-				l291:
-					position, tokenIndex, depth = position291, tokenIndex291, depth291
+					goto l325
+				l326:
+					position, tokenIndex, depth = position326, tokenIndex326, depth326
 				}
 				depth--
-				add(ruleinner, position289)
+				add(ruleinner, position324)
 			}
 			return true
 		},
-		/* 29 identifier <- <(((&('_') '_') | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') [a-z])) ((&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') ([0-9] / [0-9])) | (&('_') '_') | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') [a-z]))*)> */
+		/* 33 identifier <- <(((&('_') '_') | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') [a-z])) ((&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') ([0-9] / [0-9])) | (&('_') '_') | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') [a-z]))*)> */
 		func() bool {
-			position295, tokenIndex295, depth295 := position, tokenIndex, depth
+			position330, tokenIndex330, depth330 := position, tokenIndex, depth
 			{
-				position296 := position
+				position331 := position
 				depth++
 				{
 					switch buffer[position] {
 					case '_':
 						if buffer[position] != rune('_') {
-							goto l295
+							goto l330
 						}
 						position++
 						break
 					case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
 						if c := buffer[position]; c < rune('A') || c > rune('Z') {
-							goto l295
+							goto l330
 						}
 						position++
 						break
 					default:
 						if c := buffer[position]; c < rune('a') || c > rune('z') {
-							goto l295
+							goto l330
 						}
 						position++
 						break
 					}
 				}
 
-			l298:
+			l333:
 				{
-					position299, tokenIndex299, depth299 := position, tokenIndex, depth
+					position334, tokenIndex334, depth334 := position, tokenIndex, depth
 					{
 						switch buffer[position] {
 						case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
 							{
-								position301, tokenIndex301, depth301 := position, tokenIndex, depth
+								position336, tokenIndex336, depth336 := position, tokenIndex, depth
 								if c := buffer[position]; c < rune('0') || c > rune('9') {
-									goto l302
+									goto l337
 								}
 								position++
-								goto l301
-							l302:
-								position, tokenIndex, depth = position301, tokenIndex301, depth301
+								goto l336
+							l337:
+								position, tokenIndex, depth = position336, tokenIndex336, depth336
 								if c := buffer[position]; c < rune('0') || c > rune('9') {
-									goto l299
+									goto l334
 								}
 								position++
 							}
-						l301:
+						l336:
 							break
 						case '_':
 							if buffer[position] != rune('_') {
-								goto l299
+								goto l334
 							}
 							position++
 							break
 						case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
 							if c := buffer[position]; c < rune('A') || c > rune('Z') {
-								goto l299
+								goto l334
 							}
 							position++
 							break
 						default:
 							if c := buffer[position]; c < rune('a') || c > rune('z') {
-								goto l299
+								goto l334
 							}
 							position++
 							break
 						}
 					}
 
-					goto l298
-				l299:
-					position, tokenIndex, depth = position299, tokenIndex299, depth299
+					goto l333
+				l334:
+					position, tokenIndex, depth = position334, tokenIndex334, depth334
 				}
 				depth--
-				add(ruleidentifier, position296)
+				add(ruleidentifier, position331)
 			}
 			return true
-		l295:
-			position, tokenIndex, depth = position295, tokenIndex295, depth295
+		l330:
+			position, tokenIndex, depth = position330, tokenIndex330, depth330
 			return false
 		},
-		/* 30 fields <- <(((&('\n') '\n') | (&('\t') '\t') | (&(' ') ' ') | (&(';') ';'))* field isp* (fsep isp* (fsep isp*)* field)* ((&('\n') '\n') | (&('\t') '\t') | (&(' ') ' ') | (&(';') ';'))* !.)> */
+		/* 34 fields <- <(((&('\n') '\n') | (&('\t') '\t') | (&(' ') ' ') | (&(';') ';'))* field isp* (fsep isp* (fsep isp*)* field)* ((&('\n') '\n') | (&('\t') '\t') | (&(' ') ' ') | (&(';') ';'))* !.)> */
 		func() bool {
-			position303, tokenIndex303, depth303 := position, tokenIndex, depth
+			position338, tokenIndex338, depth338 := position, tokenIndex, depth
 			{
-				position304 := position
+				position339 := position
 				depth++
-			l305:
+			l340:
 				{
-					position306, tokenIndex306, depth306 := position, tokenIndex, depth
+					position341, tokenIndex341, depth341 := position, tokenIndex, depth
 					{
 						switch buffer[position] {
 						case '\n':
 							if buffer[position] != rune('\n') {
-								goto l306
+								goto l341
 							}
 							position++
 							break
 						case '\t':
 							if buffer[position] != rune('\t') {
-								goto l306
+								goto l341
 							}
 							position++
 							break
 						case ' ':
 							if buffer[position] != rune(' ') {
-								goto l306
+								goto l341
 							}
 							position++
 							break
 						default:
 							if buffer[position] != rune(';') {
-								goto l306
+								goto l341
 							}
 							position++
 							break
 						}
 					}
 
-					goto l305
-				l306:
-					position, tokenIndex, depth = position306, tokenIndex306, depth306
+					goto l340
+				l341:
+					position, tokenIndex, depth = position341, tokenIndex341, depth341
 				}
 				if !_rules[rulefield]() {
-					goto l303
+					goto l338
 				}
-			l308:
+			l343:
 				{
-					position309, tokenIndex309, depth309 := position, tokenIndex, depth
+					position344, tokenIndex344, depth344 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l309
+						goto l344
 					}
-					goto l308
-				l309:
-					position, tokenIndex, depth = position309, tokenIndex309, depth309
+					goto l343
+				l344:
+					position, tokenIndex, depth = position344, tokenIndex344, depth344
 				}
-			l310:
+			l345:
 				{
-					position311, tokenIndex311, depth311 := position, tokenIndex, depth
+					position346, tokenIndex346, depth346 := position, tokenIndex, depth
 					if !_rules[rulefsep]() {
-						goto l311
+						goto l346
 					}
-				l312:
+				l347:
 					{
-						position313, tokenIndex313, depth313 := position, tokenIndex, depth
+						position348, tokenIndex348, depth348 := position, tokenIndex, depth
 						if !_rules[ruleisp]() {
-							goto l313
+							goto l348
 						}
-						goto l312
-					l313:
-						position, tokenIndex, depth = position313, tokenIndex313, depth313
+						goto l347
+					l348:
+						position, tokenIndex, depth = position348, tokenIndex348, depth348
 					}
-				l314:
+				l349:
 					{
-						position315, tokenIndex315, depth315 := position, tokenIndex, depth
+						position350, tokenIndex350, depth350 := position, tokenIndex, depth
 						if !_rules[rulefsep]() {
-							goto l315
+							goto l350
 						}
-					l316:
+					l351:
 						{
-							position317, tokenIndex317, depth317 := position, tokenIndex, depth
+							position352, tokenIndex352, depth352 := position, tokenIndex, depth
 							if !_rules[ruleisp]() {
-								goto l317
+								goto l352
 							}
-							goto l316
-						l317:
-							position, tokenIndex, depth = position317, tokenIndex317, depth317
+							goto l351
+						l352:
+							position, tokenIndex, depth = position352, tokenIndex352, depth352
 						}
-						goto l314
-					l315:
-						position, tokenIndex, depth = position315, tokenIndex315, depth315
+						goto l349
+					l350:
+						position, tokenIndex, depth = position350, tokenIndex350, depth350
 					}
 					if !_rules[rulefield]() {
-						goto l311
+						goto l346
 					}
-					goto l310
-				l311:
-					position, tokenIndex, depth = position311, tokenIndex311, depth311
+					goto l345
+				l346:
+					position, tokenIndex, depth = position346, tokenIndex346, depth346
 				}
-			l318:
+			l353:
 				{
-					position319, tokenIndex319, depth319 := position, tokenIndex, depth
+					position354, tokenIndex354, depth354 := position, tokenIndex, depth
 					{
 						switch buffer[position] {
 						case '\n':
 							if buffer[position] != rune('\n') {
-								goto l319
+								goto l354
 							}
 							position++
 							break
 						case '\t':
 							if buffer[position] != rune('\t') {
-								goto l319
+								goto l354
 							}
 							position++
 							break
 						case ' ':
 							if buffer[position] != rune(' ') {
-								goto l319
+								goto l354
 							}
 							position++
 							break
 						default:
 							if buffer[position] != rune(';') {
-								goto l319
+								goto l354
 							}
 							position++
 							break
 						}
 					}
 
-					goto l318
-				l319:
-					position, tokenIndex, depth = position319, tokenIndex319, depth319
+					goto l353
+				l354:
+					position, tokenIndex, depth = position354, tokenIndex354, depth354
 				}
 				{
-					position321, tokenIndex321, depth321 := position, tokenIndex, depth
+					position356, tokenIndex356, depth356 := position, tokenIndex, depth
 					if !matchDot() {
-						goto l321
+						goto l356
 					}
-					goto l303
-				l321:
-					position, tokenIndex, depth = position321, tokenIndex321, depth321
+					goto l338
+				l356:
+					position, tokenIndex, depth = position356, tokenIndex356, depth356
 				}
 				depth--
-				add(rulefields, position304)
+				add(rulefields, position339)
 			}
 			return true
-		l303:
-			position, tokenIndex, depth = position303, tokenIndex303, depth303
+		l338:
+			position, tokenIndex, depth = position338, tokenIndex338, depth338
 			return false
 		},
-		/* 31 fsep <- <(';' / '\n')> */
+		/* 35 fsep <- <(';' / '\n')> */
 		func() bool {
-			position322, tokenIndex322, depth322 := position, tokenIndex, depth
+			position357, tokenIndex357, depth357 := position, tokenIndex, depth
 			{
-				position323 := position
+				position358 := position
 				depth++
 				{
-					position324, tokenIndex324, depth324 := position, tokenIndex, depth
+					position359, tokenIndex359, depth359 := position, tokenIndex, depth
 					if buffer[position] != rune(';') {
-						goto l325
+						goto l360
 					}
 					position++
-					goto l324
-				l325:
-					position, tokenIndex, depth = position324, tokenIndex324, depth324
+					goto l359
+				l360:
+					position, tokenIndex, depth = position359, tokenIndex359, depth359
 					if buffer[position] != rune('\n') {
-						goto l322
+						goto l357
 					}
 					position++
 				}
-			l324:
+			l359:
 				depth--
-				add(rulefsep, position323)
+				add(rulefsep, position358)
 			}
 			return true
-		l322:
-			position, tokenIndex, depth = position322, tokenIndex322, depth322
+		l357:
+			position, tokenIndex, depth = position357, tokenIndex357, depth357
 			return false
 		},
-		/* 32 field <- <(name (isp* ',' isp* name)* isp+ type isp* ('=' isp* expr)? Action15)> */
+		/* 36 field <- <(name (isp* ',' isp* name)* isp+ type isp* ('=' isp* expr)? Action18)> */
 		func() bool {
-			position326, tokenIndex326, depth326 := position, tokenIndex, depth
+			position361, tokenIndex361, depth361 := position, tokenIndex, depth
 			{
-				position327 := position
+				position362 := position
 				depth++
 				if !_rules[rulename]() {
-					goto l326
+					goto l361
 				}
-			l328:
+			l363:
 				{
-					position329, tokenIndex329, depth329 := position, tokenIndex, depth
-				l330:
+					position364, tokenIndex364, depth364 := position, tokenIndex, depth
+				l365:
 					{
-						position331, tokenIndex331, depth331 := position, tokenIndex, depth
+						position366, tokenIndex366, depth366 := position, tokenIndex, depth
 						if !_rules[ruleisp]() {
-							goto l331
+							goto l366
 						}
-						goto l330
-					l331:
-						position, tokenIndex, depth = position331, tokenIndex331, depth331
+						goto l365
+					l366:
+						position, tokenIndex, depth = position366, tokenIndex366, depth366
 					}
 					if buffer[position] != rune(',') {
-						goto l329
+						goto l364
 					}
 					position++
-				l332:
+				l367:
 					{
-						position333, tokenIndex333, depth333 := position, tokenIndex, depth
+						position368, tokenIndex368, depth368 := position, tokenIndex, depth
 						if !_rules[ruleisp]() {
-							goto l333
+							goto l368
 						}
-						goto l332
-					l333:
-						position, tokenIndex, depth = position333, tokenIndex333, depth333
+						goto l367
+					l368:
+						position, tokenIndex, depth = position368, tokenIndex368, depth368
 					}
 					if !_rules[rulename]() {
-						goto l329
+						goto l364
 					}
-					goto l328
-				l329:
-					position, tokenIndex, depth = position329, tokenIndex329, depth329
+					goto l363
+				l364:
+					position, tokenIndex, depth = position364, tokenIndex364, depth364
 				}
 				if !_rules[ruleisp]() {
-					goto l326
+					goto l361
 				}
-			l334:
+			l369:
 				{
-					position335, tokenIndex335, depth335 := position, tokenIndex, depth
+					position370, tokenIndex370, depth370 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l335
+						goto l370
 					}
-					goto l334
-				l335:
-					position, tokenIndex, depth = position335, tokenIndex335, depth335
+					goto l369
+				l370:
+					position, tokenIndex, depth = position370, tokenIndex370, depth370
 				}
 				if !_rules[ruletype]() {
-					goto l326
+					goto l361
 				}
-			l336:
+			l371:
 				{
-					position337, tokenIndex337, depth337 := position, tokenIndex, depth
+					position372, tokenIndex372, depth372 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l337
+						goto l372
 					}
-					goto l336
-				l337:
-					position, tokenIndex, depth = position337, tokenIndex337, depth337
+					goto l371
+				l372:
+					position, tokenIndex, depth = position372, tokenIndex372, depth372
 				}
 				{
-					position338, tokenIndex338, depth338 := position, tokenIndex, depth
+					position373, tokenIndex373, depth373 := position, tokenIndex, depth
 					if buffer[position] != rune('=') {
-						goto l338
+						goto l373
 					}
 					position++
-				l340:
+				l375:
 					{
-						position341, tokenIndex341, depth341 := position, tokenIndex, depth
+						position376, tokenIndex376, depth376 := position, tokenIndex, depth
 						if !_rules[ruleisp]() {
-							goto l341
+							goto l376
 						}
-						goto l340
-					l341:
-						position, tokenIndex, depth = position341, tokenIndex341, depth341
+						goto l375
+					l376:
+						position, tokenIndex, depth = position376, tokenIndex376, depth376
 					}
 					if !_rules[ruleexpr]() {
-						goto l338
+						goto l373
 					}
-					goto l339
-				l338:
-					position, tokenIndex, depth = position338, tokenIndex338, depth338
+					goto l374
+				l373:
+					position, tokenIndex, depth = position373, tokenIndex373, depth373
 				}
-			l339:
-				if !_rules[ruleAction15]() {
-					goto l326
+			l374:
+				if !_rules[ruleAction18]() {
+					goto l361
 				}
 				depth--
-				add(rulefield, position327)
+				add(rulefield, position362)
 			}
 			return true
-		l326:
-			position, tokenIndex, depth = position326, tokenIndex326, depth326
+		l361:
+			position, tokenIndex, depth = position361, tokenIndex361, depth361
 			return false
 		},
-		/* 33 name <- <(<((&('_') '_') | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') [a-z]))+> Action16)> */
+		/* 37 name <- <(<((&('_') '_') | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') [a-z]))+> Action19)> */
 		func() bool {
-			position342, tokenIndex342, depth342 := position, tokenIndex, depth
+			position377, tokenIndex377, depth377 := position, tokenIndex, depth
 			{
-				position343 := position
+				position378 := position
 				depth++
 				{
-					position344 := position
+					position379 := position
 					depth++
 					{
 						switch buffer[position] {
 						case '_':
 							if buffer[position] != rune('_') {
-								goto l342
+								goto l377
 							}
 							position++
 							break
 						case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
 							if c := buffer[position]; c < rune('A') || c > rune('Z') {
-								goto l342
+								goto l377
 							}
 							position++
 							break
 						default:
 							if c := buffer[position]; c < rune('a') || c > rune('z') {
-								goto l342
+								goto l377
 							}
 							position++
 							break
 						}
 					}
 
-				l345:
+				l380:
 					{
-						position346, tokenIndex346, depth346 := position, tokenIndex, depth
+						position381, tokenIndex381, depth381 := position, tokenIndex, depth
 						{
 							switch buffer[position] {
 							case '_':
 								if buffer[position] != rune('_') {
-									goto l346
+									goto l381
 								}
 								position++
 								break
 							case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
 								if c := buffer[position]; c < rune('A') || c > rune('Z') {
-									goto l346
+									goto l381
 								}
 								position++
 								break
 							default:
 								if c := buffer[position]; c < rune('a') || c > rune('z') {
-									goto l346
+									goto l381
 								}
 								position++
 								break
 							}
 						}
 
-						goto l345
-					l346:
-						position, tokenIndex, depth = position346, tokenIndex346, depth346
+						goto l380
+					l381:
+						position, tokenIndex, depth = position381, tokenIndex381, depth381
 					}
 					depth--
-					add(rulePegText, position344)
+					add(rulePegText, position379)
 				}
-				if !_rules[ruleAction16]() {
-					goto l342
+				if !_rules[ruleAction19]() {
+					goto l377
 				}
 				depth--
-				add(rulename, position343)
+				add(rulename, position378)
 			}
 			return true
-		l342:
-			position, tokenIndex, depth = position342, tokenIndex342, depth342
+		l377:
+			position, tokenIndex, depth = position377, tokenIndex377, depth377
 			return false
 		},
-		/* 34 type <- <(chan / func / qname / sname / ((&('*') pointer) | (&('[') array) | (&('M' | 'm') map)))> */
+		/* 38 type <- <(chan / func / qname / sname / ((&('*') pointer) | (&('[') array) | (&('M' | 'm') map)))> */
 		func() bool {
-			position349, tokenIndex349, depth349 := position, tokenIndex, depth
+			position384, tokenIndex384, depth384 := position, tokenIndex, depth
 			{
-				position350 := position
+				position385 := position
 				depth++
 				{
-					position351, tokenIndex351, depth351 := position, tokenIndex, depth
+					position386, tokenIndex386, depth386 := position, tokenIndex, depth
 					if !_rules[rulechan]() {
-						goto l352
+						goto l387
 					}
-					goto l351
-				l352:
-					position, tokenIndex, depth = position351, tokenIndex351, depth351
+					goto l386
+				l387:
+					position, tokenIndex, depth = position386, tokenIndex386, depth386
 					if !_rules[rulefunc]() {
-						goto l353
+						goto l388
 					}
-					goto l351
-				l353:
-					position, tokenIndex, depth = position351, tokenIndex351, depth351
+					goto l386
+				l388:
+					position, tokenIndex, depth = position386, tokenIndex386, depth386
 					if !_rules[ruleqname]() {
-						goto l354
+						goto l389
 					}
-					goto l351
-				l354:
-					position, tokenIndex, depth = position351, tokenIndex351, depth351
+					goto l386
+				l389:
+					position, tokenIndex, depth = position386, tokenIndex386, depth386
 					if !_rules[rulesname]() {
-						goto l355
+						goto l390
 					}
-					goto l351
-				l355:
-					position, tokenIndex, depth = position351, tokenIndex351, depth351
+					goto l386
+				l390:
+					position, tokenIndex, depth = position386, tokenIndex386, depth386
 					{
 						switch buffer[position] {
 						case '*':
 							if !_rules[rulepointer]() {
-								goto l349
+								goto l384
 							}
 							break
 						case '[':
 							if !_rules[rulearray]() {
-								goto l349
+								goto l384
 							}
 							break
 						default:
 							if !_rules[rulemap]() {
-								goto l349
+								goto l384
 							}
 							break
 						}
 					}
 
 				}
-			l351:
+			l386:
 				depth--
-				add(ruletype, position350)
+				add(ruletype, position385)
 			}
 			return true
-		l349:
-			position, tokenIndex, depth = position349, tokenIndex349, depth349
+		l384:
+			position, tokenIndex, depth = position384, tokenIndex384, depth384
 			return false
 		},
-		/* 35 sname <- <(<((&('_') '_') | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') [a-z]))+> Action17)> */
+		/* 39 sname <- <(<((&('_') '_') | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') [a-z]))+> Action20)> */
 		func() bool {
-			position357, tokenIndex357, depth357 := position, tokenIndex, depth
+			position392, tokenIndex392, depth392 := position, tokenIndex, depth
 			{
-				position358 := position
+				position393 := position
 				depth++
 				{
-					position359 := position
+					position394 := position
 					depth++
 					{
 						switch buffer[position] {
 						case '_':
 							if buffer[position] != rune('_') {
-								goto l357
+								goto l392
 							}
 							position++
 							break
 						case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
 							if c := buffer[position]; c < rune('A') || c > rune('Z') {
-								goto l357
+								goto l392
 							}
 							position++
 							break
 						default:
 							if c := buffer[position]; c < rune('a') || c > rune('z') {
-								goto l357
+								goto l392
 							}
 							position++
 							break
 						}
 					}
 
-				l360:
+				l395:
 					{
-						position361, tokenIndex361, depth361 := position, tokenIndex, depth
+						position396, tokenIndex396, depth396 := position, tokenIndex, depth
 						{
 							switch buffer[position] {
 							case '_':
 								if buffer[position] != rune('_') {
-									goto l361
+									goto l396
 								}
 								position++
 								break
 							case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
 								if c := buffer[position]; c < rune('A') || c > rune('Z') {
-									goto l361
+									goto l396
 								}
 								position++
 								break
 							default:
 								if c := buffer[position]; c < rune('a') || c > rune('z') {
-									goto l361
+									goto l396
 								}
 								position++
 								break
 							}
 						}
 
-						goto l360
-					l361:
-						position, tokenIndex, depth = position361, tokenIndex361, depth361
+						goto l395
+					l396:
+						position, tokenIndex, depth = position396, tokenIndex396, depth396
 					}
 					depth--
-					add(rulePegText, position359)
+					add(rulePegText, position394)
 				}
-				if !_rules[ruleAction17]() {
-					goto l357
+				if !_rules[ruleAction20]() {
+					goto l392
 				}
 				depth--
-				add(rulesname, position358)
+				add(rulesname, position393)
 			}
 			return true
-		l357:
-			position, tokenIndex, depth = position357, tokenIndex357, depth357
+		l392:
+			position, tokenIndex, depth = position392, tokenIndex392, depth392
 			return false
 		},
-		/* 36 qname <- <(<(((&('_') '_') | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') [a-z]))+ '.' ((&('_') '_') | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') [a-z]))+)> Action18)> */
+		/* 40 qname <- <(<(((&('_') '_') | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') [a-z]))+ '.' ((&('_') '_') | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') [a-z]))+)> Action21)> */
 		func() bool {
-			position364, tokenIndex364, depth364 := position, tokenIndex, depth
+			position399, tokenIndex399, depth399 := position, tokenIndex, depth
 			{
-				position365 := position
+				position400 := position
 				depth++
 				{
-					position366 := position
+					position401 := position
 					depth++
 					{
 						switch buffer[position] {
 						case '_':
 							if buffer[position] != rune('_') {
-								goto l364
+								goto l399
 							}
 							position++
 							break
 						case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
 							if c := buffer[position]; c < rune('A') || c > rune('Z') {
-								goto l364
+								goto l399
 							}
 							position++
 							break
 						default:
 							if c := buffer[position]; c < rune('a') || c > rune('z') {
-								goto l364
+								goto l399
 							}
 							position++
 							break
 						}
 					}
 
-				l367:
+				l402:
 					{
-						position368, tokenIndex368, depth368 := position, tokenIndex, depth
+						position403, tokenIndex403, depth403 := position, tokenIndex, depth
 						{
 							switch buffer[position] {
 							case '_':
 								if buffer[position] != rune('_') {
-									goto l368
+									goto l403
 								}
 								position++
 								break
 							case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
 								if c := buffer[position]; c < rune('A') || c > rune('Z') {
-									goto l368
+									goto l403
 								}
 								position++
 								break
 							default:
 								if c := buffer[position]; c < rune('a') || c > rune('z') {
-									goto l368
+									goto l403
 								}
 								position++
 								break
 							}
 						}
 
-						goto l367
-					l368:
-						position, tokenIndex, depth = position368, tokenIndex368, depth368
+						goto l402
+					l403:
+						position, tokenIndex, depth = position403, tokenIndex403, depth403
 					}
 					if buffer[position] != rune('.') {
-						goto l364
+						goto l399
 					}
 					position++
 					{
 						switch buffer[position] {
 						case '_':
 							if buffer[position] != rune('_') {
-								goto l364
+								goto l399
 							}
 							position++
 							break
 						case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
 							if c := buffer[position]; c < rune('A') || c > rune('Z') {
-								goto l364
+								goto l399
 							}
 							position++
 							break
 						default:
 							if c := buffer[position]; c < rune('a') || c > rune('z') {
-								goto l364
+								goto l399
 							}
 							position++
 							break
 						}
 					}
 
-				l371:
+				l406:
 					{
-						position372, tokenIndex372, depth372 := position, tokenIndex, depth
+						position407, tokenIndex407, depth407 := position, tokenIndex, depth
 						{
 							switch buffer[position] {
 							case '_':
 								if buffer[position] != rune('_') {
-									goto l372
+									goto l407
 								}
 								position++
 								break
 							case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
 								if c := buffer[position]; c < rune('A') || c > rune('Z') {
-									goto l372
+									goto l407
 								}
 								position++
 								break
 							default:
 								if c := buffer[position]; c < rune('a') || c > rune('z') {
-									goto l372
+									goto l407
 								}
 								position++
 								break
 							}
 						}
 
-						goto l371
-					l372:
-						position, tokenIndex, depth = position372, tokenIndex372, depth372
+						goto l406
+					l407:
+						position, tokenIndex, depth = position407, tokenIndex407, depth407
 					}
 					depth--
-					add(rulePegText, position366)
+					add(rulePegText, position401)
 				}
-				if !_rules[ruleAction18]() {
-					goto l364
+				if !_rules[ruleAction21]() {
+					goto l399
 				}
 				depth--
-				add(ruleqname, position365)
+				add(ruleqname, position400)
 			}
 			return true
-		l364:
-			position, tokenIndex, depth = position364, tokenIndex364, depth364
+		l399:
+			position, tokenIndex, depth = position399, tokenIndex399, depth399
 			return false
 		},
-		/* 37 array <- <('[' ']' type Action19)> */
+		/* 41 array <- <('[' ']' type Action22)> */
 		func() bool {
-			position375, tokenIndex375, depth375 := position, tokenIndex, depth
+			position410, tokenIndex410, depth410 := position, tokenIndex, depth
 			{
-				position376 := position
+				position411 := position
 				depth++
 				if buffer[position] != rune('[') {
-					goto l375
+					goto l410
 				}
 				position++
 				if buffer[position] != rune(']') {
-					goto l375
+					goto l410
 				}
 				position++
 				if !_rules[ruletype]() {
-					goto l375
+					goto l410
 				}
-				if !_rules[ruleAction19]() {
-					goto l375
+				if !_rules[ruleAction22]() {
+					goto l410
 				}
 				depth--
-				add(rulearray, position376)
+				add(rulearray, position411)
 			}
 			return true
-		l375:
-			position, tokenIndex, depth = position375, tokenIndex375, depth375
+		l410:
+			position, tokenIndex, depth = position410, tokenIndex410, depth410
 			return false
 		},
-		/* 38 map <- <(('m' / 'M') ('a' / 'A') ('p' / 'P') '[' isp* keytype isp* ']' type Action20)> */
+		/* 42 map <- <(('m' / 'M') ('a' / 'A') ('p' / 'P') '[' isp* keytype isp* ']' type Action23)> */
 		func() bool {
-			position377, tokenIndex377, depth377 := position, tokenIndex, depth
+			position412, tokenIndex412, depth412 := position, tokenIndex, depth
 			{
-				position378 := position
+				position413 := position
 				depth++
 				{
-					position379, tokenIndex379, depth379 := position, tokenIndex, depth
+					position414, tokenIndex414, depth414 := position, tokenIndex, depth
 					if buffer[position] != rune('m') {
-						goto l380
+						goto l415
 					}
 					position++
-					goto l379
-				l380:
-					position, tokenIndex, depth = position379, tokenIndex379, depth379
+					goto l414
+				l415:
+					position, tokenIndex, depth = position414, tokenIndex414, depth414
 					if buffer[position] != rune('M') {
-						goto l377
+						goto l412
 					}
 					position++
 				}
-			l379:
+			l414:
 				{
-					position381, tokenIndex381, depth381 := position, tokenIndex, depth
+					position416, tokenIndex416, depth416 := position, tokenIndex, depth
 					if buffer[position] != rune('a') {
-						goto l382
+						goto l417
 					}
 					position++
-					goto l381
-				l382:
-					position, tokenIndex, depth = position381, tokenIndex381, depth381
+					goto l416
+				l417:
+					position, tokenIndex, depth = position416, tokenIndex416, depth416
 					if buffer[position] != rune('A') {
-						goto l377
+						goto l412
 					}
 					position++
 				}
-			l381:
+			l416:
 				{
-					position383, tokenIndex383, depth383 := position, tokenIndex, depth
+					position418, tokenIndex418, depth418 := position, tokenIndex, depth
 					if buffer[position] != rune('p') {
-						goto l384
+						goto l419
 					}
 					position++
-					goto l383
-				l384:
-					position, tokenIndex, depth = position383, tokenIndex383, depth383
+					goto l418
+				l419:
+					position, tokenIndex, depth = position418, tokenIndex418, depth418
 					if buffer[position] != rune('P') {
-						goto l377
+						goto l412
 					}
 					position++
 				}
-			l383:
+			l418:
 				if buffer[position] != rune('[') {
-					goto l377
+					goto l412
 				}
 				position++
-			l385:
+			l420:
 				{
-					position386, tokenIndex386, depth386 := position, tokenIndex, depth
+					position421, tokenIndex421, depth421 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l386
+						goto l421
 					}
-					goto l385
-				l386:
-					position, tokenIndex, depth = position386, tokenIndex386, depth386
+					goto l420
+				l421:
+					position, tokenIndex, depth = position421, tokenIndex421, depth421
 				}
 				if !_rules[rulekeytype]() {
-					goto l377
+					goto l412
 				}
-			l387:
+			l422:
 				{
-					position388, tokenIndex388, depth388 := position, tokenIndex, depth
+					position423, tokenIndex423, depth423 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l388
+						goto l423
 					}
-					goto l387
-				l388:
-					position, tokenIndex, depth = position388, tokenIndex388, depth388
+					goto l422
+				l423:
+					position, tokenIndex, depth = position423, tokenIndex423, depth423
 				}
 				if buffer[position] != rune(']') {
-					goto l377
+					goto l412
 				}
 				position++
 				if !_rules[ruletype]() {
-					goto l377
+					goto l412
 				}
-				if !_rules[ruleAction20]() {
-					goto l377
+				if !_rules[ruleAction23]() {
+					goto l412
 				}
 				depth--
-				add(rulemap, position378)
+				add(rulemap, position413)
 			}
 			return true
-		l377:
-			position, tokenIndex, depth = position377, tokenIndex377, depth377
+		l412:
+			position, tokenIndex, depth = position412, tokenIndex412, depth412
 			return false
 		},
-		/* 39 chan <- <(('c' / 'C') ('h' / 'H') ('a' / 'A') ('n' / 'N') isp+ type Action21)> */
+		/* 43 chan <- <(('c' / 'C') ('h' / 'H') ('a' / 'A') ('n' / 'N') isp+ type Action24)> */
 		func() bool {
-			position389, tokenIndex389, depth389 := position, tokenIndex, depth
+			position424, tokenIndex424, depth424 := position, tokenIndex, depth
 			{
-				position390 := position
+				position425 := position
 				depth++
 				{
-					position391, tokenIndex391, depth391 := position, tokenIndex, depth
+					position426, tokenIndex426, depth426 := position, tokenIndex, depth
 					if buffer[position] != rune('c') {
-						goto l392
+						goto l427
 					}
 					position++
-					goto l391
-				l392:
-					position, tokenIndex, depth = position391, tokenIndex391, depth391
+					goto l426
+				l427:
+					position, tokenIndex, depth = position426, tokenIndex426, depth426
 					if buffer[position] != rune('C') {
-						goto l389
+						goto l424
 					}
 					position++
 				}
-			l391:
+			l426:
 				{
-					position393, tokenIndex393, depth393 := position, tokenIndex, depth
+					position428, tokenIndex428, depth428 := position, tokenIndex, depth
 					if buffer[position] != rune('h') {
-						goto l394
+						goto l429
 					}
 					position++
-					goto l393
-				l394:
-					position, tokenIndex, depth = position393, tokenIndex393, depth393
+					goto l428
+				l429:
+					position, tokenIndex, depth = position428, tokenIndex428, depth428
 					if buffer[position] != rune('H') {
-						goto l389
+						goto l424
 					}
 					position++
 				}
-			l393:
+			l428:
 				{
-					position395, tokenIndex395, depth395 := position, tokenIndex, depth
+					position430, tokenIndex430, depth430 := position, tokenIndex, depth
 					if buffer[position] != rune('a') {
-						goto l396
+						goto l431
 					}
 					position++
-					goto l395
-				l396:
-					position, tokenIndex, depth = position395, tokenIndex395, depth395
+					goto l430
+				l431:
+					position, tokenIndex, depth = position430, tokenIndex430, depth430
 					if buffer[position] != rune('A') {
-						goto l389
+						goto l424
 					}
 					position++
 				}
-			l395:
+			l430:
 				{
-					position397, tokenIndex397, depth397 := position, tokenIndex, depth
+					position432, tokenIndex432, depth432 := position, tokenIndex, depth
 					if buffer[position] != rune('n') {
-						goto l398
+						goto l433
 					}
 					position++
-					goto l397
-				l398:
-					position, tokenIndex, depth = position397, tokenIndex397, depth397
+					goto l432
+				l433:
+					position, tokenIndex, depth = position432, tokenIndex432, depth432
 					if buffer[position] != rune('N') {
-						goto l389
+						goto l424
 					}
 					position++
 				}
-			l397:
+			l432:
 				if !_rules[ruleisp]() {
-					goto l389
+					goto l424
 				}
-			l399:
+			l434:
 				{
-					position400, tokenIndex400, depth400 := position, tokenIndex, depth
+					position435, tokenIndex435, depth435 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l400
+						goto l435
 					}
-					goto l399
-				l400:
-					position, tokenIndex, depth = position400, tokenIndex400, depth400
+					goto l434
+				l435:
+					position, tokenIndex, depth = position435, tokenIndex435, depth435
 				}
 				if !_rules[ruletype]() {
-					goto l389
+					goto l424
 				}
-				if !_rules[ruleAction21]() {
-					goto l389
+				if !_rules[ruleAction24]() {
+					goto l424
 				}
 				depth--
-				add(rulechan, position390)
+				add(rulechan, position425)
 			}
 			return true
-		l389:
-			position, tokenIndex, depth = position389, tokenIndex389, depth389
+		l424:
+			position, tokenIndex, depth = position424, tokenIndex424, depth424
 			return false
 		},
-		/* 40 func <- <(('f' / 'F') ('u' / 'U') ('n' / 'N') ('c' / 'C') isp* '(' isp* (param isp* (',' isp* param)*)? ')' isp* type? Action22)> */
+		/* 44 func <- <(('f' / 'F') ('u' / 'U') ('n' / 'N') ('c' / 'C') isp* '(' isp* (param isp* (',' isp* param)*)? ')' isp* type? Action25)> */
 		func() bool {
-			position401, tokenIndex401, depth401 := position, tokenIndex, depth
+			position436, tokenIndex436, depth436 := position, tokenIndex, depth
 			{
-				position402 := position
+				position437 := position
 				depth++
 				{
-					position403, tokenIndex403, depth403 := position, tokenIndex, depth
+					position438, tokenIndex438, depth438 := position, tokenIndex, depth
 					if buffer[position] != rune('f') {
-						goto l404
+						goto l439
 					}
 					position++
-					goto l403
-				l404:
-					position, tokenIndex, depth = position403, tokenIndex403, depth403
+					goto l438
+				l439:
+					position, tokenIndex, depth = position438, tokenIndex438, depth438
 					if buffer[position] != rune('F') {
-						goto l401
+						goto l436
 					}
 					position++
 				}
-			l403:
+			l438:
 				{
-					position405, tokenIndex405, depth405 := position, tokenIndex, depth
+					position440, tokenIndex440, depth440 := position, tokenIndex, depth
 					if buffer[position] != rune('u') {
-						goto l406
+						goto l441
 					}
 					position++
-					goto l405
-				l406:
-					position, tokenIndex, depth = position405, tokenIndex405, depth405
+					goto l440
+				l441:
+					position, tokenIndex, depth = position440, tokenIndex440, depth440
 					if buffer[position] != rune('U') {
-						goto l401
+						goto l436
 					}
 					position++
 				}
-			l405:
+			l440:
 				{
-					position407, tokenIndex407, depth407 := position, tokenIndex, depth
+					position442, tokenIndex442, depth442 := position, tokenIndex, depth
 					if buffer[position] != rune('n') {
-						goto l408
+						goto l443
 					}
 					position++
-					goto l407
-				l408:
-					position, tokenIndex, depth = position407, tokenIndex407, depth407
+					goto l442
+				l443:
+					position, tokenIndex, depth = position442, tokenIndex442, depth442
 					if buffer[position] != rune('N') {
-						goto l401
+						goto l436
 					}
 					position++
 				}
-			l407:
+			l442:
 				{
-					position409, tokenIndex409, depth409 := position, tokenIndex, depth
+					position444, tokenIndex444, depth444 := position, tokenIndex, depth
 					if buffer[position] != rune('c') {
-						goto l410
+						goto l445
 					}
 					position++
-					goto l409
-				l410:
-					position, tokenIndex, depth = position409, tokenIndex409, depth409
+					goto l444
+				l445:
+					position, tokenIndex, depth = position444, tokenIndex444, depth444
 					if buffer[position] != rune('C') {
-						goto l401
+						goto l436
 					}
 					position++
 				}
-			l409:
-			l411:
+			l444:
+			l446:
 				{
-					position412, tokenIndex412, depth412 := position, tokenIndex, depth
+					position447, tokenIndex447, depth447 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l412
+						goto l447
 					}
-					goto l411
-				l412:
-					position, tokenIndex, depth = position412, tokenIndex412, depth412
+					goto l446
+				l447:
+					position, tokenIndex, depth = position447, tokenIndex447, depth447
 				}
 				if buffer[position] != rune('(') {
-					goto l401
+					goto l436
 				}
 				position++
-			l413:
+			l448:
 				{
-					position414, tokenIndex414, depth414 := position, tokenIndex, depth
+					position449, tokenIndex449, depth449 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l414
+						goto l449
 					}
-					goto l413
-				l414:
-					position, tokenIndex, depth = position414, tokenIndex414, depth414
+					goto l448
+				l449:
+					position, tokenIndex, depth = position449, tokenIndex449, depth449
 				}
 				{
-					position415, tokenIndex415, depth415 := position, tokenIndex, depth
+					position450, tokenIndex450, depth450 := position, tokenIndex, depth
 					if !_rules[ruleparam]() {
-						goto l415
+						goto l450
 					}
-				l417:
+				l452:
 					{
-						position418, tokenIndex418, depth418 := position, tokenIndex, depth
+						position453, tokenIndex453, depth453 := position, tokenIndex, depth
 						if !_rules[ruleisp]() {
-							goto l418
+							goto l453
 						}
-						goto l417
-					l418:
-						position, tokenIndex, depth = position418, tokenIndex418, depth418
+						goto l452
+					l453:
+						position, tokenIndex, depth = position453, tokenIndex453, depth453
 					}
-				l419:
+				l454:
 					{
-						position420, tokenIndex420, depth420 := position, tokenIndex, depth
+						position455, tokenIndex455, depth455 := position, tokenIndex, depth
 						if buffer[position] != rune(',') {
-							goto l420
+							goto l455
 						}
 						position++
-					l421:
+					l456:
 						{
-							position422, tokenIndex422, depth422 := position, tokenIndex, depth
+							position457, tokenIndex457, depth457 := position, tokenIndex, depth
 							if !_rules[ruleisp]() {
-								goto l422
+								goto l457
 							}
-							goto l421
-						l422:
-							position, tokenIndex, depth = position422, tokenIndex422, depth422
+							goto l456
+						l457:
+							position, tokenIndex, depth = position457, tokenIndex457, depth457
 						}
 						if !_rules[ruleparam]() {
-							goto l420
+							goto l455
 						}
-						goto l419
-					l420:
-						position, tokenIndex, depth = position420, tokenIndex420, depth420
+						goto l454
+					l455:
+						position, tokenIndex, depth = position455, tokenIndex455, depth455
 					}
-					goto l416
-				l415:
-					position, tokenIndex, depth = position415, tokenIndex415, depth415
+					goto l451
+				l450:
+					position, tokenIndex, depth = position450, tokenIndex450, depth450
 				}
-			l416:
+			l451:
 				if buffer[position] != rune(')') {
-					goto l401
+					goto l436
 				}
 				position++
-			l423:
+			l458:
 				{
-					position424, tokenIndex424, depth424 := position, tokenIndex, depth
+					position459, tokenIndex459, depth459 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l424
+						goto l459
 					}
-					goto l423
-				l424:
-					position, tokenIndex, depth = position424, tokenIndex424, depth424
+					goto l458
+				l459:
+					position, tokenIndex, depth = position459, tokenIndex459, depth459
 				}
 				{
-					position425, tokenIndex425, depth425 := position, tokenIndex, depth
+					position460, tokenIndex460, depth460 := position, tokenIndex, depth
 					if !_rules[ruletype]() {
-						goto l425
+						goto l460
 					}
-					goto l426
-				l425:
-					position, tokenIndex, depth = position425, tokenIndex425, depth425
+					goto l461
+				l460:
+					position, tokenIndex, depth = position460, tokenIndex460, depth460
 				}
-			l426:
-				if !_rules[ruleAction22]() {
-					goto l401
+			l461:
+				if !_rules[ruleAction25]() {
+					goto l436
 				}
 				depth--
-				add(rulefunc, position402)
+				add(rulefunc, position437)
 			}
 			return true
-		l401:
-			position, tokenIndex, depth = position401, tokenIndex401, depth401
+		l436:
+			position, tokenIndex, depth = position436, tokenIndex436, depth436
 			return false
 		},
-		/* 41 keytype <- <(type Action23)> */
+		/* 45 keytype <- <(type Action26)> */
 		func() bool {
-			position427, tokenIndex427, depth427 := position, tokenIndex, depth
+			position462, tokenIndex462, depth462 := position, tokenIndex, depth
 			{
-				position428 := position
+				position463 := position
 				depth++
 				if !_rules[ruletype]() {
-					goto l427
+					goto l462
 				}
-				if !_rules[ruleAction23]() {
-					goto l427
+				if !_rules[ruleAction26]() {
+					goto l462
 				}
 				depth--
-				add(rulekeytype, position428)
+				add(rulekeytype, position463)
 			}
 			return true
-		l427:
-			position, tokenIndex, depth = position427, tokenIndex427, depth427
+		l462:
+			position, tokenIndex, depth = position462, tokenIndex462, depth462
 			return false
 		},
-		/* 42 pointer <- <('*' type Action24)> */
+		/* 46 pointer <- <('*' type Action27)> */
 		func() bool {
-			position429, tokenIndex429, depth429 := position, tokenIndex, depth
+			position464, tokenIndex464, depth464 := position, tokenIndex, depth
 			{
-				position430 := position
+				position465 := position
 				depth++
 				if buffer[position] != rune('*') {
-					goto l429
+					goto l464
 				}
 				position++
 				if !_rules[ruletype]() {
-					goto l429
+					goto l464
 				}
-				if !_rules[ruleAction24]() {
-					goto l429
+				if !_rules[ruleAction27]() {
+					goto l464
 				}
 				depth--
-				add(rulepointer, position430)
+				add(rulepointer, position465)
 			}
 			return true
-		l429:
-			position, tokenIndex, depth = position429, tokenIndex429, depth429
+		l464:
+			position, tokenIndex, depth = position464, tokenIndex464, depth464
 			return false
 		},
-		/* 43 captures <- <(isp* capture isp* (',' isp* capture isp*)* !.)> */
+		/* 47 captures <- <(isp* capture isp* (',' isp* capture isp*)* !.)> */
 		func() bool {
-			position431, tokenIndex431, depth431 := position, tokenIndex, depth
+			position466, tokenIndex466, depth466 := position, tokenIndex, depth
 			{
-				position432 := position
+				position467 := position
 				depth++
-			l433:
+			l468:
 				{
-					position434, tokenIndex434, depth434 := position, tokenIndex, depth
+					position469, tokenIndex469, depth469 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l434
+						goto l469
 					}
-					goto l433
-				l434:
-					position, tokenIndex, depth = position434, tokenIndex434, depth434
+					goto l468
+				l469:
+					position, tokenIndex, depth = position469, tokenIndex469, depth469
 				}
 				if !_rules[rulecapture]() {
-					goto l431
+					goto l466
 				}
-			l435:
+			l470:
 				{
-					position436, tokenIndex436, depth436 := position, tokenIndex, depth
+					position471, tokenIndex471, depth471 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l436
+						goto l471
 					}
-					goto l435
-				l436:
-					position, tokenIndex, depth = position436, tokenIndex436, depth436
+					goto l470
+				l471:
+					position, tokenIndex, depth = position471, tokenIndex471, depth471
 				}
-			l437:
+			l472:
 				{
-					position438, tokenIndex438, depth438 := position, tokenIndex, depth
+					position473, tokenIndex473, depth473 := position, tokenIndex, depth
 					if buffer[position] != rune(',') {
-						goto l438
+						goto l473
 					}
 					position++
-				l439:
+				l474:
 					{
-						position440, tokenIndex440, depth440 := position, tokenIndex, depth
+						position475, tokenIndex475, depth475 := position, tokenIndex, depth
 						if !_rules[ruleisp]() {
-							goto l440
+							goto l475
 						}
-						goto l439
-					l440:
-						position, tokenIndex, depth = position440, tokenIndex440, depth440
+						goto l474
+					l475:
+						position, tokenIndex, depth = position475, tokenIndex475, depth475
 					}
 					if !_rules[rulecapture]() {
-						goto l438
+						goto l473
 					}
-				l441:
+				l476:
 					{
-						position442, tokenIndex442, depth442 := position, tokenIndex, depth
+						position477, tokenIndex477, depth477 := position, tokenIndex, depth
 						if !_rules[ruleisp]() {
-							goto l442
+							goto l477
 						}
-						goto l441
-					l442:
-						position, tokenIndex, depth = position442, tokenIndex442, depth442
+						goto l476
+					l477:
+						position, tokenIndex, depth = position477, tokenIndex477, depth477
 					}
-					goto l437
-				l438:
-					position, tokenIndex, depth = position438, tokenIndex438, depth438
+					goto l472
+				l473:
+					position, tokenIndex, depth = position473, tokenIndex473, depth473
 				}
 				{
-					position443, tokenIndex443, depth443 := position, tokenIndex, depth
+					position478, tokenIndex478, depth478 := position, tokenIndex, depth
 					if !matchDot() {
-						goto l443
+						goto l478
 					}
-					goto l431
-				l443:
-					position, tokenIndex, depth = position443, tokenIndex443, depth443
+					goto l466
+				l478:
+					position, tokenIndex, depth = position478, tokenIndex478, depth478
 				}
 				depth--
-				add(rulecaptures, position432)
+				add(rulecaptures, position467)
 			}
 			return true
-		l431:
-			position, tokenIndex, depth = position431, tokenIndex431, depth431
+		l466:
+			position, tokenIndex, depth = position466, tokenIndex466, depth466
 			return false
 		},
-		/* 44 capture <- <(eventid isp* ':' handlername isp* mappings isp* tags Action25)> */
+		/* 48 capture <- <(eventid isp* ':' handlername isp* mappings isp* tags Action28)> */
 		func() bool {
-			position444, tokenIndex444, depth444 := position, tokenIndex, depth
+			position479, tokenIndex479, depth479 := position, tokenIndex, depth
 			{
-				position445 := position
+				position480 := position
 				depth++
 				if !_rules[ruleeventid]() {
-					goto l444
+					goto l479
 				}
-			l446:
+			l481:
 				{
-					position447, tokenIndex447, depth447 := position, tokenIndex, depth
+					position482, tokenIndex482, depth482 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l447
+						goto l482
 					}
-					goto l446
-				l447:
-					position, tokenIndex, depth = position447, tokenIndex447, depth447
+					goto l481
+				l482:
+					position, tokenIndex, depth = position482, tokenIndex482, depth482
 				}
 				if buffer[position] != rune(':') {
-					goto l444
+					goto l479
 				}
 				position++
 				if !_rules[rulehandlername]() {
-					goto l444
+					goto l479
 				}
-			l448:
+			l483:
 				{
-					position449, tokenIndex449, depth449 := position, tokenIndex, depth
+					position484, tokenIndex484, depth484 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l449
+						goto l484
 					}
-					goto l448
-				l449:
-					position, tokenIndex, depth = position449, tokenIndex449, depth449
+					goto l483
+				l484:
+					position, tokenIndex, depth = position484, tokenIndex484, depth484
 				}
 				if !_rules[rulemappings]() {
-					goto l444
+					goto l479
 				}
-			l450:
+			l485:
 				{
-					position451, tokenIndex451, depth451 := position, tokenIndex, depth
+					position486, tokenIndex486, depth486 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l451
+						goto l486
 					}
-					goto l450
-				l451:
-					position, tokenIndex, depth = position451, tokenIndex451, depth451
+					goto l485
+				l486:
+					position, tokenIndex, depth = position486, tokenIndex486, depth486
 				}
 				if !_rules[ruletags]() {
-					goto l444
+					goto l479
 				}
-				if !_rules[ruleAction25]() {
-					goto l444
+				if !_rules[ruleAction28]() {
+					goto l479
 				}
 				depth--
-				add(rulecapture, position445)
+				add(rulecapture, position480)
 			}
 			return true
-		l444:
-			position, tokenIndex, depth = position444, tokenIndex444, depth444
+		l479:
+			position, tokenIndex, depth = position479, tokenIndex479, depth479
 			return false
 		},
-		/* 45 handlername <- <(<identifier> Action26)> */
+		/* 49 handlername <- <(<identifier> Action29)> */
 		func() bool {
-			position452, tokenIndex452, depth452 := position, tokenIndex, depth
+			position487, tokenIndex487, depth487 := position, tokenIndex, depth
 			{
-				position453 := position
+				position488 := position
 				depth++
 				{
-					position454 := position
+					position489 := position
 					depth++
 					if !_rules[ruleidentifier]() {
-						goto l452
+						goto l487
 					}
 					depth--
-					add(rulePegText, position454)
+					add(rulePegText, position489)
 				}
-				if !_rules[ruleAction26]() {
-					goto l452
+				if !_rules[ruleAction29]() {
+					goto l487
 				}
 				depth--
-				add(rulehandlername, position453)
+				add(rulehandlername, position488)
 			}
 			return true
-		l452:
-			position, tokenIndex, depth = position452, tokenIndex452, depth452
+		l487:
+			position, tokenIndex, depth = position487, tokenIndex487, depth487
 			return false
 		},
-		/* 46 eventid <- <(<[a-z]+> Action27)> */
+		/* 50 eventid <- <(<identifier> Action30)> */
 		func() bool {
-			position455, tokenIndex455, depth455 := position, tokenIndex, depth
+			position490, tokenIndex490, depth490 := position, tokenIndex, depth
 			{
-				position456 := position
+				position491 := position
 				depth++
 				{
-					position457 := position
+					position492 := position
 					depth++
-					if c := buffer[position]; c < rune('a') || c > rune('z') {
-						goto l455
-					}
-					position++
-				l458:
-					{
-						position459, tokenIndex459, depth459 := position, tokenIndex, depth
-						if c := buffer[position]; c < rune('a') || c > rune('z') {
-							goto l459
-						}
-						position++
-						goto l458
-					l459:
-						position, tokenIndex, depth = position459, tokenIndex459, depth459
+					if !_rules[ruleidentifier]() {
+						goto l490
 					}
 					depth--
-					add(rulePegText, position457)
+					add(rulePegText, position492)
 				}
-				if !_rules[ruleAction27]() {
-					goto l455
+				if !_rules[ruleAction30]() {
+					goto l490
 				}
 				depth--
-				add(ruleeventid, position456)
+				add(ruleeventid, position491)
 			}
 			return true
-		l455:
-			position, tokenIndex, depth = position455, tokenIndex455, depth455
+		l490:
+			position, tokenIndex, depth = position490, tokenIndex490, depth490
 			return false
 		},
-		/* 47 mappings <- <(mappingstart (isp* mapping isp* (',' isp* mapping isp*)*)? ')')?> */
+		/* 51 mappings <- <(mappingstart (isp* mapping isp* (',' isp* mapping isp*)*)? ')')?> */
 		func() bool {
 			{
-				position461 := position
+				position494 := position
 				depth++
 				{
-					position462, tokenIndex462, depth462 := position, tokenIndex, depth
+					position495, tokenIndex495, depth495 := position, tokenIndex, depth
 					if !_rules[rulemappingstart]() {
-						goto l462
+						goto l495
 					}
 					{
-						position464, tokenIndex464, depth464 := position, tokenIndex, depth
-					l466:
+						position497, tokenIndex497, depth497 := position, tokenIndex, depth
+					l499:
 						{
-							position467, tokenIndex467, depth467 := position, tokenIndex, depth
+							position500, tokenIndex500, depth500 := position, tokenIndex, depth
 							if !_rules[ruleisp]() {
-								goto l467
+								goto l500
 							}
-							goto l466
-						l467:
-							position, tokenIndex, depth = position467, tokenIndex467, depth467
+							goto l499
+						l500:
+							position, tokenIndex, depth = position500, tokenIndex500, depth500
 						}
 						if !_rules[rulemapping]() {
-							goto l464
+							goto l497
 						}
-					l468:
+					l501:
 						{
-							position469, tokenIndex469, depth469 := position, tokenIndex, depth
+							position502, tokenIndex502, depth502 := position, tokenIndex, depth
 							if !_rules[ruleisp]() {
-								goto l469
+								goto l502
 							}
-							goto l468
-						l469:
-							position, tokenIndex, depth = position469, tokenIndex469, depth469
+							goto l501
+						l502:
+							position, tokenIndex, depth = position502, tokenIndex502, depth502
 						}
-					l470:
+					l503:
 						{
-							position471, tokenIndex471, depth471 := position, tokenIndex, depth
+							position504, tokenIndex504, depth504 := position, tokenIndex, depth
 							if buffer[position] != rune(',') {
-								goto l471
+								goto l504
 							}
 							position++
-						l472:
+						l505:
 							{
-								position473, tokenIndex473, depth473 := position, tokenIndex, depth
+								position506, tokenIndex506, depth506 := position, tokenIndex, depth
 								if !_rules[ruleisp]() {
-									goto l473
+									goto l506
 								}
-								goto l472
-							l473:
-								position, tokenIndex, depth = position473, tokenIndex473, depth473
+								goto l505
+							l506:
+								position, tokenIndex, depth = position506, tokenIndex506, depth506
 							}
 							if !_rules[rulemapping]() {
-								goto l471
+								goto l504
 							}
-						l474:
+						l507:
 							{
-								position475, tokenIndex475, depth475 := position, tokenIndex, depth
+								position508, tokenIndex508, depth508 := position, tokenIndex, depth
 								if !_rules[ruleisp]() {
-									goto l475
+									goto l508
 								}
-								goto l474
-							l475:
-								position, tokenIndex, depth = position475, tokenIndex475, depth475
+								goto l507
+							l508:
+								position, tokenIndex, depth = position508, tokenIndex508, depth508
 							}
-							goto l470
-						l471:
-							position, tokenIndex, depth = position471, tokenIndex471, depth471
+							goto l503
+						l504:
+							position, tokenIndex, depth = position504, tokenIndex504, depth504
 						}
-						goto l465
-					l464:
-						position, tokenIndex, depth = position464, tokenIndex464, depth464
+						goto l498
+					l497:
+						position, tokenIndex, depth = position497, tokenIndex497, depth497
 					}
-				l465:
+				l498:
 					if buffer[position] != rune(')') {
-						goto l462
+						goto l495
 					}
 					position++
-					goto l463
-				l462:
-					position, tokenIndex, depth = position462, tokenIndex462, depth462
+					goto l496
+				l495:
+					position, tokenIndex, depth = position495, tokenIndex495, depth495
 				}
-			l463:
+			l496:
 				depth--
-				add(rulemappings, position461)
+				add(rulemappings, position494)
 			}
 			return true
 		},
-		/* 48 mappingstart <- <('(' Action28)> */
+		/* 52 mappingstart <- <('(' Action31)> */
 		func() bool {
-			position476, tokenIndex476, depth476 := position, tokenIndex, depth
+			position509, tokenIndex509, depth509 := position, tokenIndex, depth
 			{
-				position477 := position
+				position510 := position
 				depth++
 				if buffer[position] != rune('(') {
-					goto l476
+					goto l509
 				}
 				position++
-				if !_rules[ruleAction28]() {
-					goto l476
+				if !_rules[ruleAction31]() {
+					goto l509
 				}
 				depth--
-				add(rulemappingstart, position477)
+				add(rulemappingstart, position510)
 			}
 			return true
-		l476:
-			position, tokenIndex, depth = position476, tokenIndex476, depth476
+		l509:
+			position, tokenIndex, depth = position509, tokenIndex509, depth509
 			return false
 		},
-		/* 49 mapping <- <((mappingname isp* '=' isp*)? bound Action29)> */
+		/* 53 mapping <- <((mappingname isp* '=' isp*)? bound Action32)> */
 		func() bool {
-			position478, tokenIndex478, depth478 := position, tokenIndex, depth
+			position511, tokenIndex511, depth511 := position, tokenIndex, depth
 			{
-				position479 := position
+				position512 := position
 				depth++
 				{
-					position480, tokenIndex480, depth480 := position, tokenIndex, depth
+					position513, tokenIndex513, depth513 := position, tokenIndex, depth
 					if !_rules[rulemappingname]() {
-						goto l480
+						goto l513
 					}
-				l482:
+				l515:
 					{
-						position483, tokenIndex483, depth483 := position, tokenIndex, depth
+						position516, tokenIndex516, depth516 := position, tokenIndex, depth
 						if !_rules[ruleisp]() {
-							goto l483
+							goto l516
 						}
-						goto l482
-					l483:
-						position, tokenIndex, depth = position483, tokenIndex483, depth483
+						goto l515
+					l516:
+						position, tokenIndex, depth = position516, tokenIndex516, depth516
 					}
 					if buffer[position] != rune('=') {
-						goto l480
+						goto l513
 					}
 					position++
-				l484:
+				l517:
 					{
-						position485, tokenIndex485, depth485 := position, tokenIndex, depth
+						position518, tokenIndex518, depth518 := position, tokenIndex, depth
 						if !_rules[ruleisp]() {
-							goto l485
+							goto l518
 						}
-						goto l484
-					l485:
-						position, tokenIndex, depth = position485, tokenIndex485, depth485
+						goto l517
+					l518:
+						position, tokenIndex, depth = position518, tokenIndex518, depth518
 					}
-					goto l481
-				l480:
-					position, tokenIndex, depth = position480, tokenIndex480, depth480
+					goto l514
+				l513:
+					position, tokenIndex, depth = position513, tokenIndex513, depth513
 				}
-			l481:
+			l514:
 				if !_rules[rulebound]() {
-					goto l478
+					goto l511
 				}
-				if !_rules[ruleAction29]() {
-					goto l478
+				if !_rules[ruleAction32]() {
+					goto l511
 				}
 				depth--
-				add(rulemapping, position479)
+				add(rulemapping, position512)
 			}
 			return true
-		l478:
-			position, tokenIndex, depth = position478, tokenIndex478, depth478
+		l511:
+			position, tokenIndex, depth = position511, tokenIndex511, depth511
 			return false
 		},
-		/* 50 mappingname <- <(<identifier> Action30)> */
+		/* 54 mappingname <- <(<identifier> Action33)> */
 		func() bool {
-			position486, tokenIndex486, depth486 := position, tokenIndex, depth
+			position519, tokenIndex519, depth519 := position, tokenIndex, depth
 			{
-				position487 := position
+				position520 := position
 				depth++
 				{
-					position488 := position
+					position521 := position
 					depth++
 					if !_rules[ruleidentifier]() {
-						goto l486
+						goto l519
 					}
 					depth--
-					add(rulePegText, position488)
+					add(rulePegText, position521)
 				}
-				if !_rules[ruleAction30]() {
-					goto l486
+				if !_rules[ruleAction33]() {
+					goto l519
 				}
 				depth--
-				add(rulemappingname, position487)
+				add(rulemappingname, position520)
 			}
 			return true
-		l486:
-			position, tokenIndex, depth = position486, tokenIndex486, depth486
+		l519:
+			position, tokenIndex, depth = position519, tokenIndex519, depth519
 			return false
 		},
-		/* 51 tags <- <('{' isp* tag isp* (',' isp* tag isp*)* '}')?> */
+		/* 55 tags <- <('{' isp* tag isp* (',' isp* tag isp*)* '}')?> */
 		func() bool {
 			{
-				position490 := position
+				position523 := position
 				depth++
 				{
-					position491, tokenIndex491, depth491 := position, tokenIndex, depth
+					position524, tokenIndex524, depth524 := position, tokenIndex, depth
 					if buffer[position] != rune('{') {
-						goto l491
+						goto l524
 					}
 					position++
-				l493:
+				l526:
 					{
-						position494, tokenIndex494, depth494 := position, tokenIndex, depth
+						position527, tokenIndex527, depth527 := position, tokenIndex, depth
 						if !_rules[ruleisp]() {
-							goto l494
+							goto l527
 						}
-						goto l493
-					l494:
-						position, tokenIndex, depth = position494, tokenIndex494, depth494
+						goto l526
+					l527:
+						position, tokenIndex, depth = position527, tokenIndex527, depth527
 					}
 					if !_rules[ruletag]() {
-						goto l491
+						goto l524
 					}
-				l495:
+				l528:
 					{
-						position496, tokenIndex496, depth496 := position, tokenIndex, depth
+						position529, tokenIndex529, depth529 := position, tokenIndex, depth
 						if !_rules[ruleisp]() {
-							goto l496
+							goto l529
 						}
-						goto l495
-					l496:
-						position, tokenIndex, depth = position496, tokenIndex496, depth496
+						goto l528
+					l529:
+						position, tokenIndex, depth = position529, tokenIndex529, depth529
 					}
-				l497:
+				l530:
 					{
-						position498, tokenIndex498, depth498 := position, tokenIndex, depth
+						position531, tokenIndex531, depth531 := position, tokenIndex, depth
 						if buffer[position] != rune(',') {
-							goto l498
+							goto l531
 						}
 						position++
-					l499:
+					l532:
 						{
-							position500, tokenIndex500, depth500 := position, tokenIndex, depth
+							position533, tokenIndex533, depth533 := position, tokenIndex, depth
 							if !_rules[ruleisp]() {
-								goto l500
+								goto l533
 							}
-							goto l499
-						l500:
-							position, tokenIndex, depth = position500, tokenIndex500, depth500
+							goto l532
+						l533:
+							position, tokenIndex, depth = position533, tokenIndex533, depth533
 						}
 						if !_rules[ruletag]() {
-							goto l498
+							goto l531
 						}
-					l501:
+					l534:
 						{
-							position502, tokenIndex502, depth502 := position, tokenIndex, depth
+							position535, tokenIndex535, depth535 := position, tokenIndex, depth
 							if !_rules[ruleisp]() {
-								goto l502
+								goto l535
 							}
-							goto l501
-						l502:
-							position, tokenIndex, depth = position502, tokenIndex502, depth502
+							goto l534
+						l535:
+							position, tokenIndex, depth = position535, tokenIndex535, depth535
 						}
-						goto l497
-					l498:
-						position, tokenIndex, depth = position498, tokenIndex498, depth498
+						goto l530
+					l531:
+						position, tokenIndex, depth = position531, tokenIndex531, depth531
 					}
 					if buffer[position] != rune('}') {
-						goto l491
+						goto l524
 					}
 					position++
-					goto l492
-				l491:
-					position, tokenIndex, depth = position491, tokenIndex491, depth491
+					goto l525
+				l524:
+					position, tokenIndex, depth = position524, tokenIndex524, depth524
 				}
-			l492:
+			l525:
 				depth--
-				add(ruletags, position490)
+				add(ruletags, position523)
 			}
 			return true
 		},
-		/* 52 tag <- <(tagname ('(' (isp* tagarg isp* (',' isp* tagarg isp*)*)? ')')? Action31)> */
+		/* 56 tag <- <(tagname ('(' (isp* tagarg isp* (',' isp* tagarg isp*)*)? ')')? Action34)> */
 		func() bool {
-			position503, tokenIndex503, depth503 := position, tokenIndex, depth
+			position536, tokenIndex536, depth536 := position, tokenIndex, depth
 			{
-				position504 := position
+				position537 := position
 				depth++
 				if !_rules[ruletagname]() {
-					goto l503
+					goto l536
 				}
 				{
-					position505, tokenIndex505, depth505 := position, tokenIndex, depth
+					position538, tokenIndex538, depth538 := position, tokenIndex, depth
 					if buffer[position] != rune('(') {
-						goto l505
+						goto l538
 					}
 					position++
 					{
-						position507, tokenIndex507, depth507 := position, tokenIndex, depth
-					l509:
+						position540, tokenIndex540, depth540 := position, tokenIndex, depth
+					l542:
 						{
-							position510, tokenIndex510, depth510 := position, tokenIndex, depth
+							position543, tokenIndex543, depth543 := position, tokenIndex, depth
 							if !_rules[ruleisp]() {
-								goto l510
+								goto l543
 							}
-							goto l509
-						l510:
-							position, tokenIndex, depth = position510, tokenIndex510, depth510
+							goto l542
+						l543:
+							position, tokenIndex, depth = position543, tokenIndex543, depth543
 						}
 						if !_rules[ruletagarg]() {
-							goto l507
+							goto l540
 						}
-					l511:
+					l544:
 						{
-							position512, tokenIndex512, depth512 := position, tokenIndex, depth
+							position545, tokenIndex545, depth545 := position, tokenIndex, depth
 							if !_rules[ruleisp]() {
-								goto l512
+								goto l545
 							}
-							goto l511
-						l512:
-							position, tokenIndex, depth = position512, tokenIndex512, depth512
+							goto l544
+						l545:
+							position, tokenIndex, depth = position545, tokenIndex545, depth545
 						}
-					l513:
+					l546:
 						{
-							position514, tokenIndex514, depth514 := position, tokenIndex, depth
+							position547, tokenIndex547, depth547 := position, tokenIndex, depth
 							if buffer[position] != rune(',') {
-								goto l514
+								goto l547
 							}
 							position++
-						l515:
+						l548:
 							{
-								position516, tokenIndex516, depth516 := position, tokenIndex, depth
+								position549, tokenIndex549, depth549 := position, tokenIndex, depth
 								if !_rules[ruleisp]() {
-									goto l516
+									goto l549
 								}
-								goto l515
-							l516:
-								position, tokenIndex, depth = position516, tokenIndex516, depth516
+								goto l548
+							l549:
+								position, tokenIndex, depth = position549, tokenIndex549, depth549
 							}
 							if !_rules[ruletagarg]() {
-								goto l514
+								goto l547
 							}
-						l517:
+						l550:
 							{
-								position518, tokenIndex518, depth518 := position, tokenIndex, depth
+								position551, tokenIndex551, depth551 := position, tokenIndex, depth
 								if !_rules[ruleisp]() {
-									goto l518
+									goto l551
 								}
-								goto l517
-							l518:
-								position, tokenIndex, depth = position518, tokenIndex518, depth518
+								goto l550
+							l551:
+								position, tokenIndex, depth = position551, tokenIndex551, depth551
 							}
-							goto l513
-						l514:
-							position, tokenIndex, depth = position514, tokenIndex514, depth514
+							goto l546
+						l547:
+							position, tokenIndex, depth = position547, tokenIndex547, depth547
 						}
-						goto l508
-					l507:
-						position, tokenIndex, depth = position507, tokenIndex507, depth507
+						goto l541
+					l540:
+						position, tokenIndex, depth = position540, tokenIndex540, depth540
 					}
-				l508:
+				l541:
 					if buffer[position] != rune(')') {
-						goto l505
+						goto l538
 					}
 					position++
-					goto l506
-				l505:
-					position, tokenIndex, depth = position505, tokenIndex505, depth505
+					goto l539
+				l538:
+					position, tokenIndex, depth = position538, tokenIndex538, depth538
 				}
-			l506:
-				if !_rules[ruleAction31]() {
-					goto l503
+			l539:
+				if !_rules[ruleAction34]() {
+					goto l536
 				}
 				depth--
-				add(ruletag, position504)
+				add(ruletag, position537)
 			}
 			return true
-		l503:
-			position, tokenIndex, depth = position503, tokenIndex503, depth503
+		l536:
+			position, tokenIndex, depth = position536, tokenIndex536, depth536
 			return false
 		},
-		/* 53 tagname <- <(<identifier> Action32)> */
+		/* 57 tagname <- <(<identifier> Action35)> */
 		func() bool {
-			position519, tokenIndex519, depth519 := position, tokenIndex, depth
+			position552, tokenIndex552, depth552 := position, tokenIndex, depth
 			{
-				position520 := position
+				position553 := position
 				depth++
 				{
-					position521 := position
+					position554 := position
 					depth++
 					if !_rules[ruleidentifier]() {
-						goto l519
+						goto l552
 					}
 					depth--
-					add(rulePegText, position521)
+					add(rulePegText, position554)
 				}
-				if !_rules[ruleAction32]() {
-					goto l519
+				if !_rules[ruleAction35]() {
+					goto l552
 				}
 				depth--
-				add(ruletagname, position520)
+				add(ruletagname, position553)
 			}
 			return true
-		l519:
-			position, tokenIndex, depth = position519, tokenIndex519, depth519
+		l552:
+			position, tokenIndex, depth = position552, tokenIndex552, depth552
 			return false
 		},
-		/* 54 tagarg <- <(<identifier> Action33)> */
+		/* 58 tagarg <- <(((&('"') ('"' <(!'"' .)+> '"')) | (&('\'') ('\'' <(!'\'' .)+> '\'')) | (&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9' | 'A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z' | '_' | 'a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') <((&('0' | '1' | '2' | '3' | '4' | '5' | '6' | '7' | '8' | '9') ([0-9] / [0-9])) | (&('_') '_') | (&('A' | 'B' | 'C' | 'D' | 'E' | 'F' | 'G' | 'H' | 'I' | 'J' | 'K' | 'L' | 'M' | 'N' | 'O' | 'P' | 'Q' | 'R' | 'S' | 'T' | 'U' | 'V' | 'W' | 'X' | 'Y' | 'Z') [A-Z]) | (&('a' | 'b' | 'c' | 'd' | 'e' | 'f' | 'g' | 'h' | 'i' | 'j' | 'k' | 'l' | 'm' | 'n' | 'o' | 'p' | 'q' | 'r' | 's' | 't' | 'u' | 'v' | 'w' | 'x' | 'y' | 'z') [a-z]))+>)) Action36)> */
 		func() bool {
-			position522, tokenIndex522, depth522 := position, tokenIndex, depth
+			position555, tokenIndex555, depth555 := position, tokenIndex, depth
 			{
-				position523 := position
+				position556 := position
 				depth++
 				{
-					position524 := position
-					depth++
-					if !_rules[ruleidentifier]() {
-						goto l522
+					switch buffer[position] {
+					case '"':
+						if buffer[position] != rune('"') {
+							goto l555
+						}
+						position++
+						{
+							position558 := position
+							depth++
+							{
+								position561, tokenIndex561, depth561 := position, tokenIndex, depth
+								if buffer[position] != rune('"') {
+									goto l561
+								}
+								position++
+								goto l555
+							l561:
+								position, tokenIndex, depth = position561, tokenIndex561, depth561
+							}
+							if !matchDot() {
+								goto l555
+							}
+						l559:
+							{
+								position560, tokenIndex560, depth560 := position, tokenIndex, depth
+								{
+									position562, tokenIndex562, depth562 := position, tokenIndex, depth
+									if buffer[position] != rune('"') {
+										goto l562
+									}
+									position++
+									goto l560
+								l562:
+									position, tokenIndex, depth = position562, tokenIndex562, depth562
+								}
+								if !matchDot() {
+									goto l560
+								}
+								goto l559
+							l560:
+								position, tokenIndex, depth = position560, tokenIndex560, depth560
+							}
+							depth--
+							add(rulePegText, position558)
+						}
+						if buffer[position] != rune('"') {
+							goto l555
+						}
+						position++
+						break
+					case '\'':
+						if buffer[position] != rune('\'') {
+							goto l555
+						}
+						position++
+						{
+							position563 := position
+							depth++
+							{
+								position566, tokenIndex566, depth566 := position, tokenIndex, depth
+								if buffer[position] != rune('\'') {
+									goto l566
+								}
+								position++
+								goto l555
+							l566:
+								position, tokenIndex, depth = position566, tokenIndex566, depth566
+							}
+							if !matchDot() {
+								goto l555
+							}
+						l564:
+							{
+								position565, tokenIndex565, depth565 := position, tokenIndex, depth
+								{
+									position567, tokenIndex567, depth567 := position, tokenIndex, depth
+									if buffer[position] != rune('\'') {
+										goto l567
+									}
+									position++
+									goto l565
+								l567:
+									position, tokenIndex, depth = position567, tokenIndex567, depth567
+								}
+								if !matchDot() {
+									goto l565
+								}
+								goto l564
+							l565:
+								position, tokenIndex, depth = position565, tokenIndex565, depth565
+							}
+							depth--
+							add(rulePegText, position563)
+						}
+						if buffer[position] != rune('\'') {
+							goto l555
+						}
+						position++
+						break
+					default:
+						{
+							position568 := position
+							depth++
+							{
+								switch buffer[position] {
+								case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+									{
+										position572, tokenIndex572, depth572 := position, tokenIndex, depth
+										if c := buffer[position]; c < rune('0') || c > rune('9') {
+											goto l573
+										}
+										position++
+										goto l572
+									l573:
+										position, tokenIndex, depth = position572, tokenIndex572, depth572
+										if c := buffer[position]; c < rune('0') || c > rune('9') {
+											goto l555
+										}
+										position++
+									}
+								l572:
+									break
+								case '_':
+									if buffer[position] != rune('_') {
+										goto l555
+									}
+									position++
+									break
+								case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+									if c := buffer[position]; c < rune('A') || c > rune('Z') {
+										goto l555
+									}
+									position++
+									break
+								default:
+									if c := buffer[position]; c < rune('a') || c > rune('z') {
+										goto l555
+									}
+									position++
+									break
+								}
+							}
+
+						l569:
+							{
+								position570, tokenIndex570, depth570 := position, tokenIndex, depth
+								{
+									switch buffer[position] {
+									case '0', '1', '2', '3', '4', '5', '6', '7', '8', '9':
+										{
+											position575, tokenIndex575, depth575 := position, tokenIndex, depth
+											if c := buffer[position]; c < rune('0') || c > rune('9') {
+												goto l576
+											}
+											position++
+											goto l575
+										l576:
+											position, tokenIndex, depth = position575, tokenIndex575, depth575
+											if c := buffer[position]; c < rune('0') || c > rune('9') {
+												goto l570
+											}
+											position++
+										}
+									l575:
+										break
+									case '_':
+										if buffer[position] != rune('_') {
+											goto l570
+										}
+										position++
+										break
+									case 'A', 'B', 'C', 'D', 'E', 'F', 'G', 'H', 'I', 'J', 'K', 'L', 'M', 'N', 'O', 'P', 'Q', 'R', 'S', 'T', 'U', 'V', 'W', 'X', 'Y', 'Z':
+										if c := buffer[position]; c < rune('A') || c > rune('Z') {
+											goto l570
+										}
+										position++
+										break
+									default:
+										if c := buffer[position]; c < rune('a') || c > rune('z') {
+											goto l570
+										}
+										position++
+										break
+									}
+								}
+
+								goto l569
+							l570:
+								position, tokenIndex, depth = position570, tokenIndex570, depth570
+							}
+							depth--
+							add(rulePegText, position568)
+						}
+						break
 					}
-					depth--
-					add(rulePegText, position524)
 				}
-				if !_rules[ruleAction33]() {
-					goto l522
+
+				if !_rules[ruleAction36]() {
+					goto l555
 				}
 				depth--
-				add(ruletagarg, position523)
+				add(ruletagarg, position556)
 			}
 			return true
-		l522:
-			position, tokenIndex, depth = position522, tokenIndex522, depth522
+		l555:
+			position, tokenIndex, depth = position555, tokenIndex555, depth555
 			return false
 		},
-		/* 55 for <- <(isp* forVar isp* (',' isp* forVar isp*)? (':' '=') isp* (('r' / 'R') ('a' / 'A') ('n' / 'N') ('g' / 'G') ('e' / 'E')) isp+ expr isp* !.)> */
+		/* 59 for <- <(isp* forVar isp* (',' isp* forVar isp*)? (':' '=') isp* (('r' / 'R') ('a' / 'A') ('n' / 'N') ('g' / 'G') ('e' / 'E')) isp+ expr isp* !.)> */
 		func() bool {
-			position525, tokenIndex525, depth525 := position, tokenIndex, depth
+			position577, tokenIndex577, depth577 := position, tokenIndex, depth
 			{
-				position526 := position
+				position578 := position
 				depth++
-			l527:
+			l579:
 				{
-					position528, tokenIndex528, depth528 := position, tokenIndex, depth
+					position580, tokenIndex580, depth580 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l528
+						goto l580
 					}
-					goto l527
-				l528:
-					position, tokenIndex, depth = position528, tokenIndex528, depth528
+					goto l579
+				l580:
+					position, tokenIndex, depth = position580, tokenIndex580, depth580
 				}
 				if !_rules[ruleforVar]() {
-					goto l525
+					goto l577
 				}
-			l529:
+			l581:
 				{
-					position530, tokenIndex530, depth530 := position, tokenIndex, depth
+					position582, tokenIndex582, depth582 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l530
+						goto l582
 					}
-					goto l529
-				l530:
-					position, tokenIndex, depth = position530, tokenIndex530, depth530
+					goto l581
+				l582:
+					position, tokenIndex, depth = position582, tokenIndex582, depth582
 				}
 				{
-					position531, tokenIndex531, depth531 := position, tokenIndex, depth
+					position583, tokenIndex583, depth583 := position, tokenIndex, depth
 					if buffer[position] != rune(',') {
-						goto l531
+						goto l583
 					}
 					position++
-				l533:
+				l585:
 					{
-						position534, tokenIndex534, depth534 := position, tokenIndex, depth
+						position586, tokenIndex586, depth586 := position, tokenIndex, depth
 						if !_rules[ruleisp]() {
-							goto l534
+							goto l586
 						}
-						goto l533
-					l534:
-						position, tokenIndex, depth = position534, tokenIndex534, depth534
+						goto l585
+					l586:
+						position, tokenIndex, depth = position586, tokenIndex586, depth586
 					}
 					if !_rules[ruleforVar]() {
-						goto l531
+						goto l583
 					}
-				l535:
+				l587:
 					{
-						position536, tokenIndex536, depth536 := position, tokenIndex, depth
+						position588, tokenIndex588, depth588 := position, tokenIndex, depth
 						if !_rules[ruleisp]() {
-							goto l536
+							goto l588
 						}
-						goto l535
-					l536:
-						position, tokenIndex, depth = position536, tokenIndex536, depth536
+						goto l587
+					l588:
+						position, tokenIndex, depth = position588, tokenIndex588, depth588
 					}
-					goto l532
-				l531:
-					position, tokenIndex, depth = position531, tokenIndex531, depth531
+					goto l584
+				l583:
+					position, tokenIndex, depth = position583, tokenIndex583, depth583
 				}
-			l532:
+			l584:
 				if buffer[position] != rune(':') {
-					goto l525
+					goto l577
 				}
 				position++
 				if buffer[position] != rune('=') {
-					goto l525
+					goto l577
 				}
 				position++
-			l537:
+			l589:
 				{
-					position538, tokenIndex538, depth538 := position, tokenIndex, depth
+					position590, tokenIndex590, depth590 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l538
+						goto l590
 					}
-					goto l537
-				l538:
-					position, tokenIndex, depth = position538, tokenIndex538, depth538
+					goto l589
+				l590:
+					position, tokenIndex, depth = position590, tokenIndex590, depth590
 				}
 				{
-					position539, tokenIndex539, depth539 := position, tokenIndex, depth
+					position591, tokenIndex591, depth591 := position, tokenIndex, depth
 					if buffer[position] != rune('r') {
-						goto l540
+						goto l592
 					}
 					position++
-					goto l539
-				l540:
-					position, tokenIndex, depth = position539, tokenIndex539, depth539
+					goto l591
+				l592:
+					position, tokenIndex, depth = position591, tokenIndex591, depth591
 					if buffer[position] != rune('R') {
-						goto l525
+						goto l577
 					}
 					position++
 				}
-			l539:
+			l591:
 				{
-					position541, tokenIndex541, depth541 := position, tokenIndex, depth
+					position593, tokenIndex593, depth593 := position, tokenIndex, depth
 					if buffer[position] != rune('a') {
-						goto l542
+						goto l594
 					}
 					position++
-					goto l541
-				l542:
-					position, tokenIndex, depth = position541, tokenIndex541, depth541
+					goto l593
+				l594:
+					position, tokenIndex, depth = position593, tokenIndex593, depth593
 					if buffer[position] != rune('A') {
-						goto l525
+						goto l577
 					}
 					position++
 				}
-			l541:
+			l593:
 				{
-					position543, tokenIndex543, depth543 := position, tokenIndex, depth
+					position595, tokenIndex595, depth595 := position, tokenIndex, depth
 					if buffer[position] != rune('n') {
-						goto l544
+						goto l596
 					}
 					position++
-					goto l543
-				l544:
-					position, tokenIndex, depth = position543, tokenIndex543, depth543
+					goto l595
+				l596:
+					position, tokenIndex, depth = position595, tokenIndex595, depth595
 					if buffer[position] != rune('N') {
-						goto l525
+						goto l577
 					}
 					position++
 				}
-			l543:
+			l595:
 				{
-					position545, tokenIndex545, depth545 := position, tokenIndex, depth
+					position597, tokenIndex597, depth597 := position, tokenIndex, depth
 					if buffer[position] != rune('g') {
-						goto l546
+						goto l598
 					}
 					position++
-					goto l545
-				l546:
-					position, tokenIndex, depth = position545, tokenIndex545, depth545
+					goto l597
+				l598:
+					position, tokenIndex, depth = position597, tokenIndex597, depth597
 					if buffer[position] != rune('G') {
-						goto l525
+						goto l577
 					}
 					position++
 				}
-			l545:
+			l597:
 				{
-					position547, tokenIndex547, depth547 := position, tokenIndex, depth
+					position599, tokenIndex599, depth599 := position, tokenIndex, depth
 					if buffer[position] != rune('e') {
-						goto l548
+						goto l600
 					}
 					position++
-					goto l547
-				l548:
-					position, tokenIndex, depth = position547, tokenIndex547, depth547
+					goto l599
+				l600:
+					position, tokenIndex, depth = position599, tokenIndex599, depth599
 					if buffer[position] != rune('E') {
-						goto l525
+						goto l577
 					}
 					position++
 				}
-			l547:
+			l599:
 				if !_rules[ruleisp]() {
-					goto l525
+					goto l577
 				}
-			l549:
+			l601:
 				{
-					position550, tokenIndex550, depth550 := position, tokenIndex, depth
+					position602, tokenIndex602, depth602 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l550
+						goto l602
 					}
-					goto l549
-				l550:
-					position, tokenIndex, depth = position550, tokenIndex550, depth550
+					goto l601
+				l602:
+					position, tokenIndex, depth = position602, tokenIndex602, depth602
 				}
 				if !_rules[ruleexpr]() {
-					goto l525
+					goto l577
 				}
-			l551:
+			l603:
 				{
-					position552, tokenIndex552, depth552 := position, tokenIndex, depth
+					position604, tokenIndex604, depth604 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l552
+						goto l604
 					}
-					goto l551
-				l552:
-					position, tokenIndex, depth = position552, tokenIndex552, depth552
+					goto l603
+				l604:
+					position, tokenIndex, depth = position604, tokenIndex604, depth604
 				}
 				{
-					position553, tokenIndex553, depth553 := position, tokenIndex, depth
+					position605, tokenIndex605, depth605 := position, tokenIndex, depth
 					if !matchDot() {
-						goto l553
+						goto l605
 					}
-					goto l525
-				l553:
-					position, tokenIndex, depth = position553, tokenIndex553, depth553
+					goto l577
+				l605:
+					position, tokenIndex, depth = position605, tokenIndex605, depth605
 				}
 				depth--
-				add(rulefor, position526)
+				add(rulefor, position578)
 			}
 			return true
-		l525:
-			position, tokenIndex, depth = position525, tokenIndex525, depth525
+		l577:
+			position, tokenIndex, depth = position577, tokenIndex577, depth577
 			return false
 		},
-		/* 56 forVar <- <(<identifier> Action34)> */
+		/* 60 forVar <- <(<identifier> Action37)> */
 		func() bool {
-			position554, tokenIndex554, depth554 := position, tokenIndex, depth
+			position606, tokenIndex606, depth606 := position, tokenIndex, depth
 			{
-				position555 := position
+				position607 := position
 				depth++
 				{
-					position556 := position
+					position608 := position
 					depth++
 					if !_rules[ruleidentifier]() {
-						goto l554
+						goto l606
 					}
 					depth--
-					add(rulePegText, position556)
+					add(rulePegText, position608)
 				}
-				if !_rules[ruleAction34]() {
-					goto l554
+				if !_rules[ruleAction37]() {
+					goto l606
 				}
 				depth--
-				add(ruleforVar, position555)
+				add(ruleforVar, position607)
 			}
 			return true
-		l554:
-			position, tokenIndex, depth = position554, tokenIndex554, depth554
+		l606:
+			position, tokenIndex, depth = position606, tokenIndex606, depth606
 			return false
 		},
-		/* 57 handlers <- <(isp* (fsep isp*)* handler isp* ((fsep isp*)+ handler isp*)* (fsep isp*)* !.)> */
+		/* 61 handlers <- <(isp* (fsep isp*)* handler isp* ((fsep isp*)+ handler isp*)* (fsep isp*)* !.)> */
 		func() bool {
-			position557, tokenIndex557, depth557 := position, tokenIndex, depth
+			position609, tokenIndex609, depth609 := position, tokenIndex, depth
 			{
-				position558 := position
+				position610 := position
 				depth++
-			l559:
+			l611:
 				{
-					position560, tokenIndex560, depth560 := position, tokenIndex, depth
+					position612, tokenIndex612, depth612 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l560
+						goto l612
 					}
-					goto l559
-				l560:
-					position, tokenIndex, depth = position560, tokenIndex560, depth560
+					goto l611
+				l612:
+					position, tokenIndex, depth = position612, tokenIndex612, depth612
 				}
-			l561:
+			l613:
 				{
-					position562, tokenIndex562, depth562 := position, tokenIndex, depth
+					position614, tokenIndex614, depth614 := position, tokenIndex, depth
 					if !_rules[rulefsep]() {
-						goto l562
+						goto l614
 					}
-				l563:
+				l615:
 					{
-						position564, tokenIndex564, depth564 := position, tokenIndex, depth
+						position616, tokenIndex616, depth616 := position, tokenIndex, depth
 						if !_rules[ruleisp]() {
-							goto l564
+							goto l616
 						}
-						goto l563
-					l564:
-						position, tokenIndex, depth = position564, tokenIndex564, depth564
+						goto l615
+					l616:
+						position, tokenIndex, depth = position616, tokenIndex616, depth616
 					}
-					goto l561
-				l562:
-					position, tokenIndex, depth = position562, tokenIndex562, depth562
+					goto l613
+				l614:
+					position, tokenIndex, depth = position614, tokenIndex614, depth614
 				}
 				if !_rules[rulehandler]() {
-					goto l557
+					goto l609
 				}
-			l565:
+			l617:
 				{
-					position566, tokenIndex566, depth566 := position, tokenIndex, depth
+					position618, tokenIndex618, depth618 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l566
+						goto l618
 					}
-					goto l565
-				l566:
-					position, tokenIndex, depth = position566, tokenIndex566, depth566
+					goto l617
+				l618:
+					position, tokenIndex, depth = position618, tokenIndex618, depth618
 				}
-			l567:
+			l619:
 				{
-					position568, tokenIndex568, depth568 := position, tokenIndex, depth
+					position620, tokenIndex620, depth620 := position, tokenIndex, depth
 					if !_rules[rulefsep]() {
-						goto l568
+						goto l620
 					}
-				l571:
+				l623:
 					{
-						position572, tokenIndex572, depth572 := position, tokenIndex, depth
+						position624, tokenIndex624, depth624 := position, tokenIndex, depth
 						if !_rules[ruleisp]() {
-							goto l572
+							goto l624
 						}
-						goto l571
-					l572:
-						position, tokenIndex, depth = position572, tokenIndex572, depth572
+						goto l623
+					l624:
+						position, tokenIndex, depth = position624, tokenIndex624, depth624
 					}
-				l569:
+				l621:
 					{
-						position570, tokenIndex570, depth570 := position, tokenIndex, depth
+						position622, tokenIndex622, depth622 := position, tokenIndex, depth
 						if !_rules[rulefsep]() {
-							goto l570
+							goto l622
 						}
-					l573:
+					l625:
 						{
-							position574, tokenIndex574, depth574 := position, tokenIndex, depth
+							position626, tokenIndex626, depth626 := position, tokenIndex, depth
 							if !_rules[ruleisp]() {
-								goto l574
+								goto l626
 							}
-							goto l573
-						l574:
-							position, tokenIndex, depth = position574, tokenIndex574, depth574
+							goto l625
+						l626:
+							position, tokenIndex, depth = position626, tokenIndex626, depth626
 						}
-						goto l569
-					l570:
-						position, tokenIndex, depth = position570, tokenIndex570, depth570
+						goto l621
+					l622:
+						position, tokenIndex, depth = position622, tokenIndex622, depth622
 					}
 					if !_rules[rulehandler]() {
-						goto l568
+						goto l620
 					}
-				l575:
+				l627:
 					{
-						position576, tokenIndex576, depth576 := position, tokenIndex, depth
+						position628, tokenIndex628, depth628 := position, tokenIndex, depth
 						if !_rules[ruleisp]() {
-							goto l576
+							goto l628
 						}
-						goto l575
-					l576:
-						position, tokenIndex, depth = position576, tokenIndex576, depth576
+						goto l627
+					l628:
+						position, tokenIndex, depth = position628, tokenIndex628, depth628
 					}
-					goto l567
-				l568:
-					position, tokenIndex, depth = position568, tokenIndex568, depth568
+					goto l619
+				l620:
+					position, tokenIndex, depth = position620, tokenIndex620, depth620
 				}
-			l577:
+			l629:
 				{
-					position578, tokenIndex578, depth578 := position, tokenIndex, depth
+					position630, tokenIndex630, depth630 := position, tokenIndex, depth
 					if !_rules[rulefsep]() {
-						goto l578
+						goto l630
 					}
-				l579:
+				l631:
 					{
-						position580, tokenIndex580, depth580 := position, tokenIndex, depth
+						position632, tokenIndex632, depth632 := position, tokenIndex, depth
 						if !_rules[ruleisp]() {
-							goto l580
+							goto l632
 						}
-						goto l579
-					l580:
-						position, tokenIndex, depth = position580, tokenIndex580, depth580
+						goto l631
+					l632:
+						position, tokenIndex, depth = position632, tokenIndex632, depth632
 					}
-					goto l577
-				l578:
-					position, tokenIndex, depth = position578, tokenIndex578, depth578
+					goto l629
+				l630:
+					position, tokenIndex, depth = position630, tokenIndex630, depth630
 				}
 				{
-					position581, tokenIndex581, depth581 := position, tokenIndex, depth
+					position633, tokenIndex633, depth633 := position, tokenIndex, depth
 					if !matchDot() {
-						goto l581
+						goto l633
 					}
-					goto l557
-				l581:
-					position, tokenIndex, depth = position581, tokenIndex581, depth581
+					goto l609
+				l633:
+					position, tokenIndex, depth = position633, tokenIndex633, depth633
 				}
 				depth--
-				add(rulehandlers, position558)
+				add(rulehandlers, position610)
 			}
 			return true
-		l557:
-			position, tokenIndex, depth = position557, tokenIndex557, depth557
+		l609:
+			position, tokenIndex, depth = position609, tokenIndex609, depth609
 			return false
 		},
-		/* 58 handler <- <(handlername '(' isp* (param isp* (',' isp* param isp*)*)? ')' (isp* type)? Action35)> */
+		/* 62 handler <- <(handlername '(' isp* (param isp* (',' isp* param isp*)*)? ')' (isp* type)? Action38)> */
 		func() bool {
-			position582, tokenIndex582, depth582 := position, tokenIndex, depth
+			position634, tokenIndex634, depth634 := position, tokenIndex, depth
 			{
-				position583 := position
+				position635 := position
 				depth++
 				if !_rules[rulehandlername]() {
-					goto l582
+					goto l634
 				}
 				if buffer[position] != rune('(') {
-					goto l582
+					goto l634
 				}
 				position++
-			l584:
+			l636:
 				{
-					position585, tokenIndex585, depth585 := position, tokenIndex, depth
+					position637, tokenIndex637, depth637 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l585
+						goto l637
 					}
-					goto l584
-				l585:
-					position, tokenIndex, depth = position585, tokenIndex585, depth585
+					goto l636
+				l637:
+					position, tokenIndex, depth = position637, tokenIndex637, depth637
 				}
 				{
-					position586, tokenIndex586, depth586 := position, tokenIndex, depth
+					position638, tokenIndex638, depth638 := position, tokenIndex, depth
 					if !_rules[ruleparam]() {
-						goto l586
+						goto l638
 					}
-				l588:
+				l640:
 					{
-						position589, tokenIndex589, depth589 := position, tokenIndex, depth
+						position641, tokenIndex641, depth641 := position, tokenIndex, depth
 						if !_rules[ruleisp]() {
-							goto l589
+							goto l641
 						}
-						goto l588
-					l589:
-						position, tokenIndex, depth = position589, tokenIndex589, depth589
+						goto l640
+					l641:
+						position, tokenIndex, depth = position641, tokenIndex641, depth641
 					}
-				l590:
+				l642:
 					{
-						position591, tokenIndex591, depth591 := position, tokenIndex, depth
+						position643, tokenIndex643, depth643 := position, tokenIndex, depth
 						if buffer[position] != rune(',') {
-							goto l591
+							goto l643
 						}
 						position++
-					l592:
+					l644:
 						{
-							position593, tokenIndex593, depth593 := position, tokenIndex, depth
+							position645, tokenIndex645, depth645 := position, tokenIndex, depth
 							if !_rules[ruleisp]() {
-								goto l593
+								goto l645
 							}
-							goto l592
-						l593:
-							position, tokenIndex, depth = position593, tokenIndex593, depth593
+							goto l644
+						l645:
+							position, tokenIndex, depth = position645, tokenIndex645, depth645
 						}
 						if !_rules[ruleparam]() {
-							goto l591
+							goto l643
 						}
-					l594:
+					l646:
 						{
-							position595, tokenIndex595, depth595 := position, tokenIndex, depth
+							position647, tokenIndex647, depth647 := position, tokenIndex, depth
 							if !_rules[ruleisp]() {
-								goto l595
+								goto l647
 							}
-							goto l594
-						l595:
-							position, tokenIndex, depth = position595, tokenIndex595, depth595
+							goto l646
+						l647:
+							position, tokenIndex, depth = position647, tokenIndex647, depth647
 						}
-						goto l590
-					l591:
-						position, tokenIndex, depth = position591, tokenIndex591, depth591
+						goto l642
+					l643:
+						position, tokenIndex, depth = position643, tokenIndex643, depth643
 					}
-					goto l587
-				l586:
-					position, tokenIndex, depth = position586, tokenIndex586, depth586
+					goto l639
+				l638:
+					position, tokenIndex, depth = position638, tokenIndex638, depth638
 				}
-			l587:
+			l639:
 				if buffer[position] != rune(')') {
-					goto l582
+					goto l634
 				}
 				position++
 				{
-					position596, tokenIndex596, depth596 := position, tokenIndex, depth
-				l598:
+					position648, tokenIndex648, depth648 := position, tokenIndex, depth
+				l650:
 					{
-						position599, tokenIndex599, depth599 := position, tokenIndex, depth
+						position651, tokenIndex651, depth651 := position, tokenIndex, depth
 						if !_rules[ruleisp]() {
-							goto l599
+							goto l651
 						}
-						goto l598
-					l599:
-						position, tokenIndex, depth = position599, tokenIndex599, depth599
+						goto l650
+					l651:
+						position, tokenIndex, depth = position651, tokenIndex651, depth651
 					}
 					if !_rules[ruletype]() {
-						goto l596
+						goto l648
 					}
-					goto l597
-				l596:
-					position, tokenIndex, depth = position596, tokenIndex596, depth596
+					goto l649
+				l648:
+					position, tokenIndex, depth = position648, tokenIndex648, depth648
 				}
-			l597:
-				if !_rules[ruleAction35]() {
-					goto l582
+			l649:
+				if !_rules[ruleAction38]() {
+					goto l634
 				}
 				depth--
-				add(rulehandler, position583)
+				add(rulehandler, position635)
 			}
 			return true
-		l582:
-			position, tokenIndex, depth = position582, tokenIndex582, depth582
+		l634:
+			position, tokenIndex, depth = position634, tokenIndex634, depth634
 			return false
 		},
-		/* 59 paramname <- <(<identifier> Action36)> */
+		/* 63 paramname <- <(<identifier> Action39)> */
 		func() bool {
-			position600, tokenIndex600, depth600 := position, tokenIndex, depth
+			position652, tokenIndex652, depth652 := position, tokenIndex, depth
 			{
-				position601 := position
+				position653 := position
 				depth++
 				{
-					position602 := position
+					position654 := position
 					depth++
 					if !_rules[ruleidentifier]() {
-						goto l600
+						goto l652
 					}
 					depth--
-					add(rulePegText, position602)
+					add(rulePegText, position654)
 				}
-				if !_rules[ruleAction36]() {
-					goto l600
+				if !_rules[ruleAction39]() {
+					goto l652
 				}
 				depth--
-				add(ruleparamname, position601)
+				add(ruleparamname, position653)
 			}
 			return true
-		l600:
-			position, tokenIndex, depth = position600, tokenIndex600, depth600
+		l652:
+			position, tokenIndex, depth = position652, tokenIndex652, depth652
 			return false
 		},
-		/* 60 param <- <(paramname isp+ type Action37)> */
+		/* 64 param <- <(paramname isp+ type Action40)> */
 		func() bool {
-			position603, tokenIndex603, depth603 := position, tokenIndex, depth
+			position655, tokenIndex655, depth655 := position, tokenIndex, depth
 			{
-				position604 := position
+				position656 := position
 				depth++
 				if !_rules[ruleparamname]() {
-					goto l603
+					goto l655
 				}
 				if !_rules[ruleisp]() {
-					goto l603
+					goto l655
 				}
-			l605:
+			l657:
 				{
-					position606, tokenIndex606, depth606 := position, tokenIndex, depth
+					position658, tokenIndex658, depth658 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l606
+						goto l658
 					}
-					goto l605
-				l606:
-					position, tokenIndex, depth = position606, tokenIndex606, depth606
+					goto l657
+				l658:
+					position, tokenIndex, depth = position658, tokenIndex658, depth658
 				}
 				if !_rules[ruletype]() {
-					goto l603
+					goto l655
 				}
-				if !_rules[ruleAction37]() {
-					goto l603
+				if !_rules[ruleAction40]() {
+					goto l655
 				}
 				depth--
-				add(ruleparam, position604)
+				add(ruleparam, position656)
 			}
 			return true
-		l603:
-			position, tokenIndex, depth = position603, tokenIndex603, depth603
+		l655:
+			position, tokenIndex, depth = position655, tokenIndex655, depth655
 			return false
 		},
-		/* 61 cparams <- <(isp* (cparam isp* (',' isp* cparam isp*)*)? !.)> */
+		/* 65 cparams <- <(isp* (cparam isp* (',' isp* cparam isp*)*)? !.)> */
 		func() bool {
-			position607, tokenIndex607, depth607 := position, tokenIndex, depth
+			position659, tokenIndex659, depth659 := position, tokenIndex, depth
 			{
-				position608 := position
+				position660 := position
 				depth++
-			l609:
+			l661:
 				{
-					position610, tokenIndex610, depth610 := position, tokenIndex, depth
+					position662, tokenIndex662, depth662 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l610
+						goto l662
 					}
-					goto l609
-				l610:
-					position, tokenIndex, depth = position610, tokenIndex610, depth610
+					goto l661
+				l662:
+					position, tokenIndex, depth = position662, tokenIndex662, depth662
 				}
 				{
-					position611, tokenIndex611, depth611 := position, tokenIndex, depth
+					position663, tokenIndex663, depth663 := position, tokenIndex, depth
 					if !_rules[rulecparam]() {
-						goto l611
+						goto l663
 					}
-				l613:
+				l665:
 					{
-						position614, tokenIndex614, depth614 := position, tokenIndex, depth
+						position666, tokenIndex666, depth666 := position, tokenIndex, depth
 						if !_rules[ruleisp]() {
-							goto l614
+							goto l666
 						}
-						goto l613
-					l614:
-						position, tokenIndex, depth = position614, tokenIndex614, depth614
+						goto l665
+					l666:
+						position, tokenIndex, depth = position666, tokenIndex666, depth666
 					}
-				l615:
+				l667:
 					{
-						position616, tokenIndex616, depth616 := position, tokenIndex, depth
+						position668, tokenIndex668, depth668 := position, tokenIndex, depth
 						if buffer[position] != rune(',') {
-							goto l616
+							goto l668
 						}
 						position++
-					l617:
+					l669:
 						{
-							position618, tokenIndex618, depth618 := position, tokenIndex, depth
+							position670, tokenIndex670, depth670 := position, tokenIndex, depth
 							if !_rules[ruleisp]() {
-								goto l618
+								goto l670
 							}
-							goto l617
-						l618:
-							position, tokenIndex, depth = position618, tokenIndex618, depth618
+							goto l669
+						l670:
+							position, tokenIndex, depth = position670, tokenIndex670, depth670
 						}
 						if !_rules[rulecparam]() {
-							goto l616
+							goto l668
 						}
-					l619:
+					l671:
 						{
-							position620, tokenIndex620, depth620 := position, tokenIndex, depth
+							position672, tokenIndex672, depth672 := position, tokenIndex, depth
 							if !_rules[ruleisp]() {
-								goto l620
+								goto l672
 							}
-							goto l619
-						l620:
-							position, tokenIndex, depth = position620, tokenIndex620, depth620
+							goto l671
+						l672:
+							position, tokenIndex, depth = position672, tokenIndex672, depth672
 						}
-						goto l615
-					l616:
-						position, tokenIndex, depth = position616, tokenIndex616, depth616
+						goto l667
+					l668:
+						position, tokenIndex, depth = position668, tokenIndex668, depth668
 					}
-					goto l612
-				l611:
-					position, tokenIndex, depth = position611, tokenIndex611, depth611
+					goto l664
+				l663:
+					position, tokenIndex, depth = position663, tokenIndex663, depth663
 				}
-			l612:
+			l664:
 				{
-					position621, tokenIndex621, depth621 := position, tokenIndex, depth
+					position673, tokenIndex673, depth673 := position, tokenIndex, depth
 					if !matchDot() {
-						goto l621
+						goto l673
 					}
-					goto l607
-				l621:
-					position, tokenIndex, depth = position621, tokenIndex621, depth621
+					goto l659
+				l673:
+					position, tokenIndex, depth = position673, tokenIndex673, depth673
 				}
 				depth--
-				add(rulecparams, position608)
+				add(rulecparams, position660)
 			}
 			return true
-		l607:
-			position, tokenIndex, depth = position607, tokenIndex607, depth607
+		l659:
+			position, tokenIndex, depth = position659, tokenIndex659, depth659
 			return false
 		},
-		/* 62 cparam <- <((var isp+)? tagname isp+ type Action38)> */
+		/* 66 cparam <- <((var isp+)? tagname isp+ type Action41)> */
 		func() bool {
-			position622, tokenIndex622, depth622 := position, tokenIndex, depth
+			position674, tokenIndex674, depth674 := position, tokenIndex, depth
 			{
-				position623 := position
+				position675 := position
 				depth++
 				{
-					position624, tokenIndex624, depth624 := position, tokenIndex, depth
+					position676, tokenIndex676, depth676 := position, tokenIndex, depth
 					if !_rules[rulevar]() {
-						goto l624
+						goto l676
 					}
 					if !_rules[ruleisp]() {
-						goto l624
+						goto l676
 					}
-				l626:
+				l678:
 					{
-						position627, tokenIndex627, depth627 := position, tokenIndex, depth
+						position679, tokenIndex679, depth679 := position, tokenIndex, depth
 						if !_rules[ruleisp]() {
-							goto l627
+							goto l679
 						}
-						goto l626
-					l627:
-						position, tokenIndex, depth = position627, tokenIndex627, depth627
+						goto l678
+					l679:
+						position, tokenIndex, depth = position679, tokenIndex679, depth679
 					}
-					goto l625
-				l624:
-					position, tokenIndex, depth = position624, tokenIndex624, depth624
+					goto l677
+				l676:
+					position, tokenIndex, depth = position676, tokenIndex676, depth676
 				}
-			l625:
+			l677:
 				if !_rules[ruletagname]() {
-					goto l622
+					goto l674
 				}
 				if !_rules[ruleisp]() {
-					goto l622
+					goto l674
 				}
-			l628:
+			l680:
 				{
-					position629, tokenIndex629, depth629 := position, tokenIndex, depth
+					position681, tokenIndex681, depth681 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l629
+						goto l681
 					}
-					goto l628
-				l629:
-					position, tokenIndex, depth = position629, tokenIndex629, depth629
+					goto l680
+				l681:
+					position, tokenIndex, depth = position681, tokenIndex681, depth681
 				}
 				if !_rules[ruletype]() {
-					goto l622
+					goto l674
 				}
-				if !_rules[ruleAction38]() {
-					goto l622
+				if !_rules[ruleAction41]() {
+					goto l674
 				}
 				depth--
-				add(rulecparam, position623)
+				add(rulecparam, position675)
 			}
 			return true
-		l622:
-			position, tokenIndex, depth = position622, tokenIndex622, depth622
+		l674:
+			position, tokenIndex, depth = position674, tokenIndex674, depth674
 			return false
 		},
-		/* 63 var <- <(('v' / 'V') ('a' / 'A') ('r' / 'R') Action39)> */
+		/* 67 var <- <(('v' / 'V') ('a' / 'A') ('r' / 'R') Action42)> */
 		func() bool {
-			position630, tokenIndex630, depth630 := position, tokenIndex, depth
+			position682, tokenIndex682, depth682 := position, tokenIndex, depth
 			{
-				position631 := position
+				position683 := position
 				depth++
 				{
-					position632, tokenIndex632, depth632 := position, tokenIndex, depth
+					position684, tokenIndex684, depth684 := position, tokenIndex, depth
 					if buffer[position] != rune('v') {
-						goto l633
+						goto l685
 					}
 					position++
-					goto l632
-				l633:
-					position, tokenIndex, depth = position632, tokenIndex632, depth632
+					goto l684
+				l685:
+					position, tokenIndex, depth = position684, tokenIndex684, depth684
 					if buffer[position] != rune('V') {
-						goto l630
+						goto l682
 					}
 					position++
 				}
-			l632:
+			l684:
 				{
-					position634, tokenIndex634, depth634 := position, tokenIndex, depth
+					position686, tokenIndex686, depth686 := position, tokenIndex, depth
 					if buffer[position] != rune('a') {
-						goto l635
+						goto l687
 					}
 					position++
-					goto l634
-				l635:
-					position, tokenIndex, depth = position634, tokenIndex634, depth634
+					goto l686
+				l687:
+					position, tokenIndex, depth = position686, tokenIndex686, depth686
 					if buffer[position] != rune('A') {
-						goto l630
+						goto l682
 					}
 					position++
 				}
-			l634:
+			l686:
 				{
-					position636, tokenIndex636, depth636 := position, tokenIndex, depth
+					position688, tokenIndex688, depth688 := position, tokenIndex, depth
 					if buffer[position] != rune('r') {
-						goto l637
+						goto l689
 					}
 					position++
-					goto l636
-				l637:
-					position, tokenIndex, depth = position636, tokenIndex636, depth636
+					goto l688
+				l689:
+					position, tokenIndex, depth = position688, tokenIndex688, depth688
 					if buffer[position] != rune('R') {
-						goto l630
+						goto l682
 					}
 					position++
 				}
-			l636:
-				if !_rules[ruleAction39]() {
-					goto l630
+			l688:
+				if !_rules[ruleAction42]() {
+					goto l682
 				}
 				depth--
-				add(rulevar, position631)
+				add(rulevar, position683)
 			}
 			return true
-		l630:
-			position, tokenIndex, depth = position630, tokenIndex630, depth630
+		l682:
+			position, tokenIndex, depth = position682, tokenIndex682, depth682
 			return false
 		},
-		/* 64 args <- <(isp* arg isp* (',' isp* arg isp*)* !.)> */
+		/* 68 args <- <(isp* arg isp* (',' isp* arg isp*)* !.)> */
 		func() bool {
-			position638, tokenIndex638, depth638 := position, tokenIndex, depth
+			position690, tokenIndex690, depth690 := position, tokenIndex, depth
 			{
-				position639 := position
+				position691 := position
 				depth++
-			l640:
+			l692:
 				{
-					position641, tokenIndex641, depth641 := position, tokenIndex, depth
+					position693, tokenIndex693, depth693 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l641
+						goto l693
 					}
-					goto l640
-				l641:
-					position, tokenIndex, depth = position641, tokenIndex641, depth641
+					goto l692
+				l693:
+					position, tokenIndex, depth = position693, tokenIndex693, depth693
 				}
 				if !_rules[rulearg]() {
-					goto l638
+					goto l690
 				}
-			l642:
+			l694:
 				{
-					position643, tokenIndex643, depth643 := position, tokenIndex, depth
+					position695, tokenIndex695, depth695 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l643
+						goto l695
 					}
-					goto l642
-				l643:
-					position, tokenIndex, depth = position643, tokenIndex643, depth643
+					goto l694
+				l695:
+					position, tokenIndex, depth = position695, tokenIndex695, depth695
 				}
-			l644:
+			l696:
 				{
-					position645, tokenIndex645, depth645 := position, tokenIndex, depth
+					position697, tokenIndex697, depth697 := position, tokenIndex, depth
 					if buffer[position] != rune(',') {
-						goto l645
+						goto l697
 					}
 					position++
-				l646:
+				l698:
 					{
-						position647, tokenIndex647, depth647 := position, tokenIndex, depth
+						position699, tokenIndex699, depth699 := position, tokenIndex, depth
 						if !_rules[ruleisp]() {
-							goto l647
+							goto l699
 						}
-						goto l646
-					l647:
-						position, tokenIndex, depth = position647, tokenIndex647, depth647
+						goto l698
+					l699:
+						position, tokenIndex, depth = position699, tokenIndex699, depth699
 					}
 					if !_rules[rulearg]() {
-						goto l645
+						goto l697
 					}
-				l648:
+				l700:
 					{
-						position649, tokenIndex649, depth649 := position, tokenIndex, depth
+						position701, tokenIndex701, depth701 := position, tokenIndex, depth
 						if !_rules[ruleisp]() {
-							goto l649
+							goto l701
 						}
-						goto l648
-					l649:
-						position, tokenIndex, depth = position649, tokenIndex649, depth649
+						goto l700
+					l701:
+						position, tokenIndex, depth = position701, tokenIndex701, depth701
 					}
-					goto l644
-				l645:
-					position, tokenIndex, depth = position645, tokenIndex645, depth645
+					goto l696
+				l697:
+					position, tokenIndex, depth = position697, tokenIndex697, depth697
 				}
 				{
-					position650, tokenIndex650, depth650 := position, tokenIndex, depth
+					position702, tokenIndex702, depth702 := position, tokenIndex, depth
 					if !matchDot() {
-						goto l650
+						goto l702
 					}
-					goto l638
-				l650:
-					position, tokenIndex, depth = position650, tokenIndex650, depth650
+					goto l690
+				l702:
+					position, tokenIndex, depth = position702, tokenIndex702, depth702
 				}
 				depth--
-				add(ruleargs, position639)
+				add(ruleargs, position691)
 			}
 			return true
-		l638:
-			position, tokenIndex, depth = position638, tokenIndex638, depth638
+		l690:
+			position, tokenIndex, depth = position690, tokenIndex690, depth690
 			return false
 		},
-		/* 65 arg <- <(expr Action40)> */
+		/* 69 arg <- <(expr Action43)> */
 		func() bool {
-			position651, tokenIndex651, depth651 := position, tokenIndex, depth
+			position703, tokenIndex703, depth703 := position, tokenIndex, depth
 			{
-				position652 := position
+				position704 := position
 				depth++
 				if !_rules[ruleexpr]() {
-					goto l651
+					goto l703
 				}
-				if !_rules[ruleAction40]() {
-					goto l651
+				if !_rules[ruleAction43]() {
+					goto l703
 				}
 				depth--
-				add(rulearg, position652)
+				add(rulearg, position704)
 			}
 			return true
-		l651:
-			position, tokenIndex, depth = position651, tokenIndex651, depth651
+		l703:
+			position, tokenIndex, depth = position703, tokenIndex703, depth703
 			return false
 		},
-		/* 66 imports <- <(isp* (fsep isp*)* import isp* (fsep isp* (fsep isp*)* import isp*)* (fsep isp*)* !.)> */
+		/* 70 imports <- <(isp* (fsep isp*)* import isp* (fsep isp* (fsep isp*)* import isp*)* (fsep isp*)* !.)> */
 		func() bool {
-			position653, tokenIndex653, depth653 := position, tokenIndex, depth
+			position705, tokenIndex705, depth705 := position, tokenIndex, depth
 			{
-				position654 := position
+				position706 := position
 				depth++
-			l655:
+			l707:
 				{
-					position656, tokenIndex656, depth656 := position, tokenIndex, depth
+					position708, tokenIndex708, depth708 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l656
+						goto l708
 					}
-					goto l655
-				l656:
-					position, tokenIndex, depth = position656, tokenIndex656, depth656
+					goto l707
+				l708:
+					position, tokenIndex, depth = position708, tokenIndex708, depth708
 				}
-			l657:
+			l709:
 				{
-					position658, tokenIndex658, depth658 := position, tokenIndex, depth
+					position710, tokenIndex710, depth710 := position, tokenIndex, depth
 					if !_rules[rulefsep]() {
-						goto l658
+						goto l710
 					}
-				l659:
+				l711:
 					{
-						position660, tokenIndex660, depth660 := position, tokenIndex, depth
+						position712, tokenIndex712, depth712 := position, tokenIndex, depth
 						if !_rules[ruleisp]() {
-							goto l660
+							goto l712
 						}
-						goto l659
-					l660:
-						position, tokenIndex, depth = position660, tokenIndex660, depth660
+						goto l711
+					l712:
+						position, tokenIndex, depth = position712, tokenIndex712, depth712
 					}
-					goto l657
-				l658:
-					position, tokenIndex, depth = position658, tokenIndex658, depth658
+					goto l709
+				l710:
+					position, tokenIndex, depth = position710, tokenIndex710, depth710
 				}
 				if !_rules[ruleimport]() {
-					goto l653
+					goto l705
 				}
-			l661:
+			l713:
 				{
-					position662, tokenIndex662, depth662 := position, tokenIndex, depth
+					position714, tokenIndex714, depth714 := position, tokenIndex, depth
 					if !_rules[ruleisp]() {
-						goto l662
+						goto l714
 					}
-					goto l661
-				l662:
-					position, tokenIndex, depth = position662, tokenIndex662, depth662
+					goto l713
+				l714:
+					position, tokenIndex, depth = position714, tokenIndex714, depth714
 				}
-			l663:
+			l715:
 				{
-					position664, tokenIndex664, depth664 := position, tokenIndex, depth
+					position716, tokenIndex716, depth716 := position, tokenIndex, depth
 					if !_rules[rulefsep]() {
-						goto l664
+						goto l716
 					}
-				l665:
+				l717:
 					{
-						position666, tokenIndex666, depth666 := position, tokenIndex, depth
+						position718, tokenIndex718, depth718 := position, tokenIndex, depth
 						if !_rules[ruleisp]() {
-							goto l666
+							goto l718
 						}
-						goto l665
-					l666:
-						position, tokenIndex, depth = position666, tokenIndex666, depth666
+						goto l717
+					l718:
+						position, tokenIndex, depth = position718, tokenIndex718, depth718
 					}
-				l667:
+				l719:
 					{
-						position668, tokenIndex668, depth668 := position, tokenIndex, depth
+						position720, tokenIndex720, depth720 := position, tokenIndex, depth
 						if !_rules[rulefsep]() {
-							goto l668
+							goto l720
 						}
-					l669:
+					l721:
 						{
-							position670, tokenIndex670, depth670 := position, tokenIndex, depth
+							position722, tokenIndex722, depth722 := position, tokenIndex, depth
 							if !_rules[ruleisp]() {
-								goto l670
+								goto l722
 							}
-							goto l669
-						l670:
-							position, tokenIndex, depth = position670, tokenIndex670, depth670
+							goto l721
+						l722:
+							position, tokenIndex, depth = position722, tokenIndex722, depth722
 						}
-						goto l667
-					l668:
-						position, tokenIndex, depth = position668, tokenIndex668, depth668
+						goto l719
+					l720:
+						position, tokenIndex, depth = position720, tokenIndex720, depth720
 					}
 					if !_rules[ruleimport]() {
-						goto l664
+						goto l716
 					}
-				l671:
+				l723:
 					{
-						position672, tokenIndex672, depth672 := position, tokenIndex, depth
+						position724, tokenIndex724, depth724 := position, tokenIndex, depth
 						if !_rules[ruleisp]() {
-							goto l672
+							goto l724
 						}
-						goto l671
-					l672:
-						position, tokenIndex, depth = position672, tokenIndex672, depth672
+						goto l723
+					l724:
+						position, tokenIndex, depth = position724, tokenIndex724, depth724
 					}
-					goto l663
-				l664:
-					position, tokenIndex, depth = position664, tokenIndex664, depth664
+					goto l715
+				l716:
+					position, tokenIndex, depth = position716, tokenIndex716, depth716
 				}
-			l673:
+			l725:
 				{
-					position674, tokenIndex674, depth674 := position, tokenIndex, depth
+					position726, tokenIndex726, depth726 := position, tokenIndex, depth
 					if !_rules[rulefsep]() {
-						goto l674
+						goto l726
 					}
-				l675:
+				l727:
 					{
-						position676, tokenIndex676, depth676 := position, tokenIndex, depth
+						position728, tokenIndex728, depth728 := position, tokenIndex, depth
 						if !_rules[ruleisp]() {
-							goto l676
+							goto l728
 						}
-						goto l675
-					l676:
-						position, tokenIndex, depth = position676, tokenIndex676, depth676
+						goto l727
+					l728:
+						position, tokenIndex, depth = position728, tokenIndex728, depth728
 					}
-					goto l673
-				l674:
-					position, tokenIndex, depth = position674, tokenIndex674, depth674
+					goto l725
+				l726:
+					position, tokenIndex, depth = position726, tokenIndex726, depth726
 				}
 				{
-					position677, tokenIndex677, depth677 := position, tokenIndex, depth
+					position729, tokenIndex729, depth729 := position, tokenIndex, depth
 					if !matchDot() {
-						goto l677
+						goto l729
 					}
-					goto l653
-				l677:
-					position, tokenIndex, depth = position677, tokenIndex677, depth677
+					goto l705
+				l729:
+					position, tokenIndex, depth = position729, tokenIndex729, depth729
 				}
 				depth--
-				add(ruleimports, position654)
+				add(ruleimports, position706)
 			}
 			return true
-		l653:
-			position, tokenIndex, depth = position653, tokenIndex653, depth653
+		l705:
+			position, tokenIndex, depth = position705, tokenIndex705, depth705
 			return false
 		},
-		/* 67 import <- <((tagname isp+)? '"' <(!'"' .)*> '"' Action41)> */
+		/* 71 import <- <((tagname isp+)? '"' <(!'"' .)*> '"' Action44)> */
 		func() bool {
-			position678, tokenIndex678, depth678 := position, tokenIndex, depth
+			position730, tokenIndex730, depth730 := position, tokenIndex, depth
 			{
-				position679 := position
+				position731 := position
 				depth++
 				{
-					position680, tokenIndex680, depth680 := position, tokenIndex, depth
+					position732, tokenIndex732, depth732 := position, tokenIndex, depth
 					if !_rules[ruletagname]() {
-						goto l680
+						goto l732
 					}
 					if !_rules[ruleisp]() {
-						goto l680
+						goto l732
 					}
-				l682:
+				l734:
 					{
-						position683, tokenIndex683, depth683 := position, tokenIndex, depth
+						position735, tokenIndex735, depth735 := position, tokenIndex, depth
 						if !_rules[ruleisp]() {
-							goto l683
+							goto l735
 						}
-						goto l682
-					l683:
-						position, tokenIndex, depth = position683, tokenIndex683, depth683
+						goto l734
+					l735:
+						position, tokenIndex, depth = position735, tokenIndex735, depth735
 					}
-					goto l681
-				l680:
-					position, tokenIndex, depth = position680, tokenIndex680, depth680
+					goto l733
+				l732:
+					position, tokenIndex, depth = position732, tokenIndex732, depth732
 				}
-			l681:
+			l733:
 				if buffer[position] != rune('"') {
-					goto l678
+					goto l730
 				}
 				position++
 				{
-					position684 := position
+					position736 := position
 					depth++
-				l685:
+				l737:
 					{
-						position686, tokenIndex686, depth686 := position, tokenIndex, depth
+						position738, tokenIndex738, depth738 := position, tokenIndex, depth
 						{
-							position687, tokenIndex687, depth687 := position, tokenIndex, depth
+							position739, tokenIndex739, depth739 := position, tokenIndex, depth
 							if buffer[position] != rune('"') {
-								goto l687
+								goto l739
 							}
 							position++
-							goto l686
-						l687:
-							position, tokenIndex, depth = position687, tokenIndex687, depth687
+							goto l738
+						l739:
+							position, tokenIndex, depth = position739, tokenIndex739, depth739
 						}
 						if !matchDot() {
-							goto l686
+							goto l738
 						}
-						goto l685
-					l686:
-						position, tokenIndex, depth = position686, tokenIndex686, depth686
+						goto l737
+					l738:
+						position, tokenIndex, depth = position738, tokenIndex738, depth738
 					}
 					depth--
-					add(rulePegText, position684)
+					add(rulePegText, position736)
 				}
 				if buffer[position] != rune('"') {
-					goto l678
+					goto l730
 				}
 				position++
-				if !_rules[ruleAction41]() {
-					goto l678
+				if !_rules[ruleAction44]() {
+					goto l730
 				}
 				depth--
-				add(ruleimport, position679)
+				add(ruleimport, position731)
 			}
 			return true
-		l678:
-			position, tokenIndex, depth = position678, tokenIndex678, depth678
+		l730:
+			position, tokenIndex, depth = position730, tokenIndex730, depth730
 			return false
 		},
-		/* 69 Action0 <- <{
+		/* 73 Action0 <- <{
 			p.varMappings = append(p.varMappings,
 				data.VariableMapping{Value: p.bv, Variable: p.goVal})
 			p.goVal.Type = nil
@@ -6321,102 +6872,124 @@ func (p *GeneralParser) Init() {
 			}
 			return true
 		},
+		/* 74 Action1 <- <{
+			p.observed = append(p.observed, data.Assignment{Expression: p.expr,
+				Target: p.bv, TwoWay: true})
+			p.bv.IDs = nil
+		}> */
+		func() bool {
+			{
+				add(ruleAction1, position)
+			}
+			return true
+		},
+		/* 75 Action2 <- <{
+			p.observed = append(p.observed, data.Assignment{Expression: p.expr,
+				Target: p.bv})
+			p.bv.IDs = nil
+		}> */
+		func() bool {
+			{
+				add(ruleAction2, position)
+			}
+			return true
+		},
 		nil,
-		/* 71 Action1 <- <{
+		/* 77 Action3 <- <{
 			p.goVal.Name = buffer[begin:end]
 		}> */
 		func() bool {
 			{
-				add(ruleAction1, position)
+				add(ruleAction3, position)
 			}
 			return true
 		},
-		/* 72 Action2 <- <{
+		/* 78 Action4 <- <{
 			p.goVal.Type = p.valuetype
 			p.valuetype = nil
 		}> */
 		func() bool {
 			{
-				add(ruleAction2, position)
+				add(ruleAction4, position)
 			}
 			return true
 		},
-		/* 73 Action3 <- <{
+		/* 79 Action5 <- <{
 			p.assignments = append(p.assignments, data.Assignment{Expression: p.expr,
 				Target: p.bv})
 			p.bv.IDs = nil
 		}> */
 		func() bool {
 			{
-				add(ruleAction3, position)
+				add(ruleAction5, position)
 			}
 			return true
 		},
-		/* 74 Action4 <- <{
+		/* 80 Action6 <- <{
 			p.bv.Kind = data.BoundSelf
 		}> */
 		func() bool {
 			{
-				add(ruleAction4, position)
+				add(ruleAction6, position)
 			}
 			return true
 		},
-		/* 75 Action5 <- <{
+		/* 81 Action7 <- <{
 			p.bv.Kind = data.BoundDataset
 		}> */
 		func() bool {
 			{
-				add(ruleAction5, position)
+				add(ruleAction7, position)
 			}
 			return true
 		},
-		/* 76 Action6 <- <{
+		/* 82 Action8 <- <{
 			p.bv.Kind = data.BoundProperty
 		}> */
 		func() bool {
 			{
-				add(ruleAction6, position)
+				add(ruleAction8, position)
 			}
 			return true
 		},
-		/* 77 Action7 <- <{
+		/* 83 Action9 <- <{
 			p.bv.Kind = data.BoundStyle
 		}> */
 		func() bool {
 			{
-				add(ruleAction7, position)
+				add(ruleAction9, position)
 			}
 			return true
 		},
-		/* 78 Action8 <- <{
+		/* 84 Action10 <- <{
 			p.bv.Kind = data.BoundClass
 		}> */
 		func() bool {
 			{
-				add(ruleAction8, position)
+				add(ruleAction10, position)
 			}
 			return true
 		},
-		/* 79 Action9 <- <{
+		/* 85 Action11 <- <{
 			p.bv.Kind = data.BoundFormValue
 		}> */
 		func() bool {
 			{
-				add(ruleAction9, position)
+				add(ruleAction11, position)
 			}
 			return true
 		},
-		/* 80 Action10 <- <{
+		/* 86 Action12 <- <{
 			p.bv.Kind = data.BoundExpr
 			p.bv.IDs = append(p.bv.IDs, p.expr)
 		}> */
 		func() bool {
 			{
-				add(ruleAction10, position)
+				add(ruleAction12, position)
 			}
 			return true
 		},
-		/* 81 Action11 <- <{
+		/* 87 Action13 <- <{
 			p.bv.Kind = data.BoundEventValue
 			if len(p.bv.IDs) == 0 {
 				p.bv.IDs = append(p.bv.IDs, "")
@@ -6424,38 +6997,47 @@ func (p *GeneralParser) Init() {
 		}> */
 		func() bool {
 			{
-				add(ruleAction11, position)
+				add(ruleAction13, position)
+			}
+			return true
+		},
+		/* 88 Action14 <- <{
+			p.bv.Kind = data.BoundEventDetail
+		}> */
+		func() bool {
+			{
+				add(ruleAction14, position)
 			}
 			return true
 		},
-		/* 82 Action12 <- <{
+		/* 89 Action15 <- <{
 			p.bv.IDs = append(p.bv.IDs, buffer[begin:end])
 		}> */
 		func() bool {
 			{
-				add(ruleAction12, position)
+				add(ruleAction15, position)
 			}
 			return true
 		},
-		/* 83 Action13 <- <{
+		/* 90 Action16 <- <{
 			p.bv.IDs = append(p.bv.IDs, buffer[begin:end])
 		}> */
 		func() bool {
 			{
-				add(ruleAction13, position)
+				add(ruleAction16, position)
 			}
 			return true
 		},
-		/* 84 Action14 <- <{
+		/* 91 Action17 <- <{
 			p.expr = buffer[begin:end]
 		}> */
 		func() bool {
 			{
-				add(ruleAction14, position)
+				add(ruleAction17, position)
 			}
 			return true
 		},
-		/* 85 Action15 <- <{
+		/* 92 Action18 <- <{
 			var expr *string
 			if p.expr != "" {
 				expr = new(string)
@@ -6470,20 +7052,20 @@ func (p *GeneralParser) Init() {
 		}> */
 		func() bool {
 			{
-				add(ruleAction15, position)
+				add(ruleAction18, position)
 			}
 			return true
 		},
-		/* 86 Action16 <- <{
+		/* 93 Action19 <- <{
 			p.names = append(p.names, buffer[begin:end])
 		}> */
 		func() bool {
 			{
-				add(ruleAction16, position)
+				add(ruleAction19, position)
 			}
 			return true
 		},
-		/* 87 Action17 <- <{
+		/* 94 Action20 <- <{
 			switch name := buffer[begin:end]; name {
 			case "int":
 				p.valuetype = &data.ParamType{Kind: data.IntType}
@@ -6497,11 +7079,11 @@ func (p *GeneralParser) Init() {
 		}> */
 		func() bool {
 			{
-				add(ruleAction17, position)
+				add(ruleAction20, position)
 			}
 			return true
 		},
-		/* 88 Action18 <- <{
+		/* 95 Action21 <- <{
 			name := buffer[begin:end]
 			if name == "js.Value" {
 				p.valuetype = &data.ParamType{Kind: data.JSValueType}
@@ -6511,109 +7093,120 @@ func (p *GeneralParser) Init() {
 		}> */
 		func() bool {
 			{
-				add(ruleAction18, position)
+				add(ruleAction21, position)
 			}
 			return true
 		},
-		/* 89 Action19 <- <{
+		/* 96 Action22 <- <{
 			p.valuetype = &data.ParamType{Kind: data.ArrayType, ValueType: p.valuetype}
 		}> */
 		func() bool {
 			{
-				add(ruleAction19, position)
+				add(ruleAction22, position)
 			}
 			return true
 		},
-		/* 90 Action20 <- <{
+		/* 97 Action23 <- <{
 			p.valuetype = &data.ParamType{Kind: data.MapType, KeyType: p.keytype, ValueType: p.valuetype}
 		}> */
 		func() bool {
 			{
-				add(ruleAction20, position)
+				add(ruleAction23, position)
 			}
 			return true
 		},
-		/* 91 Action21 <- <{
+		/* 98 Action24 <- <{
 			p.valuetype = &data.ParamType{Kind: data.ChanType, ValueType: p.valuetype}
 		}> */
 		func() bool {
 			{
-				add(ruleAction21, position)
+				add(ruleAction24, position)
 			}
 			return true
 		},
-		/* 92 Action22 <- <{
+		/* 99 Action25 <- <{
 			p.valuetype = &data.ParamType{Kind: data.FuncType, ValueType: p.valuetype,
 				Params: p.params}
 			p.params = nil
 		}> */
 		func() bool {
 			{
-				add(ruleAction22, position)
+				add(ruleAction25, position)
 			}
 			return true
 		},
-		/* 93 Action23 <- <{
+		/* 100 Action26 <- <{
 			p.keytype = p.valuetype
 		}> */
 		func() bool {
 			{
-				add(ruleAction23, position)
+				add(ruleAction26, position)
 			}
 			return true
 		},
-		/* 94 Action24 <- <{
+		/* 101 Action27 <- <{
 			p.valuetype = &data.ParamType{Kind: data.PointerType, ValueType: p.valuetype}
 		}> */
 		func() bool {
 			{
-				add(ruleAction24, position)
+				add(ruleAction27, position)
 			}
 			return true
 		},
-		/* 95 Action25 <- <{
+		/* 102 Action28 <- <{
+			if p.eventOptions.Passive && (p.eventHandling == data.PreventDefault ||
+				p.eventHandling == data.AskPreventDefault) {
+				p.err = errors.New("passive listener cannot prevent default")
+				return
+			}
+			if p.eventOptions.IsLimited() && (p.eventHandling == data.PreventDefault ||
+				p.eventHandling == data.AskPreventDefault) {
+				p.err = errors.New("debounced or throttled handler cannot prevent default")
+				return
+			}
 			p.eventMappings = append(p.eventMappings, data.UnboundEventMapping{
 				Event: p.eventName, Handler: p.handlername, ParamMappings: p.paramMappings,
-				Handling: p.eventHandling})
+				Handling: p.eventHandling, Options: p.eventOptions})
 			p.eventHandling = data.AutoPreventDefault
+			p.eventOptions = data.EventOptions{}
 			p.expr = ""
 			p.paramMappings = make(map[string]data.BoundValue)
 		}> */
 		func() bool {
 			{
-				add(ruleAction25, position)
+				add(ruleAction28, position)
 			}
 			return true
 		},
-		/* 96 Action26 <- <{
+		/* 103 Action29 <- <{
 			p.handlername = buffer[begin:end]
 		}> */
 		func() bool {
 			{
-				add(ruleAction26, position)
+				add(ruleAction29, position)
 			}
 			return true
 		},
-		/* 97 Action27 <- <{
+		/* 104 Action30 <- <{
 			p.eventName = buffer[begin:end]
 		}> */
 		func() bool {
 			{
-				add(ruleAction27, position)
+				add(ruleAction30, position)
 			}
 			return true
 		},
-		/* 98 Action28 <- <{
+		/* 105 Action31 <- <{
 			p.paramIndex = 0
 			p.tagname = ""
 		}> */
 		func() bool {
 			{
-				add(ruleAction28, position)
+				add(ruleAction31, position)
 			}
 			return true
 		},
-		/* 99 Action29 <- <{
+		/* 106 Action32 <- <{
 			if p.tagname == "" {
 				if p.paramIndex == -1 {
 					p.err = errors.New("unnamed parameter mapping after named one")
@@ -6634,20 +7227,20 @@ func (p *GeneralParser) Init() {
 		}> */
 		func() bool {
 			{
-				add(ruleAction29, position)
+				add(ruleAction32, position)
 			}
 			return true
 		},
-		/* 100 Action30 <- <{
+		/* 107 Action33 <- <{
 			p.tagname = buffer[begin:end]
 		}> */
 		func() bool {
 			{
-				add(ruleAction30, position)
+				add(ruleAction33, position)
 			}
 			return true
 		},
-		/* 101 Action31 <- <{
+		/* 108 Action34 <- <{
 			switch p.tagname {
 			case "preventDefault":
 				if p.eventHandling != data.AutoPreventDefault {
@@ -6673,6 +7266,55 @@ func (p *GeneralParser) Init() {
 					p.err = errors.New("too many parameters for preventDefault")
 					return
 				}
+			case "key", "button":
+				if p.eventOptions.Filter != nil {
+					p.err = errors.New("cannot have more than one of key and button")
+					return
+				}
+				p.eventOptions.Filter, p.err = eventFilter(p.tagname, p.names)
+				if p.err != nil {
+					return
+				}
+			case "debounce", "throttle":
+				if p.eventOptions.IsLimited() {
+					p.err = errors.New("cannot have more than one of debounce and throttle")
+					return
+				}
+				var d time.Duration
+				if d, p.err = duration(p.tagname, p.names); p.err != nil {
+					return
+				}
+				if p.tagname == "debounce" {
+					p.eventOptions.Debounce = d
+				} else {
+					p.eventOptions.Throttle = d
+				}
+			case "once", "passive", "capture", "stopPropagation",
+				"stopImmediatePropagation", "self":
+				if len(p.names) != 0 {
+					p.err = errors.New("too many parameters for " + p.tagname)
+					return
+				}
+				var flag *bool
+				switch p.tagname {
+				case "once":
+					flag = &p.eventOptions.Once
+				case "passive":
+					flag = &p.eventOptions.Passive
+				case "capture":
+					flag = &p.eventOptions.Capture
+				case "stopPropagation":
+					flag = &p.eventOptions.StopPropagation
+				case "stopImmediatePropagation":
+					flag = &p.eventOptions.StopImmediatePropagation
+				default:
+					flag = &p.eventOptions.Self
+				}
+				if *flag {
+					p.err = errors.New("duplicate " + p.tagname)
+					return
+				}
+				*flag = true
 			default:
 				p.err = errors.New("unknown tag: " + p.tagname)
 				return
@@ -6681,38 +7323,42 @@ func (p *GeneralParser) Init() {
 		}> */
 		func() bool {
 			{
-				add(ruleAction31, position)
+				add(ruleAction34, position)
 			}
 			return true
 		},
-		/* 102 Action32 <- <{
+		/* 109 Action35 <- <{
 			p.tagname = buffer[begin:end]
 		}> */
 		func() bool {
 			{
-				add(ruleAction32, position)
+				add(ruleAction35, position)
 			}
 			return true
 		},
-		/* 103 Action33 <- <{
+		/* 110 Action36 <- <{
 			p.names = append(p.names, buffer[begin:end])
 		}> */
 		func() bool {
 			{
-				add(ruleAction33, position)
+				add(ruleAction36, position)
 			}
 			return true
 		},
-		/* 104 Action34 <- <{
+		/* 111 Action37 <- <{
 			p.names = append(p.names, buffer[begin:end])
 		}> */
 		func() bool {
 			{
-				add(ruleAction34, position)
+				add(ruleAction37, position)
 			}
 			return true
 		},
-		/* 105 Action35 <- <{
+		/* 112 Action38 <- <{
+			if err := checkName(p.handlername); err != nil {
+				p.err = err
+				return
+			}
 			p.handlers = append(p.handlers, HandlerSpec{
 				Name: p.handlername, Params: p.params, Returns: p.valuetype})
 			p.valuetype = nil
@@ -6720,20 +7366,20 @@ func (p *GeneralParser) Init() {
 		}> */
 		func() bool {
 			{
-				add(ruleAction35, position)
+				add(ruleAction38, position)
 			}
 			return true
 		},
-		/* 106 Action36 <- <{
+		/* 113 Action39 <- <{
 			p.paramnames = append(p.paramnames, buffer[begin:end])
 		}> */
 		func() bool {
 			{
-				add(ruleAction36, position)
+				add(ruleAction39, position)
 			}
 			return true
 		},
-		/* 107 Action37 <- <{
+		/* 114 Action40 <- <{
 			name := p.paramnames[len(p.paramnames)-1]
 			p.paramnames = p.paramnames[:len(p.paramnames)-1]
 			for _, para := range p.params {
@@ -6748,11 +7394,11 @@ func (p *GeneralParser) Init() {
 		}> */
 		func() bool {
 			{
-				add(ruleAction37, position)
+				add(ruleAction40, position)
 			}
 			return true
 		},
-		/* 108 Action38 <- <{
+		/* 115 Action41 <- <{
 			p.cParams = append(p.cParams, data.ComponentParam{
 				Name: p.tagname, Type: *p.valuetype, IsVar: p.isVar})
 			p.valuetype = nil
@@ -6760,29 +7406,29 @@ func (p *GeneralParser) Init() {
 		}> */
 		func() bool {
 			{
-				add(ruleAction38, position)
+				add(ruleAction41, position)
 			}
 			return true
 		},
-		/* 109 Action39 <- <{
+		/* 116 Action42 <- <{
 			p.isVar = true
 		}> */
 		func() bool {
 			{
-				add(ruleAction39, position)
+				add(ruleAction42, position)
 			}
 			return true
 		},
-		/* 110 Action40 <- <{
+		/* 117 Action43 <- <{
 		  p.names = append(p.names, p.expr)
 		}> */
 		func() bool {
 			{
-				add(ruleAction40, position)
+				add(ruleAction43, position)
 			}
 			return true
 		},
-		/* 111 Action41 <- <{
+		/* 118 Action44 <- <{
 			path := buffer[begin:end]
 			if p.tagname == "" {
 				lastDot := strings.LastIndexByte(path, '/')
@@ -6801,7 +7447,7 @@ func (p *GeneralParser) Init() {
 		}> */
 		func() bool {
 			{
-				add(ruleAction41, position)
+				add(ruleAction44, position)
 			}
 			return true
 		},
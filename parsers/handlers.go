@@ -1,6 +1,11 @@
 package parsers
 
-import "github.com/flyx/askew/data"
+import (
+	"errors"
+	"go/token"
+
+	"github.com/flyx/askew/data"
+)
 
 // HandlerSpec describes the content of an <a:handler> node.
 type HandlerSpec struct {
@@ -14,8 +19,17 @@ func ParseHandlers(s string) ([]HandlerSpec, error) {
 	p := GeneralParser{Buffer: s}
 	p.Init()
 	if err := p.Parse(int(rulehandlers)); err != nil {
-		return nil, err
+		return nil, p.syntaxError(err)
 	}
 	p.Execute()
 	return p.handlers, p.err
 }
+
+// checkName returns an error if the given handler name cannot be used as
+// name of a Go method.
+func checkName(name string) error {
+	if token.IsKeyword(name) {
+		return errors.New("Go keyword cannot be used as name: " + name)
+	}
+	return nil
+}
@@ -0,0 +1,27 @@
+package parsers
+
+import (
+	"strings"
+	"testing"
+)
+
+func TestParseHandlers(t *testing.T) {
+	handlers, err := ParseHandlers("choose(id int)\nselected() bool")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(handlers) != 2 || handlers[0].Name != "choose" ||
+		len(handlers[0].Params) != 1 || handlers[1].Name != "selected" ||
+		handlers[1].Returns == nil {
+		t.Errorf("unexpected handlers: %+v", handlers)
+	}
+}
+
+func TestParseHandlersKeyword(t *testing.T) {
+	for _, input := range []string{"select()", "choose()\nfunc(id int)"} {
+		_, err := ParseHandlers(input)
+		if err == nil || !strings.Contains(err.Error(), "Go keyword") {
+			t.Errorf("%q: expected keyword error, got %v", input, err)
+		}
+	}
+}
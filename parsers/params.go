@@ -7,7 +7,7 @@ func ParseParameters(s string) ([]data.ComponentParam, error) {
 	p := GeneralParser{Buffer: s}
 	p.Init()
 	if err := p.Parse(int(rulecparams)); err != nil {
-		return nil, err
+		return nil, p.syntaxError(err)
 	}
 	p.Execute()
 	return p.cParams, nil
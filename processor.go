@@ -1,12 +1,15 @@
 package main
 
 import (
-	"errors"
+	"fmt"
+	"io/ioutil"
 	"os"
+	"path/filepath"
 	"strings"
 
 	"github.com/flyx/askew/data"
 	"github.com/flyx/askew/output"
+	"github.com/flyx/askew/typecheck"
 	"github.com/flyx/askew/units"
 	"github.com/flyx/askew/walker"
 
@@ -14,16 +17,40 @@ import (
 	"golang.org/x/mod/modfile"
 )
 
+// mode defines what the processor does with the generated code.
+type mode int
+
+const (
+	// writeMode writes the generated files to disk.
+	writeMode mode = iota
+	// checkMode only validates the input and does not generate any files.
+	checkMode
+	// diffMode compares the generated code with the existing files and
+	// prints the differences.
+	diffMode
+)
+
 type processor struct {
 	syms data.Symbols
 	mod  *modfile.File
+	mode mode
+	// files whose macros could not be processed. their components are not
+	// processed since that would only yield follow-up errors.
+	failed map[*data.AskewFile]struct{}
+	// number of generated files that differ from the files on disk in diffMode.
+	stale int
+	// whether the generated code should be type-checked.
+	typeCheck bool
+	// generated files that have not been written, by path.
+	overlay map[string][]byte
 }
 
 func (p *processor) init(base *data.BaseDir) {
 	p.syms.BaseDir = *base
+	p.failed = make(map[*data.AskewFile]struct{})
 }
 
-func (p *processor) processMacros(pkgName string) error {
+func (p *processor) processMacros(pkgName string) {
 	p.syms.CurPkg = pkgName
 	pkg := p.syms.Packages[pkgName]
 	for _, file := range pkg.Files {
@@ -32,8 +59,19 @@ func (p *processor) processMacros(pkgName string) error {
 		p.syms.SetAskewFile(file)
 		os.Stdout.WriteString("[info] processing macros: " + file.Path + "\n")
 		var dummyParent *html.Node
-		if dummyParent, err = processMacros(file.Content, &p.syms); err != nil {
-			return errors.New(file.Path + err.Error())
+		failed := false
+		dummyParent, err = processMacros(file.Content, &p.syms, func(err error) {
+			p.syms.Report(file.Path, err)
+			failed = true
+		})
+		if err != nil {
+			p.syms.Report(file.Path, err)
+			failed = true
+		}
+		if failed {
+			p.failed[file] = struct{}{}
+			pkg.Incomplete = true
+			continue
 		}
 
 		// we need to write out the nodes and parse it again since text nodes may
@@ -41,39 +79,137 @@ func (p *processor) processMacros(pkgName string) error {
 		// processed. If we don't do this, paths to access the dynamic objects will
 		// be wrong.
 		b := strings.Builder{}
+		var rendered []*html.Node
 		for cur := dummyParent.FirstChild; cur != nil; cur = cur.NextSibling {
 			html.Render(&b, cur)
+			rendered = append(rendered, cur)
 		}
 		file.Content, err = html.ParseFragmentWithOptions(
 			strings.NewReader(b.String()), &data.BodyEnv,
 			html.ParseOptionCustomElements(walker.AskewElements))
 		if err != nil {
-			return errors.New(file.Path + ": " + err.Error())
+			p.syms.Report(file.Path, err)
+			p.failed[file] = struct{}{}
+			pkg.Incomplete = true
+			continue
 		}
+		p.syms.Positions.Transfer(rendered, file.Content)
 	}
-	return nil
 }
 
-func (p *processor) processComponents(pkgName string) error {
+func (p *processor) processComponents(pkgName string) {
 	p.syms.CurPkg = pkgName
 	pkg := p.syms.Packages[pkgName]
 	for _, file := range pkg.Files {
-		if err := units.ProcessFile(file, &p.syms); err != nil {
-			return err
+		if _, ok := p.failed[file]; !ok {
+			units.ProcessFile(file, &p.syms)
 		}
 	}
 	if pkg.Site != nil {
-		return units.ProcessSite(pkg.Site, &p.syms)
+		units.ProcessSite(pkg.Site, &p.syms)
 	}
+}
+
+// generate processes the packages with the given relative paths, which must
+// be sorted by dependency order, and writes their code unless the processor is
+// in checkMode or diffMode. Afterwards, the generated code is type-checked.
+// Errors are added to the diagnostics, which may already contain errors from
+// discovery. Reports all errors and returns false if there were any.
+func (p *processor) generate(order []string, outputPath string,
+	backend output.Backend) bool {
+	for _, path := range order {
+		p.processMacros(path)
+	}
+	for _, path := range order {
+		p.processComponents(path)
+	}
+	if p.syms.Diagnostics.Len() > 0 {
+		reportDiagnostics(&p.syms.Diagnostics)
+		return false
+	}
+
+	switch p.mode {
+	case checkMode:
+		os.Stdout.WriteString("[info] checking generated code\n")
+	case diffMode:
+		os.Stdout.WriteString("[info] comparing generated code\n")
+	default:
+		os.Stdout.WriteString("[info] generating code\n")
+	}
+	p.stale = 0
+	p.overlay = make(map[string][]byte)
+	if err := p.dump(order, outputPath, backend); err != nil {
+		os.Stdout.WriteString("[error] " + err.Error() + "\n")
+		return false
+	}
+	if p.typeCheck {
+		p.checkTypes(order)
+		if p.syms.Diagnostics.Len() > 0 {
+			reportDiagnostics(&p.syms.Diagnostics)
+			return false
+		}
+	}
+	switch {
+	case p.stale == 1:
+		os.Stdout.WriteString("[error] 1 generated file is out of date\n")
+	case p.stale > 1:
+		fmt.Printf("[error] %d generated files are out of date\n", p.stale)
+	case p.mode == checkMode:
+		os.Stdout.WriteString("[info] no errors found\n")
+	}
+	return p.stale == 0
+}
+
+// checkTypes type-checks the packages with the given relative paths.
+// Packages that cannot be checked are skipped with a warning.
+func (p *processor) checkTypes(order []string) {
+	os.Stdout.WriteString("[info] type-checking generated code\n")
+	c := typecheck.NewChecker(&p.syms, p.overlay)
+	for _, relPath := range order {
+		if err := c.Check(relPath); err != nil {
+			os.Stdout.WriteString("[warning] skipping type check of package '" +
+				relPath + "': " + err.Error() + "\n")
+		}
+	}
+}
+
+// store keeps the generated content in memory for type checking.
+func (p *processor) store(path string, content []byte) error {
+	p.overlay[path] = content
 	return nil
 }
 
-func (p *processor) dump(outputPath string, backend output.Backend) error {
-	for relPath, pkg := range p.syms.Packages {
+// compare prints a diff if the generated content differs from the file
+// at path.
+func (p *processor) compare(path string, content []byte) error {
+	existing, err := ioutil.ReadFile(path)
+	if err != nil && !os.IsNotExist(err) {
+		return err
+	}
+	p.overlay[path] = content
+	if diff := unifiedDiff(filepath.ToSlash(path), string(existing),
+		string(content)); diff != "" {
+		p.stale++
+		os.Stdout.WriteString("[error] out of date: " + path + "\n")
+		os.Stdout.WriteString(diff)
+	}
+	return nil
+}
+
+func (p *processor) dump(order []string, outputPath string, backend output.Backend) error {
+	for _, relPath := range order {
+		pkg := p.syms.Packages[relPath]
 		w := output.PackageWriter{Syms: &p.syms, PackageName: pkg.Name, RelPath: relPath}
-		if err := os.MkdirAll(relPath, 0755); err != nil {
-			panic("failed to create package directory '" + relPath +
-				"': " + err.Error())
+		switch p.mode {
+		case checkMode:
+			w.Write = p.store
+		case diffMode:
+			w.Write = p.compare
+		default:
+			if err := os.MkdirAll(relPath, 0755); err != nil {
+				panic("failed to create package directory '" + relPath +
+					"': " + err.Error())
+			}
 		}
 		for _, f := range pkg.Files {
 			if err := w.WriteFile(f); err != nil {
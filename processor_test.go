@@ -0,0 +1,442 @@
+package main
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"sort"
+	"strings"
+	"testing"
+
+	"github.com/flyx/askew/output"
+)
+
+func TestAllErrorsReported(t *testing.T) {
+	inProject(t, map[string]string{
+		"ui/b.askew": `<a:component name="B">
+  <a:embed type="Missing" name="m"></a:embed>
+</a:component>`,
+		"ui/a.askew": `<a:component name="A">
+  <div a:capture="click:"></div>
+</a:component>
+<a:component name="C">
+  <p a:bindings="bogus"></p>
+</a:component>`,
+	}, func(dir string) {
+		p, order := newProcessor(t, checkMode)
+		if p.generate(order, dir, output.WasmBackend) {
+			t.Fatal("generate succeeded despite errors")
+		}
+		expectDiagnostics(t, &p.syms.Diagnostics,
+			"ui/a.askew:2:24: invalid capture: parse error near ':'",
+			"ui/a.askew:5:18: invalid bindings: parse error near 'b'",
+			"ui/b.askew:2:18: unknown component: 'Missing'")
+	})
+}
+
+// listFiles returns the slash-separated paths of all files in the cwd.
+func listFiles(t *testing.T) []string {
+	t.Helper()
+	var ret []string
+	err := filepath.Walk(".", func(path string, info os.FileInfo, err error) error {
+		if err == nil && !info.IsDir() {
+			ret = append(ret, filepath.ToSlash(path))
+		}
+		return err
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	sort.Strings(ret)
+	return ret
+}
+
+var validProject = map[string]string{
+	"ui/a.askew": `<a:component name="A">
+  <p a:bindings="prop(title):Title">text</p>
+</a:component>`,
+	"main.asite": `<!doctype html>
+<a:site>
+  <head><a:import>"example.com/proj/ui"</a:import></head>
+  <body><a:embed type="ui.A" name="a"></a:embed></body>
+</a:site>`,
+}
+
+func TestCheckMode(t *testing.T) {
+	inProject(t, validProject, func(dir string) {
+		before := listFiles(t)
+		p, order := newProcessor(t, checkMode)
+		if !p.generate(order, dir, output.WasmBackend) {
+			t.Fatalf("check failed: %q", diagnostics(&p.syms.Diagnostics))
+		}
+		if after := listFiles(t); len(after) != len(before) {
+			t.Errorf("check mode wrote files: %v", after)
+		}
+		if _, ok := p.overlay[filepath.Join("ui", "a.askew.go")]; !ok {
+			t.Errorf("generated code has not been kept for type checking")
+		}
+	})
+}
+
+func TestWriteMode(t *testing.T) {
+	inProject(t, validProject, func(dir string) {
+		p, order := newProcessor(t, writeMode)
+		if !p.generate(order, dir, output.WasmBackend) {
+			t.Fatalf("generate failed: %q", diagnostics(&p.syms.Diagnostics))
+		}
+		expected := []string{"go.mod", "index.html", "main.asite", "main.asite.go",
+			"ui/a.askew", "ui/a.askew.go"}
+		if files := listFiles(t); !reflect.DeepEqual(files, expected) {
+			t.Errorf("unexpected files: %v", files)
+		}
+	})
+}
+
+func TestVirtualListGeneration(t *testing.T) {
+	inProject(t, map[string]string{
+		"ui/a.askew": `<a:component name="Plain">
+  <p>plain</p>
+</a:component>
+<a:component name="Row">
+  <p>row</p>
+</a:component>
+<a:component name="Restricted" usage="list virtual">
+  <p>restricted</p>
+</a:component>
+<a:component name="Table">
+  <div><a:embed list virtual item-height="10" name="Rows" type="Row"></a:embed></div>
+</a:component>`,
+	}, func(dir string) {
+		p, order := newProcessor(t, checkMode)
+		if !p.generate(order, dir, output.WasmBackend) {
+			t.Fatalf("check failed: %q", diagnostics(&p.syms.Diagnostics))
+		}
+		code := string(p.overlay[filepath.Join("ui", "a.askew.go")])
+		for name, expected := range map[string]bool{
+			"VirtualPlainList": false, "VirtualRowList": true,
+			"VirtualRestrictedList": true, "VirtualTableList": false,
+			"PlainList": true, "OptionalRestricted": false,
+		} {
+			if actual := strings.Contains(code, "type "+name+" struct"); actual != expected {
+				t.Errorf("expected generation of %s to be %v", name, expected)
+			}
+		}
+	})
+}
+
+func TestKeywordHandlerName(t *testing.T) {
+	inProject(t, map[string]string{
+		"ui/a.askew": `<a:component name="A">
+  <a:handlers>select()</a:handlers>
+</a:component>
+<a:component name="B">
+  <a:controller>go(id int)</a:controller>
+</a:component>
+<a:component name="C">
+  <a:events>
+    func()
+  </a:events>
+</a:component>`,
+	}, func(dir string) {
+		p, order := newProcessor(t, checkMode)
+		if p.generate(order, dir, output.WasmBackend) {
+			t.Fatal("generate succeeded despite errors")
+		}
+		expectDiagnostics(t, &p.syms.Diagnostics,
+			"ui/a.askew:2:3: unable to parse `select()`: Go keyword cannot be used as name: select",
+			"ui/a.askew:5:3: unable to parse `go(id int)`: Go keyword cannot be used as name: go",
+			"ui/a.askew:8:3: unable to parse `\n    func()\n  `: Go keyword cannot be used as name: func")
+	})
+}
+
+func TestTextSyntaxErrors(t *testing.T) {
+	inProject(t, map[string]string{
+		"ui/a.askew": `<a:component name="A">
+  <a:handlers>
+    ok()
+    bad(x int
+  </a:handlers>
+</a:component>
+<a:component name="B">
+  <a:data>
+    Count int
+    Name &amp; string
+  </a:data>
+</a:component>`,
+	}, func(dir string) {
+		p, order := newProcessor(t, checkMode)
+		if p.generate(order, dir, output.WasmBackend) {
+			t.Fatal("generate succeeded despite errors")
+		}
+		expectDiagnostics(t, &p.syms.Diagnostics,
+			"ui/a.askew:4:14: unable to parse `\n    ok()\n    bad(x int\n  `: parse error near '\\n'",
+			"ui/a.askew:10:10: unable to parse fields: parse error near '&'")
+	})
+}
+
+// TestEventsExample type-checks the example from the documentation of
+// <a:events>, where a handler of Item emits the event that Page captures.
+func TestEventsExample(t *testing.T) {
+	inProject(t, withRuntime(t, map[string]string{
+		"ui/a.askew": `<a:component name="Item" params="var id int">
+  <a:handlers>
+    choose()
+  </a:handlers>
+  <a:events>
+    selected(id int)
+  </a:events>
+  <li a:capture="click:choose()">…</li>
+</a:component>
+
+<a:component name="Page">
+  <a:handlers>
+    show(id int)
+  </a:handlers>
+  <ul a:capture="selected:show(id=detail(id))">
+    <a:embed name="Items" type="Item" list></a:embed>
+  </ul>
+</a:component>`,
+		"ui/a.go": `package ui
+
+func (o *Item) choose() {
+	o.EmitSelected(o.id)
+}
+
+func (o *Page) show(id int) {}
+`,
+	}), func(dir string) {
+		p, order := newProcessor(t, checkMode)
+		p.typeCheck = true
+		if !p.generate(order, dir, output.WasmBackend) {
+			t.Fatalf("check failed: %q", diagnostics(&p.syms.Diagnostics))
+		}
+	})
+}
+
+func TestElseErrors(t *testing.T) {
+	inProject(t, map[string]string{
+		"ui/a.askew": `<a:component name="A">
+  <p a:else>orphan</p>
+</a:component>
+<a:component name="B">
+  <p a:if="true">a</p>
+  <div></div>
+  <p a:else-if="false">b</p>
+</a:component>
+<a:component name="C">
+  <p a:if="true" a:else>c</p>
+</a:component>
+<a:component name="D">
+  <p a:if="true">d</p>
+  <p a:else-if="true" a:else>e</p>
+</a:component>
+<a:component name="E">
+  <p a:if="true">f</p>
+  <p a:else>g</p>
+  <p a:else>h</p>
+</a:component>`,
+	}, func(dir string) {
+		p, order := newProcessor(t, checkMode)
+		if p.generate(order, dir, output.WasmBackend) {
+			t.Fatal("generate succeeded despite errors")
+		}
+		expectDiagnostics(t, &p.syms.Diagnostics,
+			"ui/a.askew:2:3: a:else-if and a:else must follow an element with a:if or a:else-if",
+			"ui/a.askew:7:3: a:else-if and a:else must follow an element with a:if or a:else-if",
+			"ui/a.askew:10:3: cannot have a:if and a:else-if or a:else on same element",
+			"ui/a.askew:14:3: cannot have a:else-if and a:else on same element",
+			"ui/a.askew:19:3: a:else-if and a:else must follow an element with a:if or a:else-if")
+	})
+}
+
+func TestSwitchErrors(t *testing.T) {
+	inProject(t, map[string]string{
+		"ui/a.askew": `<a:component name="A">
+  <div a:switch="1">
+    <p a:case="1">a</p>
+    <p>b</p>
+  </div>
+</a:component>
+<a:component name="B">
+  <div a:switch="1">
+    <p a:default>c</p>
+    <p a:default>d</p>
+  </div>
+</a:component>
+<a:component name="C">
+  <p a:case="1">e</p>
+</a:component>
+<a:component name="D">
+  <div a:switch="1" a:if="true"></div>
+</a:component>
+<a:component name="E">
+  <div a:switch="1">
+    <p a:case="1" a:default>f</p>
+  </div>
+</a:component>`,
+	}, func(dir string) {
+		p, order := newProcessor(t, checkMode)
+		if p.generate(order, dir, output.WasmBackend) {
+			t.Fatal("generate succeeded despite errors")
+		}
+		expectDiagnostics(t, &p.syms.Diagnostics,
+			"ui/a.askew:4:5: children of a:switch must have a:case or a:default",
+			"ui/a.askew:10:5: duplicate a:default",
+			"ui/a.askew:14:3: a:case and a:default must be on a child of an element with a:switch",
+			"ui/a.askew:17:3: cannot have a:switch together with a:if, a:for, a:else-if or a:else",
+			"ui/a.askew:21:5: cannot have a:case and a:default on same element")
+	})
+}
+
+func TestLiveIfErrors(t *testing.T) {
+	inProject(t, map[string]string{
+		"ui/a.askew": `<a:component name="A">
+  <div a:if="true">
+    <p a:if.live="askew.NewBoolSignal(true)">a</p>
+  </div>
+</a:component>
+<a:component name="B">
+  <div>
+    <p a:for="i := range []int{}" a:if.live="askew.NewBoolSignal(true)">b</p>
+  </div>
+</a:component>
+<a:component name="C">
+  <div a:switch>
+    <p a:case="true" a:if.live="askew.NewBoolSignal(true)">c</p>
+  </div>
+</a:component>
+<a:component name="D"><p a:if.live="askew.NewBoolSignal(true)">d</p></a:component>
+<a:component name="E">
+  <div>
+    <p a:if.live="askew.NewBoolSignal(true)"><a:embed type="D" name="d"></a:embed></p>
+  </div>
+</a:component>`,
+	}, func(dir string) {
+		p, order := newProcessor(t, checkMode)
+		if p.generate(order, dir, output.WasmBackend) {
+			t.Fatal("generate succeeded despite errors")
+		}
+		expectDiagnostics(t, &p.syms.Diagnostics,
+			"ui/a.askew:3:5: cannot use a:if.live inside a:if, a:for or a:switch",
+			"ui/a.askew:8:5: cannot have a:if.live together with a:if, a:for, a:for.live, a:else-if, a:else or a:switch",
+			"ui/a.askew:13:5: cannot use a:if.live inside a:if, a:for or a:switch",
+			"ui/a.askew:16:23: a:if.live cannot be on the first or last node of a component",
+			"ui/a.askew:19:46: cannot embed inside a:if.live")
+	})
+}
+
+func TestLiveForErrors(t *testing.T) {
+	inProject(t, map[string]string{
+		"ui/a.askew": `<a:component name="A">
+  <div a:if="true">
+    <p a:for.live="i := range []int{}">a</p>
+  </div>
+</a:component>
+<a:component name="B">
+  <div>
+    <p a:if="true" a:for.live="i := range []int{}">b</p>
+  </div>
+</a:component>
+<a:component name="C"><p a:for.live="i := range []int{}">c</p></a:component>
+<a:component name="D">
+  <div a:if.live="askew.NewBoolSignal(true)">
+    <p a:for.live="i := range []int{}">d</p>
+  </div>
+</a:component>
+<a:component name="E">
+  <div>
+    <p a:for.live="i := range []int{}"><a:embed type="C" name="c"></a:embed></p>
+  </div>
+</a:component>
+<a:component name="F">
+  <div>
+    <p a:for.live="i := range">f</p>
+  </div>
+</a:component>`,
+	}, func(dir string) {
+		p, order := newProcessor(t, checkMode)
+		if p.generate(order, dir, output.WasmBackend) {
+			t.Fatal("generate succeeded despite errors")
+		}
+		expectDiagnostics(t, &p.syms.Diagnostics,
+			"ui/a.askew:3:5: cannot use a:for.live inside a:if, a:for or a:switch",
+			"ui/a.askew:8:5: cannot have a:for.live together with a:if, a:for, a:else-if, a:else, a:switch, a:case or a:default",
+			"ui/a.askew:11:23: a:for.live cannot be on the first or last node of a component",
+			"ui/a.askew:14:5: cannot use a:for.live inside a:if.live",
+			"ui/a.askew:19:40: cannot embed inside a:for.live",
+			"ui/a.askew:24:25: invalid for.live: parse error near 'r'")
+	})
+}
+
+func TestBlockAssignmentErrors(t *testing.T) {
+	inProject(t, map[string]string{
+		"ui/a.askew": `<a:component name="A">
+  <form>
+    <input name="x" a:if="true" a:assign="form(x) = 1">
+  </form>
+</a:component>`,
+	}, func(dir string) {
+		p, order := newProcessor(t, checkMode)
+		if p.generate(order, dir, output.WasmBackend) {
+			t.Fatal("generate succeeded despite errors")
+		}
+		expectDiagnostics(t, &p.syms.Diagnostics,
+			"ui/a.askew:3:5: form() binding inside a:if, a:for or a:switch must be inside the <form> as well")
+	})
+}
+
+// TestMatrixExamples type-checks the Matrix components from the documentation
+// of bindings and captures inside a:for, including the nested slice of
+// bindings and a handler receiving the loop variables.
+func TestMatrixExamples(t *testing.T) {
+	inProject(t, withRuntime(t, map[string]string{
+		"ui/a.askew": `<a:component name="Matrix" params="rows [][]string">
+  <table>
+    <tr a:for="_, row := range rows">
+      <td a:for="_, cell := range row" a:bindings="prop(textContent):Cells" a:assign="prop(textContent) = cell"></td>
+    </tr>
+  </table>
+</a:component>
+<a:component name="ClickMatrix" params="rows [][]string">
+  <a:handlers>choose(row int, col int)</a:handlers>
+  <table>
+    <tr a:for="i, row := range rows">
+      <td a:for="j, cell := range row" a:capture="click:choose(go(i), go(j))" a:assign="prop(textContent) = cell"></td>
+    </tr>
+  </table>
+</a:component>`,
+		"ui/a.go": `package ui
+
+import askew "github.com/flyx/askew/runtime"
+
+func (o *Matrix) cell(row, col int) askew.StringValue {
+	return o.Cells[row][col]
+}
+
+func (o *ClickMatrix) choose(row int, col int) {}
+`,
+	}), func(dir string) {
+		p, order := newProcessor(t, checkMode)
+		p.typeCheck = true
+		if !p.generate(order, dir, output.WasmBackend) {
+			t.Fatalf("check failed: %q", diagnostics(&p.syms.Diagnostics))
+		}
+	})
+}
+
+func TestMissingImport(t *testing.T) {
+	inProject(t, withRuntime(t, map[string]string{
+		"ui/a.askew": `<a:component name="A" params="n int">
+  <p a:assign="prop(textContent) = strconv.Itoa(n)"></p>
+</a:component>`,
+	}), func(dir string) {
+		p, order := newProcessor(t, checkMode)
+		p.typeCheck = true
+		if !p.generate(order, dir, output.WasmBackend) {
+			t.Fatalf("check failed: %q", diagnostics(&p.syms.Diagnostics))
+		}
+		if code := string(p.overlay[filepath.Join("ui", "a.askew.go")]); !strings.Contains(code, `"strconv"`) {
+			t.Errorf("missing import has not been added:\n%s", code)
+		}
+	})
+}
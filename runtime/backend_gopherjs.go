@@ -1,3 +1,4 @@
+//go:build js && !wasm
 // +build js,!wasm
 
 package askew
@@ -12,6 +13,6 @@ func equals(left, right js.Value) bool {
 // This is required if your main() entry point would exit; otherwise the
 // handlers for DOM events wouldn't be called.
 //
-// Does nothing when using the GopherJS backend.
+// Does nothing when using the GopherJS backend or the headless DOM.
 func KeepAlive() {
 }
@@ -0,0 +1,38 @@
+//go:build !js
+// +build !js
+
+package askew
+
+import (
+	js "github.com/flyx/askew/runtime/dom"
+)
+
+func equals(left, right js.Value) bool {
+	return left.Equal(right)
+}
+
+// filtered returns the listener that calls fn for the events matching f.
+// If once is true, the listener is removed after the first matching event.
+// The returned func releases the listener.
+func filtered(fn js.Value, f *EventFilter, once, capture bool) (js.Value, func()) {
+	var handler js.Func
+	handler = js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		if !f.matches(args[0]) {
+			return nil
+		}
+		if once {
+			args[0].Get("currentTarget").Call("removeEventListener",
+				args[0].Get("type"), handler, map[string]interface{}{"capture": capture})
+		}
+		return fn.Invoke(args[0])
+	})
+	return handler.Value, handler.Release
+}
+
+// KeepAlive sends the main thread to sleep if compiled for WASM.
+// This is required if your main() entry point would exit; otherwise the
+// handlers for DOM events wouldn't be called.
+//
+// Does nothing when using the GopherJS backend or the headless DOM.
+func KeepAlive() {
+}
@@ -0,0 +1,61 @@
+//go:build js
+// +build js
+
+package askew
+
+import "syscall/js"
+
+// compiled contains the JavaScript functions created by compile.
+var compiled = make(map[string]js.Value)
+
+// compile returns a JavaScript function with the given parameters and body.
+// Functions are created when they are first needed, so that pages with a
+// Content Security Policy forbidding eval only fail if they use them.
+func compile(body string, params ...string) js.Value {
+	fn, ok := compiled[body]
+	if !ok {
+		args := make([]interface{}, 0, len(params)+1)
+		for _, p := range params {
+			args = append(args, p)
+		}
+		fn = js.Global().Get("Function").New(append(args, body)...)
+		compiled[body] = fn
+	}
+	return fn
+}
+
+// eventFilterSource is the body of a JavaScript function that creates a
+// function calling fn with the event it receives if the event matches the
+// given keys, buttons and modifiers, like EventFilter.matches. If once is
+// true, the created function removes itself after fn has been called.
+const eventFilterSource = `
+	return function handler(e) {
+		var ignoreShift = false;
+		if (keys.length > 0) {
+			if (typeof e.key !== "string") return;
+			var lower = e.key.toLowerCase(), found = false;
+			for (var i = 0; i < keys.length && !found; i++) {
+				found = keys[i] === e.key ||
+					(Array.from(keys[i]).length === 1 && keys[i].toLowerCase() === lower);
+			}
+			if (!found) return;
+			ignoreShift = !mods[1] && Array.from(e.key).length === 1;
+		}
+		if (buttons.length > 0 && buttons.indexOf(e.button) < 0) return;
+		if (!!e.ctrlKey !== mods[0] || (!ignoreShift && !!e.shiftKey !== mods[1]) ||
+			!!e.altKey !== mods[2] || !!e.metaKey !== mods[3]) return;
+		if (once) e.currentTarget.removeEventListener(e.type, handler, {capture: capture});
+		return fn.apply(this, arguments);
+	};`
+
+// filtered returns the listener that calls fn for the events matching f.
+// If once is true, the listener is removed after the first matching event.
+// The returned func releases the listener.
+//
+// The listener is a JavaScript function, so that events that do not match do
+// not cause a call into Go.
+func filtered(fn js.Value, f *EventFilter, once, capture bool) (js.Value, func()) {
+	return compile(eventFilterSource, "fn", "keys", "buttons", "mods", "once",
+		"capture").Invoke(fn, f.jsKeys(), f.jsButtons(), f.modifiers(), once,
+		capture), func() {}
+}
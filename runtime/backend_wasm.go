@@ -1,4 +1,5 @@
-// +build wasm
+//go:build js && wasm
+// +build js,wasm
 
 package askew
 
@@ -12,7 +13,7 @@ func equals(left, right js.Value) bool {
 // This is required if your main() entry point would exit; otherwise the
 // handlers for DOM events wouldn't be called.
 //
-// Does nothing when using the GopherJS backend.
+// Does nothing when using the GopherJS backend or the headless DOM.
 func KeepAlive() {
 	<-make(chan bool)
 }
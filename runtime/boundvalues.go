@@ -1,6 +1,6 @@
 package askew
 
-import "syscall/js"
+import js "github.com/flyx/askew/runtime/dom"
 
 // BoundValue is the interface for retrieving and setting bound values in
 // the HTML DOM.
@@ -243,6 +243,30 @@ func (bev *BoundEventValue) set(value interface{}) {
 	panic("BoundEvent doesn't support set()")
 }
 
+// BoundEventDetail implements BoundValue as a reference to an item of the
+// `detail` of a captured CustomEvent, as dispatched by ComponentData.Emit.
+type BoundEventDetail struct {
+	val js.Value
+}
+
+// BoundEventDetailAt returns a BoundEventDetail for the item with the given
+// name in the detail of the given event.
+func BoundEventDetailAt(e js.Value, name string) *BoundEventDetail {
+	detail := e.Get("detail")
+	if detail.Type() != js.TypeObject {
+		panic("event `" + e.Get("type").String() + "` has no detail object")
+	}
+	return &BoundEventDetail{val: detail.Get(name)}
+}
+
+func (bed *BoundEventDetail) get() js.Value {
+	return bed.val
+}
+
+func (bed *BoundEventDetail) set(value interface{}) {
+	panic("BoundEventDetail doesn't support set()")
+}
+
 // BoundSelf implements BoundValue as a reference to a DOM node.
 // It retrieves the linked node when getting its value, and replaces it with the
 // given node when setting a value.
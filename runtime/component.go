@@ -1,6 +1,10 @@
 package askew
 
-import "syscall/js"
+import (
+	"time"
+
+	js "github.com/flyx/askew/runtime/dom"
+)
 
 // ComponentData holds the content of an instance of a <a:component>.
 //
@@ -23,13 +27,51 @@ import "syscall/js"
 // to use it directly if the website is defined with a skeleton.
 type ComponentData struct {
 	fragment, first, last js.Value
+	listeners             []listener
+	subscriptions         []func()
+}
+
+// listener is an event listener registered via Listen.
+type listener struct {
+	target js.Value
+	event  string
+	fn     js.Func
+	// handler is the function registered at target, which differs from fn if
+	// the listener has a filter.
+	handler js.Value
+	capture bool
+	// release releases the wrapper around fn when the listener is removed.
+	release func()
+}
+
+// ListenerOptions are the options of an event listener.
+type ListenerOptions struct {
+	// Once removes the listener after it has been called once.
+	Once bool
+	// Passive tells the browser that the listener never prevents the default
+	// action, which can improve scrolling performance.
+	Passive bool
+	// Capture calls the listener in the capturing phase, before listeners of
+	// descendants of the target are called.
+	Capture bool
+	// Filter, if not nil, restricts the events the listener is called for.
+	Filter *EventFilter
 }
 
 // Init initializes the ComponentData with the given DocumentFragment node.
 // Previous data is discarded. The Component will be in initial state afterwards.
 func (cd *ComponentData) Init(frag js.Value) {
+	cd.releaseListeners()
 	cd.fragment, cd.first, cd.last = frag, js.Value{}, js.Value{}
+}
 
+// Hydrate initializes the ComponentData with server-rendered nodes that are
+// already part of the document, from first to last. Previous data is
+// discarded. The Component will be in inserted state afterwards.
+func (cd *ComponentData) Hydrate(first, last js.Value) {
+	cd.releaseListeners()
+	cd.fragment = js.Global().Get("document").Call("createDocumentFragment")
+	cd.first, cd.last = first, last
 }
 
 // DoInsert inserts the component into the given parent before the node before or at the end if before is nil.
@@ -70,7 +112,9 @@ func (cd *ComponentData) DoExtract() {
 }
 
 // DoDestroy removes the component from the DOM if it is currently inserted.
-// Then it resets all links to the nodes, letting them eventually be garbage
+// Then it removes and releases the event listeners added via Listen, cancels
+// the subscriptions added via Observe and the pending calls of Limit, and
+// resets all links to the nodes, letting them eventually be garbage
 // collected. Afterwards, the component is is destroyed state and must not be
 // used anymore.
 func (cd *ComponentData) DoDestroy() {
@@ -85,9 +129,128 @@ func (cd *ComponentData) DoDestroy() {
 			cur = next
 		}
 	}
+	cd.releaseListeners()
 	cd.fragment, cd.first, cd.last = js.Undefined(), js.Undefined(), js.Undefined()
 }
 
+// Listen adds fn as listener for the given event to target, which must be a
+// node of the component. The listener is removed and fn is released when
+// the component is destroyed.
+func (cd *ComponentData) Listen(target js.Value, event string, fn js.Func) {
+	target.Call("addEventListener", event, fn)
+	cd.listeners = append(cd.listeners, listener{target, event, fn, fn.Value, false, nil})
+}
+
+// ListenWith adds fn as listener like Listen, with the given options.
+func (cd *ComponentData) ListenWith(target js.Value, event string, fn js.Func,
+	options ListenerOptions) {
+	handler, once := fn.Value, options.Once
+	var release func()
+	if options.Filter != nil {
+		// the browser would remove a listener with `once` after the first event,
+		// even if the filter does not pass it on to fn.
+		handler, release = filtered(handler, options.Filter, once, options.Capture)
+		once = false
+	}
+	target.Call("addEventListener", event, handler, map[string]interface{}{
+		"once": once, "passive": options.Passive, "capture": options.Capture})
+	cd.listeners = append(cd.listeners,
+		listener{target, event, fn, handler, options.Capture, release})
+}
+
+// Limit returns a func that calls the funcs given to it at most once per wait.
+// If debounce is true, the last func is called once the returned func has not
+// been called for wait. Otherwise, the first func is called immediately and
+// afterwards the last func given during each interval at its end. Delayed
+// calls happen in their own goroutine and are cancelled when the component is
+// destroyed.
+//
+// This is the backend for the debounce and throttle tags of a:capture. Unlike
+// ListenWith, it lets the caller read the event's values when the event
+// occurs and only delay the call of the handler.
+func (cd *ComponentData) Limit(wait time.Duration, debounce bool) func(fn func()) {
+	l := &limiter{wait: wait, debounce: debounce}
+	cd.subscriptions = append(cd.subscriptions, l.cancel)
+	return l.call
+}
+
+// Emit dispatches a bubbling CustomEvent with the given name at the first
+// element of the component. The given detail becomes the event's `detail`.
+// Nothing happens if the component has no element.
+//
+// This is the backend for the Emit methods generated for <a:events>.
+func (cd *ComponentData) Emit(event string, detail map[string]interface{}) {
+	last := cd.Last()
+	for cur := cd.First(); !equals(cur, js.Null()); cur = cur.Get("nextSibling") {
+		if cur.Get("nodeType").Int() == 1 {
+			cur.Call("dispatchEvent", js.Global().Get("CustomEvent").New(event,
+				map[string]interface{}{"bubbles": true, "detail": detail}))
+			return
+		}
+		if equals(cur, last) {
+			return
+		}
+	}
+}
+
+// IsOwnEvent returns true if the given event has been dispatched to the node
+// that is currently handling it, i.e. not to one of its descendants.
+func IsOwnEvent(event js.Value) bool {
+	return equals(event.Get("target"), event.Get("currentTarget"))
+}
+
+// Observe assigns the current value of src to target, and assigns it again
+// whenever src changes. The subscription is cancelled when the component is
+// destroyed.
+func (cd *ComponentData) Observe(src Observable, target BoundValue) {
+	target.set(src.Value())
+	cd.subscriptions = append(cd.subscriptions, src.Subscribe(func() {
+		target.set(src.Value())
+	}))
+}
+
+// Model binds target to src in both directions: Like with Observe, the value
+// of src is assigned to target. Additionally, src is updated from target
+// whenever node, which is the element containing target, receives an `input`
+// or `change` event. The value is not assigned back to target then, so that
+// e.g. the cursor in a text input stays in place.
+func (cd *ComponentData) Model(src Model, target BoundValue, node js.Value) {
+	updating := false
+	target.set(src.Value())
+	cd.subscriptions = append(cd.subscriptions, src.Subscribe(func() {
+		if !updating {
+			target.set(src.Value())
+		}
+	}))
+	for _, event := range []string{"input", "change"} {
+		cd.Listen(node, event, js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+			updating = true
+			src.Update(target)
+			updating = false
+			return nil
+		}))
+	}
+}
+
+// releaseListeners removes and releases all listeners added via Listen,
+// cancels all subscriptions added via Observe and the pending calls of the
+// funcs returned by Limit.
+func (cd *ComponentData) releaseListeners() {
+	for _, l := range cd.listeners {
+		l.target.Call("removeEventListener", l.event, l.handler,
+			map[string]interface{}{"capture": l.capture})
+		if l.release != nil {
+			l.release()
+		}
+		l.fn.Release()
+	}
+	cd.listeners = nil
+	for _, cancel := range cd.subscriptions {
+		cancel()
+	}
+	cd.subscriptions = nil
+}
+
 // Walk descends into the DocumentFragment's children using the given list of indexes.
 // This may only be done when the ComponentData is in initial state.
 func (cd *ComponentData) Walk(path ...int) js.Value {
@@ -105,6 +268,14 @@ func (cd *ComponentData) First() js.Value {
 	return cd.first
 }
 
+// Last returns the last DOM node in this component
+func (cd *ComponentData) Last() js.Value {
+	if equals(cd.first, js.Undefined()) {
+		return cd.fragment.Get("lastChild")
+	}
+	return cd.last
+}
+
 // DocumentFragment returns the DocumentFragment the component uses to store its contents
 // when it is in initial state.
 func (cd *ComponentData) DocumentFragment() js.Value {
@@ -0,0 +1,126 @@
+//go:build !js
+// +build !js
+
+package askew
+
+import (
+	"strings"
+	"testing"
+
+	js "github.com/flyx/askew/runtime/dom"
+)
+
+func keydown(target js.Value, key string) {
+	target.Call("dispatchEvent", js.Global().Get("KeyboardEvent").New("keydown",
+		map[string]interface{}{"key": key, "bubbles": true}))
+}
+
+// invokeReleased returns true if calling fn panics because it has been
+// released.
+func invokeReleased(fn js.Value) (released bool) {
+	defer func() {
+		if r := recover(); r != nil {
+			released = strings.Contains(r.(string), "released")
+		}
+	}()
+	fn.Invoke(js.Global().Get("KeyboardEvent").New("keydown"))
+	return false
+}
+
+func TestListenWithRelease(t *testing.T) {
+	js.Reset()
+	c := newTestComponent("a", "<input>")
+	input := c.cd.Walk(0)
+	calls := 0
+	fn := js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		calls++
+		return nil
+	})
+	c.cd.ListenWith(input, "keydown", fn, ListenerOptions{
+		Filter: &EventFilter{Keys: []string{"Enter"}}})
+	if len(c.cd.listeners) != 1 {
+		t.Fatalf("expected one listener, got %d", len(c.cd.listeners))
+	}
+	handler := c.cd.listeners[0].handler
+	keydown(input, "a")
+	keydown(input, "Enter")
+	if calls != 1 {
+		t.Errorf("expected one call before destruction, got %d", calls)
+	}
+
+	c.Destroy()
+	keydown(input, "Enter")
+	if calls != 1 {
+		t.Errorf("listener called after destruction")
+	}
+	if c.cd.listeners != nil {
+		t.Error("listeners not reset")
+	}
+	if !invokeReleased(handler) {
+		t.Error("filter wrapper has not been released")
+	}
+	if !invokeReleased(fn.Value) {
+		t.Error("listener has not been released")
+	}
+}
+
+func TestObserve(t *testing.T) {
+	js.Reset()
+	c := newTestComponent("a", "<p>initial</p>")
+	title := NewStringSignal("title")
+	p := c.cd.Walk(0)
+	c.cd.Observe(title, BoundPropertyAt(p, "textContent"))
+	if s := p.Get("textContent").String(); s != "title" {
+		t.Errorf("initial value not assigned: %s", s)
+	}
+	title.Set("changed")
+	if s := p.Get("textContent").String(); s != "changed" {
+		t.Errorf("change not assigned: %s", s)
+	}
+	c.Destroy()
+	title.Set("destroyed")
+	if s := p.Get("textContent").String(); s != "changed" {
+		t.Errorf("destroyed component still observes: %s", s)
+	}
+}
+
+func input(target js.Value, value interface{}) {
+	target.Set("value", value)
+	target.Call("dispatchEvent", js.Global().Get("Event").New("input",
+		map[string]interface{}{"bubbles": true}))
+}
+
+func TestModel(t *testing.T) {
+	js.Reset()
+	c := newTestComponent("a", `<form><input name="age"><input name="text"></form>`)
+	form := c.cd.Walk(0)
+	age, text := NewIntSignal(3), NewStringSignal("a")
+	c.cd.Model(age, BoundFormValueAt(form, "age", false), form)
+	ageInput := form.Get("elements").Get("age")
+	textInput := form.Get("elements").Get("text")
+	c.cd.Model(text, BoundPropertyAt(textInput, "value"), textInput)
+	if ageInput.Get("value").String() != "3" || textInput.Get("value").String() != "a" {
+		t.Error("initial values not assigned")
+	}
+
+	notified := 0
+	age.Subscribe(func() { notified++ })
+	input(ageInput, "42")
+	if age.Get() != 42 || notified != 1 {
+		t.Errorf("signal not updated from form: %d (%d notifications)", age.Get(), notified)
+	}
+	input(textInput, "b")
+	if text.Get() != "b" {
+		t.Errorf("signal not updated from input: %s", text.Get())
+	}
+	text.Set("c")
+	if s := textInput.Get("value").String(); s != "c" {
+		t.Errorf("input not updated from signal: %s", s)
+	}
+
+	c.Destroy()
+	input(textInput, "d")
+	if text.Get() != "c" {
+		t.Error("destroyed component still updates the signal")
+	}
+}
@@ -0,0 +1,14 @@
+// Package dom provides the JavaScript values the askew runtime and the
+// generated code operate on.
+//
+// When compiling for the browser (GOOS=js), it simply forwards to
+// syscall/js. Otherwise, it implements an in-memory DOM in pure Go, so that
+// components can be instantiated and tested with `go test` without a
+// browser. The headless DOM supports the parts of the DOM API used by askew,
+// along with common operations used in handlers and tests: tree
+// manipulation, attributes, classList, dataset, style, form controls,
+// innerHTML, simple CSS selectors, and event dispatching.
+//
+// Code that should run in both environments must use this package instead of
+// syscall/js.
+package dom
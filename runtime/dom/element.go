@@ -0,0 +1,1020 @@
+//go:build !js
+// +build !js
+
+package dom
+
+import (
+	"strconv"
+	"strings"
+)
+
+// getElement implements the properties and methods of elements.
+func (n *node) getElement(name string) (Value, bool) {
+	if attr, ok := stringAttrs[name]; ok {
+		return ValueOf(n.attr(attr)), true
+	}
+	if attr, ok := boolAttrs[name]; ok {
+		return ValueOf(n.hasAttr(attr)), true
+	}
+	switch name {
+	case "tagName":
+		return ValueOf(n.nodeName()), true
+	case "localName":
+		return ValueOf(n.tag), true
+	case "namespaceURI":
+		return ValueOf(namespaceURI(n.namespace)), true
+	case "innerHTML":
+		return ValueOf(n.innerHTML()), true
+	case "outerHTML":
+		return ValueOf(render(n)), true
+	case "innerText", "outerText":
+		return ValueOf(n.textContent()), true
+	case "content":
+		if n.content != nil {
+			return Value{n.content}, true
+		}
+	case "classList":
+		if n.classList == nil {
+			n.classList = &tokenList{n: n, attr: "class"}
+		}
+		return Value{n.classList}, true
+	case "dataset":
+		if n.dataset == nil {
+			n.dataset = &stringMap{n: n}
+		}
+		return Value{n.dataset}, true
+	case "style":
+		if n.style == nil {
+			n.style = &style{n: n}
+		}
+		return Value{n.style}, true
+	case "nextElementSibling":
+		for c := n.next; c != nil; c = c.next {
+			if c.typ == elementNode {
+				return Value{c}, true
+			}
+		}
+		return Null(), true
+	case "previousElementSibling":
+		for c := n.prev; c != nil; c = c.prev {
+			if c.typ == elementNode {
+				return Value{c}, true
+			}
+		}
+		return Null(), true
+	case "getAttribute":
+		return method(func(args []Value) Value {
+			if value, ok := n.getAttr(toString(arg(args, 0))); ok {
+				return ValueOf(value)
+			}
+			return Null()
+		}), true
+	case "setAttribute":
+		return method(func(args []Value) Value {
+			n.setAttr(toString(arg(args, 0)), toString(arg(args, 1)))
+			return Undefined()
+		}), true
+	case "removeAttribute":
+		return method(func(args []Value) Value {
+			n.removeAttr(toString(arg(args, 0)))
+			return Undefined()
+		}), true
+	case "hasAttribute":
+		return method(func(args []Value) Value {
+			return ValueOf(n.hasAttr(toString(arg(args, 0))))
+		}), true
+	case "toggleAttribute":
+		return method(func(args []Value) Value {
+			name := toString(arg(args, 0))
+			value := !n.hasAttr(name)
+			if len(args) > 1 {
+				value = args[1].Truthy()
+			}
+			n.setBoolAttr(name, value)
+			return ValueOf(value)
+		}), true
+	case "getAttributeNames":
+		return method(func(args []Value) Value {
+			names := make([]interface{}, len(n.attrs))
+			for i, a := range n.attrs {
+				names[i] = a.key
+				if a.namespace != "" {
+					names[i] = a.namespace + ":" + a.key
+				}
+			}
+			return ValueOf(names)
+		}), true
+	case "matches":
+		return method(func(args []Value) Value {
+			return ValueOf(parseSelector(toString(arg(args, 0))).matches(n))
+		}), true
+	case "closest":
+		return method(func(args []Value) Value {
+			sel := parseSelector(toString(arg(args, 0)))
+			for cur := n; cur != nil && cur.typ == elementNode; cur = cur.parent {
+				if sel.matches(cur) {
+					return Value{cur}
+				}
+			}
+			return Null()
+		}), true
+	case "click":
+		return method(func(args []Value) Value {
+			n.click()
+			return Undefined()
+		}), true
+	case "focus", "blur", "scrollIntoView":
+		return method(func(args []Value) Value {
+			return Undefined()
+		}), true
+	}
+	if n.namespace == "" {
+		return n.getFormProperty(name)
+	}
+	return Value{}, false
+}
+
+// setElement implements setting properties of elements. Returns false if
+// the property is not part of the DOM API.
+func (n *node) setElement(name string, value Value) bool {
+	if attr, ok := stringAttrs[name]; ok {
+		n.setAttr(attr, toString(value))
+		return true
+	}
+	if attr, ok := boolAttrs[name]; ok {
+		n.setBoolAttr(attr, value.Truthy())
+		return true
+	}
+	switch name {
+	case "innerHTML":
+		n.setInnerHTML(stringOrEmpty(value))
+		return true
+	case "outerHTML":
+		if n.parent == nil {
+			throw("NoModificationAllowedError", "the element has no parent")
+		}
+		context := n.parent
+		if context.typ != elementNode {
+			context = newElement("body", "")
+		}
+		frag := parseFragment(stringOrEmpty(value), context)
+		n.parent.insertBefore(frag, n)
+		n.detach()
+		return true
+	case "innerText", "outerText":
+		n.setTextContent(stringOrEmpty(value))
+		return true
+	case "classList":
+		n.setAttr("class", toString(value))
+		return true
+	case "style":
+		n.setAttr("style", toString(value))
+		return true
+	}
+	if n.namespace == "" {
+		return n.setFormProperty(name, value)
+	}
+	return false
+}
+
+func namespaceURI(namespace string) string {
+	switch namespace {
+	case "":
+		return "http://www.w3.org/1999/xhtml"
+	case "svg":
+		return "http://www.w3.org/2000/svg"
+	case "math":
+		return "http://www.w3.org/1998/Math/MathML"
+	}
+	return namespace
+}
+
+// tokenList is a DOMTokenList backed by an attribute.
+type tokenList struct {
+	n    *node
+	attr string
+}
+
+func (l *tokenList) className() string {
+	return "DOMTokenList"
+}
+
+func (l *tokenList) tokens() []string {
+	var ret []string
+	for _, t := range strings.Fields(l.n.attr(l.attr)) {
+		if !contains(ret, t) {
+			ret = append(ret, t)
+		}
+	}
+	return ret
+}
+
+func contains(list []string, s string) bool {
+	for _, item := range list {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+func (l *tokenList) update(tokens []string) {
+	l.n.setAttr(l.attr, strings.Join(tokens, " "))
+}
+
+func without(list []string, s string) []string {
+	var ret []string
+	for _, item := range list {
+		if item != s {
+			ret = append(ret, item)
+		}
+	}
+	return ret
+}
+
+func validToken(t string) string {
+	if t == "" {
+		throw("SyntaxError", "the token must not be empty")
+	}
+	if strings.ContainsAny(t, " \t\n\r\f") {
+		throw("InvalidCharacterError", "the token must not contain whitespace")
+	}
+	return t
+}
+
+func (l *tokenList) get(name string) Value {
+	switch name {
+	case "length":
+		return ValueOf(len(l.tokens()))
+	case "value":
+		return ValueOf(l.n.attr(l.attr))
+	case "item":
+		return method(func(args []Value) Value {
+			ret := l.index(int(toNumber(arg(args, 0))))
+			if ret.IsUndefined() {
+				return Null()
+			}
+			return ret
+		})
+	case "contains":
+		return method(func(args []Value) Value {
+			return ValueOf(contains(l.tokens(), toString(arg(args, 0))))
+		})
+	case "add":
+		return method(func(args []Value) Value {
+			tokens := l.tokens()
+			for _, a := range args {
+				if t := validToken(toString(a)); !contains(tokens, t) {
+					tokens = append(tokens, t)
+				}
+			}
+			l.update(tokens)
+			return Undefined()
+		})
+	case "remove":
+		return method(func(args []Value) Value {
+			tokens := l.tokens()
+			for _, a := range args {
+				tokens = without(tokens, validToken(toString(a)))
+			}
+			if l.n.hasAttr(l.attr) {
+				l.update(tokens)
+			}
+			return Undefined()
+		})
+	case "toggle":
+		return method(func(args []Value) Value {
+			t := validToken(toString(arg(args, 0)))
+			tokens := l.tokens()
+			present := contains(tokens, t)
+			want := !present
+			if len(args) > 1 && !args[1].IsUndefined() {
+				want = args[1].Truthy()
+			}
+			if want && !present {
+				l.update(append(tokens, t))
+			} else if !want && present {
+				l.update(without(tokens, t))
+			}
+			return ValueOf(want)
+		})
+	case "replace":
+		return method(func(args []Value) Value {
+			old := validToken(toString(arg(args, 0)))
+			repl := validToken(toString(arg(args, 1)))
+			tokens := l.tokens()
+			if !contains(tokens, old) {
+				return ValueOf(false)
+			}
+			var ret []string
+			for _, t := range tokens {
+				if t == old {
+					t = repl
+				}
+				if !contains(ret, t) {
+					ret = append(ret, t)
+				}
+			}
+			l.update(ret)
+			return ValueOf(true)
+		})
+	case "toString":
+		return method(func(args []Value) Value {
+			return ValueOf(l.n.attr(l.attr))
+		})
+	}
+	return Undefined()
+}
+
+func (l *tokenList) set(name string, value Value) {
+	if name == "value" {
+		l.n.setAttr(l.attr, toString(value))
+	}
+}
+
+func (l *tokenList) index(i int) Value {
+	tokens := l.tokens()
+	if i < 0 || i >= len(tokens) {
+		return Undefined()
+	}
+	return ValueOf(tokens[i])
+}
+
+func (l *tokenList) setIndex(i int, value Value) {}
+
+// camelToKebab converts "fooBar" to "foo-bar".
+func camelToKebab(name string) string {
+	var b strings.Builder
+	for _, r := range name {
+		if r >= 'A' && r <= 'Z' {
+			b.WriteByte('-')
+			r += 'a' - 'A'
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// kebabToCamel converts "foo-bar" to "fooBar".
+func kebabToCamel(name string) string {
+	var b strings.Builder
+	upper := false
+	for _, r := range name {
+		if r == '-' {
+			upper = true
+			continue
+		}
+		if upper && r >= 'a' && r <= 'z' {
+			r -= 'a' - 'A'
+		}
+		upper = false
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// stringMap is the DOMStringMap of an element's data-* attributes.
+type stringMap struct {
+	n *node
+}
+
+func (m *stringMap) className() string {
+	return "DOMStringMap"
+}
+
+func (m *stringMap) get(name string) Value {
+	if value, ok := m.n.getAttr("data-" + camelToKebab(name)); ok {
+		return ValueOf(value)
+	}
+	return Undefined()
+}
+
+func (m *stringMap) set(name string, value Value) {
+	m.n.setAttr("data-"+camelToKebab(name), toString(value))
+}
+
+func (m *stringMap) delete(name string) {
+	m.n.removeAttr("data-" + camelToKebab(name))
+}
+
+// style is the CSSStyleDeclaration of an element, backed by its style
+// attribute.
+type style struct {
+	n *node
+}
+
+func (s *style) className() string {
+	return "CSSStyleDeclaration"
+}
+
+type declaration struct {
+	property, value string
+}
+
+func (s *style) declarations() []declaration {
+	var ret []declaration
+	for _, item := range strings.Split(s.n.attr("style"), ";") {
+		colon := strings.IndexByte(item, ':')
+		if colon == -1 {
+			continue
+		}
+		property := strings.ToLower(strings.TrimSpace(item[:colon]))
+		value := strings.TrimSpace(item[colon+1:])
+		if property == "" || value == "" {
+			continue
+		}
+		replaced := false
+		for i := range ret {
+			if ret[i].property == property {
+				ret[i].value, replaced = value, true
+			}
+		}
+		if !replaced {
+			ret = append(ret, declaration{property, value})
+		}
+	}
+	return ret
+}
+
+func (s *style) getProperty(property string) string {
+	for _, d := range s.declarations() {
+		if d.property == property {
+			return d.value
+		}
+	}
+	return ""
+}
+
+func (s *style) setProperty(property, value string) {
+	var b strings.Builder
+	found := false
+	for _, d := range s.declarations() {
+		if d.property == property {
+			found = true
+			d.value = value
+		}
+		if d.value != "" {
+			b.WriteString(d.property + ": " + d.value + "; ")
+		}
+	}
+	if !found && value != "" {
+		b.WriteString(property + ": " + value + "; ")
+	}
+	if b.Len() == 0 && !s.n.hasAttr("style") {
+		return
+	}
+	s.n.setAttr("style", strings.TrimSuffix(b.String(), " "))
+}
+
+// cssProperty returns the CSS property name of the given style property,
+// which may be given in camel case.
+func cssProperty(name string) string {
+	if strings.HasPrefix(name, "--") {
+		return name
+	}
+	return camelToKebab(name)
+}
+
+func (s *style) get(name string) Value {
+	switch name {
+	case "cssText":
+		return ValueOf(s.n.attr("style"))
+	case "length":
+		return ValueOf(len(s.declarations()))
+	case "getPropertyValue":
+		return method(func(args []Value) Value {
+			return ValueOf(s.getProperty(toString(arg(args, 0))))
+		})
+	case "setProperty":
+		return method(func(args []Value) Value {
+			s.setProperty(toString(arg(args, 0)), stringOrEmpty(arg(args, 1)))
+			return Undefined()
+		})
+	case "removeProperty":
+		return method(func(args []Value) Value {
+			property := toString(arg(args, 0))
+			ret := s.getProperty(property)
+			s.setProperty(property, "")
+			return ValueOf(ret)
+		})
+	}
+	return ValueOf(s.getProperty(cssProperty(name)))
+}
+
+func (s *style) set(name string, value Value) {
+	if name == "cssText" {
+		s.n.setAttr("style", stringOrEmpty(value))
+		return
+	}
+	s.setProperty(cssProperty(name), stringOrEmpty(value))
+}
+
+func (s *style) index(i int) Value {
+	decls := s.declarations()
+	if i < 0 || i >= len(decls) {
+		return Undefined()
+	}
+	return ValueOf(decls[i].property)
+}
+
+func (s *style) setIndex(i int, value Value) {}
+
+// getDocument implements the properties and methods of the document.
+func (n *node) getDocument(name string) (Value, bool) {
+	switch name {
+	case "documentElement":
+		for c := n.first; c != nil; c = c.next {
+			if c.typ == elementNode {
+				return Value{c}, true
+			}
+		}
+		return Null(), true
+	case "head", "body":
+		for c := n.first; c != nil; c = c.next {
+			if c.isHTML("html") {
+				for d := c.first; d != nil; d = d.next {
+					if d.isHTML(name) {
+						return Value{d}, true
+					}
+				}
+			}
+		}
+		return Null(), true
+	case "doctype":
+		for c := n.first; c != nil; c = c.next {
+			if c.typ == doctypeNode {
+				return Value{c}, true
+			}
+		}
+		return Null(), true
+	case "title":
+		for _, t := range n.descendants(func(d *node) bool { return d.isHTML("title") }) {
+			return ValueOf(strings.TrimSpace(t.textContent())), true
+		}
+		return ValueOf(""), true
+	case "createElement":
+		return method(func(args []Value) Value {
+			return Value{newElement(strings.ToLower(toString(arg(args, 0))), "")}
+		}), true
+	case "createElementNS":
+		return method(func(args []Value) Value {
+			namespace := toString(arg(args, 0))
+			switch namespace {
+			case "http://www.w3.org/1999/xhtml":
+				namespace = ""
+			case "http://www.w3.org/2000/svg":
+				namespace = "svg"
+			case "http://www.w3.org/1998/Math/MathML":
+				namespace = "math"
+			}
+			return Value{newElement(toString(arg(args, 1)), namespace)}
+		}), true
+	case "createTextNode":
+		return method(func(args []Value) Value {
+			ret := newNode(textNode)
+			ret.data = toString(arg(args, 0))
+			return Value{ret}
+		}), true
+	case "createComment":
+		return method(func(args []Value) Value {
+			ret := newNode(commentNode)
+			ret.data = toString(arg(args, 0))
+			return Value{ret}
+		}), true
+	case "createDocumentFragment":
+		return method(func(args []Value) Value {
+			return Value{newNode(fragmentNode)}
+		}), true
+	case "getElementById":
+		return method(func(args []Value) Value {
+			id := toString(arg(args, 0))
+			for _, d := range n.descendants(func(d *node) bool { return d.attr("id") == id }) {
+				return Value{d}
+			}
+			return Null()
+		}), true
+	case "importNode":
+		return method(func(args []Value) Value {
+			return Value{mustNode(arg(args, 0), "importNode").clone(arg(args, 1).Truthy())}
+		}), true
+	}
+	return Value{}, false
+}
+
+// isFormControl checks whether n is listed in its form's elements.
+func (n *node) isFormControl() bool {
+	if n.namespace != "" {
+		return false
+	}
+	switch n.tag {
+	case "button", "fieldset", "object", "output", "select", "textarea":
+		return true
+	case "input":
+		return n.inputType() != "image"
+	}
+	return false
+}
+
+func (n *node) inputType() string {
+	t := strings.ToLower(n.attr("type"))
+	switch t {
+	case "hidden", "search", "tel", "url", "email", "password", "date",
+		"month", "week", "time", "datetime-local", "number", "range", "color",
+		"checkbox", "radio", "file", "submit", "image", "reset", "button":
+		return t
+	}
+	return "text"
+}
+
+// isCheckable checks whether n is a checkbox or a radio button.
+func (n *node) isCheckable() bool {
+	if !n.isHTML("input") {
+		return false
+	}
+	t := n.inputType()
+	return t == "checkbox" || t == "radio"
+}
+
+func (n *node) form() *node {
+	if n.parent == nil {
+		return nil
+	}
+	return n.parent.closestHTML("form")
+}
+
+// controls returns the form controls of the form n.
+func (n *node) controls() []*node {
+	return n.descendants((*node).isFormControl)
+}
+
+func (n *node) isChecked() bool {
+	if n.checkedDirty {
+		return n.checked
+	}
+	return n.hasAttr("checked")
+}
+
+func (n *node) setChecked(value bool) {
+	n.checked, n.checkedDirty = value, true
+	if value && n.isHTML("input") && n.inputType() == "radio" {
+		n.uncheckGroup()
+	}
+}
+
+// uncheckGroup unchecks all other radio buttons in n's group.
+func (n *node) uncheckGroup() {
+	name := n.attr("name")
+	if name == "" {
+		return
+	}
+	scope := n.form()
+	if scope == nil {
+		scope = n.root()
+	}
+	for _, other := range scope.descendants(func(d *node) bool {
+		return d != n && d.isHTML("input") && d.inputType() == "radio" &&
+			d.attr("name") == name && d.form() == n.form()
+	}) {
+		other.checked, other.checkedDirty = false, true
+	}
+}
+
+func (n *node) isSelected() bool {
+	if n.selectDirty {
+		return n.selected
+	}
+	return n.hasAttr("selected")
+}
+
+// options returns the option elements of the select element n.
+func (n *node) options() []*node {
+	return n.descendants(func(d *node) bool { return d.isHTML("option") })
+}
+
+// selectedOption returns the option that is displayed by the select element
+// n.
+func (n *node) selectedOption() (*node, int) {
+	options := n.options()
+	for i, o := range options {
+		if o.isSelected() {
+			return o, i
+		}
+	}
+	if len(options) > 0 && !n.hasAttr("multiple") {
+		return options[0], 0
+	}
+	return nil, -1
+}
+
+func (n *node) selectOption(selected *node) {
+	for _, o := range n.options() {
+		o.selected, o.selectDirty = o == selected, true
+	}
+}
+
+// formValue returns the value property of n.
+func (n *node) formValue() string {
+	switch n.tag {
+	case "input":
+		if n.isCheckable() {
+			if value, ok := n.getAttr("value"); ok {
+				return value
+			}
+			return "on"
+		}
+		if n.value != nil {
+			return *n.value
+		}
+		return n.attr("value")
+	case "textarea":
+		if n.value != nil {
+			return *n.value
+		}
+		return n.textContent()
+	case "select":
+		if o, _ := n.selectedOption(); o != nil {
+			return o.formValue()
+		}
+		return ""
+	case "option":
+		if value, ok := n.getAttr("value"); ok {
+			return value
+		}
+		return strings.Join(strings.Fields(n.textContent()), " ")
+	}
+	return n.attr("value")
+}
+
+func (n *node) setFormValue(value string) {
+	switch n.tag {
+	case "input":
+		if n.isCheckable() {
+			n.setAttr("value", value)
+		} else {
+			n.value = &value
+		}
+	case "textarea":
+		n.value = &value
+	case "select":
+		var selected *node
+		for _, o := range n.options() {
+			if o.formValue() == value {
+				selected = o
+				break
+			}
+		}
+		n.selectOption(selected)
+	default:
+		n.setAttr("value", value)
+	}
+}
+
+// reset resets the form controls of the form n to their default values.
+func (n *node) reset() {
+	for _, c := range n.controls() {
+		c.value, c.checkedDirty = nil, false
+		for _, o := range c.options() {
+			o.selectDirty = false
+		}
+	}
+}
+
+// getFormProperty implements properties of forms and form controls.
+func (n *node) getFormProperty(name string) (Value, bool) {
+	switch name {
+	case "value":
+		switch n.tag {
+		case "input", "textarea", "select", "option", "button", "output", "li",
+			"data", "param", "progress", "meter":
+			return ValueOf(n.formValue()), true
+		}
+	case "defaultValue":
+		switch n.tag {
+		case "input":
+			return ValueOf(n.attr("value")), true
+		case "textarea":
+			return ValueOf(n.textContent()), true
+		}
+	case "type":
+		switch n.tag {
+		case "input":
+			return ValueOf(n.inputType()), true
+		case "button":
+			if t := strings.ToLower(n.attr("type")); t == "reset" || t == "button" {
+				return ValueOf(t), true
+			}
+			return ValueOf("submit"), true
+		case "select":
+			if n.hasAttr("multiple") {
+				return ValueOf("select-multiple"), true
+			}
+			return ValueOf("select-one"), true
+		case "textarea":
+			return ValueOf("textarea"), true
+		}
+	case "checked":
+		if n.isHTML("input") {
+			return ValueOf(n.isChecked()), true
+		}
+	case "selected":
+		if n.isHTML("option") {
+			if s := n.closestHTML("select"); s != nil {
+				o, _ := s.selectedOption()
+				return ValueOf(o == n), true
+			}
+			return ValueOf(n.isSelected()), true
+		}
+	case "selectedIndex":
+		if n.isHTML("select") {
+			_, i := n.selectedOption()
+			return ValueOf(i), true
+		}
+	case "options":
+		if n.isHTML("select") {
+			return Value{&staticNodeList{items: n.options()}}, true
+		}
+	case "form":
+		if n.isFormControl() || n.isHTML("option") || n.isHTML("label") {
+			return n.form().val(), true
+		}
+	case "elements":
+		if n.isHTML("form") || n.isHTML("fieldset") {
+			return Value{&formControls{n: n}}, true
+		}
+	case "length":
+		if n.isHTML("form") {
+			return ValueOf(len(n.controls())), true
+		}
+	case "submit":
+		if n.isHTML("form") {
+			return method(func(args []Value) Value {
+				return Undefined()
+			}), true
+		}
+	case "requestSubmit":
+		if n.isHTML("form") {
+			return method(func(args []Value) Value {
+				n.requestSubmit()
+				return Undefined()
+			}), true
+		}
+	case "reset":
+		if n.isHTML("form") {
+			return method(func(args []Value) Value {
+				if n.dispatch(newEvent("reset", true, true)) {
+					n.reset()
+				}
+				return Undefined()
+			}), true
+		}
+	}
+	return Value{}, false
+}
+
+// setFormProperty implements setting properties of forms and form controls.
+func (n *node) setFormProperty(name string, value Value) bool {
+	switch name {
+	case "value":
+		switch n.tag {
+		case "input", "textarea", "select", "option", "button", "output", "li",
+			"data", "param", "progress", "meter":
+			n.setFormValue(stringOrEmpty(value))
+			return true
+		}
+	case "defaultValue":
+		switch n.tag {
+		case "input":
+			n.setAttr("value", toString(value))
+			return true
+		case "textarea":
+			n.setTextContent(toString(value))
+			return true
+		}
+	case "type":
+		if n.isHTML("input") || n.isHTML("button") {
+			n.setAttr("type", toString(value))
+			return true
+		}
+	case "checked":
+		if n.isHTML("input") {
+			n.setChecked(value.Truthy())
+			return true
+		}
+	case "selected":
+		if n.isHTML("option") {
+			n.selected, n.selectDirty = value.Truthy(), true
+			if s := n.closestHTML("select"); s != nil && n.selected &&
+				!s.hasAttr("multiple") {
+				s.selectOption(n)
+			}
+			return true
+		}
+	case "selectedIndex":
+		if n.isHTML("select") {
+			var selected *node
+			if i := int(toNumber(value)); i >= 0 && i < len(n.options()) {
+				selected = n.options()[i]
+			}
+			n.selectOption(selected)
+			return true
+		}
+	}
+	return false
+}
+
+// formControls is the HTMLFormControlsCollection of a form.
+type formControls struct {
+	n *node
+}
+
+func (c *formControls) className() string {
+	return "HTMLFormControlsCollection"
+}
+
+func (c *formControls) namedItem(name string) Value {
+	var items []*node
+	for _, control := range c.n.controls() {
+		if control.attr("id") == name || control.attr("name") == name {
+			items = append(items, control)
+		}
+	}
+	switch len(items) {
+	case 0:
+		return Null()
+	case 1:
+		return Value{items[0]}
+	}
+	return Value{&radioNodeList{staticNodeList{items: items}}}
+}
+
+func (c *formControls) get(name string) Value {
+	switch name {
+	case "length":
+		return ValueOf(len(c.n.controls()))
+	case "item":
+		return method(func(args []Value) Value {
+			ret := c.index(int(toNumber(arg(args, 0))))
+			if ret.IsUndefined() {
+				return Null()
+			}
+			return ret
+		})
+	case "namedItem":
+		return method(func(args []Value) Value {
+			return c.namedItem(toString(arg(args, 0)))
+		})
+	}
+	if i, err := strconv.Atoi(name); err == nil {
+		return c.index(i)
+	}
+	if ret := c.namedItem(name); !ret.IsNull() {
+		return ret
+	}
+	return Undefined()
+}
+
+func (c *formControls) set(name string, value Value) {}
+
+func (c *formControls) index(i int) Value {
+	controls := c.n.controls()
+	if i < 0 || i >= len(controls) {
+		return Undefined()
+	}
+	return Value{controls[i]}
+}
+
+func (c *formControls) setIndex(i int, value Value) {}
+
+// radioNodeList is a list of form controls sharing a name.
+type radioNodeList struct {
+	staticNodeList
+}
+
+func (l *radioNodeList) className() string {
+	return "RadioNodeList"
+}
+
+func (l *radioNodeList) get(name string) Value {
+	if name == "value" {
+		for _, item := range l.items {
+			if item.isHTML("input") && item.inputType() == "radio" && item.isChecked() {
+				return ValueOf(item.formValue())
+			}
+		}
+		return ValueOf("")
+	}
+	return l.staticNodeList.get(name)
+}
+
+func (l *radioNodeList) set(name string, value Value) {
+	if name == "value" {
+		s := toString(value)
+		for _, item := range l.items {
+			if item.isHTML("input") && item.inputType() == "radio" &&
+				item.formValue() == s {
+				item.setChecked(true)
+				return
+			}
+		}
+	}
+}
@@ -0,0 +1,158 @@
+//go:build !js
+// +build !js
+
+package dom
+
+import "testing"
+
+func TestClassList(t *testing.T) {
+	Reset()
+	e := create(t, `<div class=" a  b "></div>`)
+	l := e.Get("classList")
+	if l.Length() != 2 || l.Index(1).String() != "b" || !l.Index(2).IsUndefined() {
+		t.Errorf("unexpected tokens: %s", l.Get("value"))
+	}
+	if !e.Get("classList").Equal(l) {
+		t.Error("classList must be cached")
+	}
+	if !l.Call("contains", "a").Bool() || l.Call("contains", "c").Bool() {
+		t.Error("contains returned wrong result")
+	}
+	l.Call("add", "c", "a")
+	if e.Get("className").String() != "a b c" {
+		t.Errorf("unexpected class after add: %s", e.Get("className"))
+	}
+	l.Call("remove", "b", "x")
+	if e.Get("className").String() != "a c" {
+		t.Errorf("unexpected class after remove: %s", e.Get("className"))
+	}
+	if l.Call("toggle", "a").Bool() || !l.Call("toggle", "d").Bool() ||
+		!l.Call("toggle", "d", true).Bool() {
+		t.Error("toggle returned wrong result")
+	}
+	if e.Get("className").String() != "c d" {
+		t.Errorf("unexpected class after toggle: %s", e.Get("className"))
+	}
+	if !l.Call("replace", "c", "d").Bool() || l.Call("replace", "x", "y").Bool() {
+		t.Error("replace returned wrong result")
+	}
+	if e.Call("getAttribute", "class").String() != "d" {
+		t.Errorf("unexpected class after replace: %s", e.Get("className"))
+	}
+	expectThrow(t, "InvalidCharacterError", func() {
+		l.Call("add", "a b")
+	})
+
+	// removing from an element without class does not add the attribute
+	plain := create(t, "<p></p>")
+	plain.Get("classList").Call("remove", "a")
+	if plain.Call("hasAttribute", "class").Bool() {
+		t.Error("remove added a class attribute")
+	}
+}
+
+func TestDataset(t *testing.T) {
+	Reset()
+	e := create(t, `<div data-foo="1" data-foo-bar="2"></div>`)
+	ds := e.Get("dataset")
+	if ds.Get("foo").String() != "1" || ds.Get("fooBar").String() != "2" {
+		t.Errorf("unexpected values: %s, %s", ds.Get("foo"), ds.Get("fooBar"))
+	}
+	if !ds.Get("missing").IsUndefined() {
+		t.Error("missing value must be undefined")
+	}
+	ds.Set("someValue", 42)
+	if v := e.Call("getAttribute", "data-some-value").String(); v != "42" {
+		t.Errorf("unexpected attribute: %s", v)
+	}
+	ds.Delete("foo")
+	if e.Call("hasAttribute", "data-foo").Bool() {
+		t.Error("attribute has not been deleted")
+	}
+}
+
+func TestStyle(t *testing.T) {
+	Reset()
+	e := create(t, `<div style="color: red; margin-top: 1px"></div>`)
+	s := e.Get("style")
+	if s.Get("color").String() != "red" || s.Get("marginTop").String() != "1px" {
+		t.Errorf("unexpected style: %s", s.Get("cssText"))
+	}
+	s.Set("display", "none")
+	s.Call("removeProperty", "color")
+	if v := e.Call("getAttribute", "style").String(); v != "margin-top: 1px; display: none;" {
+		t.Errorf("unexpected style attribute: %s", v)
+	}
+}
+
+func TestFormControls(t *testing.T) {
+	Reset()
+	form := create(t, `<form>
+		<input name="text" value="default">
+		<input type="checkbox" name="check" checked>
+		<input type="radio" name="r" value="1" checked><input type="radio" name="r" value="2">
+		<select name="sel"><option>a</option><option selected>b</option></select>
+		<textarea name="area">content</textarea>
+	</form>`)
+	elements := form.Get("elements")
+	text := elements.Get("text")
+	if text.Get("value").String() != "default" {
+		t.Errorf("unexpected value: %s", text.Get("value"))
+	}
+	text.Set("value", "changed")
+	if text.Call("getAttribute", "value").String() != "default" {
+		t.Error("setting the value must not change the attribute")
+	}
+	if !elements.Get("check").Get("checked").Bool() {
+		t.Error("checkbox is not checked")
+	}
+	if v := elements.Get("r").Get("value").String(); v != "1" {
+		t.Errorf("unexpected radio value: %s", v)
+	}
+	elements.Get("r").Index(1).Call("click")
+	if elements.Get("r").Index(0).Get("checked").Bool() {
+		t.Error("checking a radio button must uncheck the others")
+	}
+	sel := elements.Get("sel")
+	if sel.Get("value").String() != "b" || sel.Get("selectedIndex").Int() != 1 {
+		t.Errorf("unexpected selection: %s", sel.Get("value"))
+	}
+	sel.Set("value", "a")
+	if sel.Get("selectedIndex").Int() != 0 {
+		t.Errorf("unexpected selection after setting value: %s", sel.Get("value"))
+	}
+	if v := elements.Get("area").Get("value").String(); v != "content" {
+		t.Errorf("unexpected textarea value: %s", v)
+	}
+
+	form.Call("reset")
+	if text.Get("value").String() != "default" || sel.Get("value").String() != "b" ||
+		!elements.Get("r").Index(0).Get("checked").Bool() {
+		t.Error("reset did not restore the default state")
+	}
+}
+
+func TestSelectors(t *testing.T) {
+	Reset()
+	root := create(t, `<div id="root"><p class="a">1</p><section><p class="a b">2</p>
+		<span data-x="y">3</span></section></div>`)
+	if v := root.Call("querySelector", "p.b").Get("textContent").String(); v != "2" {
+		t.Errorf("unexpected match: %s", v)
+	}
+	if n := root.Call("querySelectorAll", ".a").Length(); n != 2 {
+		t.Errorf("expected 2 matches, got %d", n)
+	}
+	if n := root.Call("querySelectorAll", "section > *").Length(); n != 2 {
+		t.Errorf("expected 2 matches, got %d", n)
+	}
+	span := root.Call("querySelector", "[data-x=y]")
+	if span.IsNull() || !span.Call("matches", "div span").Bool() {
+		t.Error("attribute selector did not match")
+	}
+	if !span.Call("closest", "#root").Equal(root) {
+		t.Error("closest did not find the root")
+	}
+	if !root.Call("querySelector", "em").IsNull() {
+		t.Error("querySelector must return null without match")
+	}
+}
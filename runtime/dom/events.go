@@ -0,0 +1,343 @@
+//go:build !js
+// +build !js
+
+package dom
+
+import "time"
+
+type listener struct {
+	typ                    string
+	callback               Value
+	capture, once, passive bool
+	removed                bool
+}
+
+// listenerOptions reads the options argument of add/removeEventListener,
+// which is either a boolean or an object.
+func listenerOptions(options Value) (capture, once, passive bool) {
+	if options.Type().isObject() {
+		return options.Get("capture").Truthy(), options.Get("once").Truthy(),
+			options.Get("passive").Truthy()
+	}
+	return options.Truthy(), false, false
+}
+
+func (n *node) addEventListener(args []Value) {
+	callback := arg(args, 1)
+	if !callback.Type().isObject() {
+		return
+	}
+	l := &listener{typ: toString(arg(args, 0)), callback: callback}
+	l.capture, l.once, l.passive = listenerOptions(arg(args, 2))
+	for _, existing := range n.listeners {
+		if existing.typ == l.typ && existing.capture == l.capture &&
+			existing.callback.Equal(l.callback) {
+			return
+		}
+	}
+	n.listeners = append(n.listeners, l)
+}
+
+func (n *node) removeListener(l *listener) {
+	l.removed = true
+	for i, existing := range n.listeners {
+		if existing == l {
+			n.listeners = append(n.listeners[:i:i], n.listeners[i+1:]...)
+			return
+		}
+	}
+}
+
+func (n *node) removeEventListener(args []Value) {
+	typ := toString(arg(args, 0))
+	capture, _, _ := listenerOptions(arg(args, 2))
+	for _, l := range n.listeners {
+		if l.typ == typ && l.capture == capture && l.callback.Equal(arg(args, 1)) {
+			n.removeListener(l)
+			return
+		}
+	}
+}
+
+const (
+	phaseNone = iota
+	phaseCapturing
+	phaseAtTarget
+	phaseBubbling
+)
+
+// event is a DOM Event. The properties of specialized events like
+// KeyboardEvent are stored in props.
+type event struct {
+	class                 string
+	typ                   string
+	bubbles, cancelable   bool
+	defaultPrevented      bool
+	stop, stopImmediate   bool
+	inPassive, dispatched bool
+	phase                 int
+	target, current       *node
+	timeStamp             float64
+	props                 *plainObject
+}
+
+var start = time.Now()
+
+func newEvent(typ string, bubbles, cancelable bool) *event {
+	return &event{class: "Event", typ: typ, bubbles: bubbles,
+		cancelable: cancelable, props: newPlainObject(),
+		timeStamp: float64(time.Since(start)) / float64(time.Millisecond)}
+}
+
+func (e *event) className() string {
+	return e.class
+}
+
+func (e *event) get(name string) Value {
+	switch name {
+	case "type":
+		return ValueOf(e.typ)
+	case "bubbles":
+		return ValueOf(e.bubbles)
+	case "cancelable":
+		return ValueOf(e.cancelable)
+	case "defaultPrevented":
+		return ValueOf(e.defaultPrevented)
+	case "eventPhase":
+		return ValueOf(e.phase)
+	case "target", "srcElement":
+		return e.target.val()
+	case "currentTarget":
+		return e.current.val()
+	case "isTrusted":
+		return ValueOf(false)
+	case "timeStamp":
+		return ValueOf(e.timeStamp)
+	case "preventDefault":
+		return method(func(args []Value) Value {
+			if e.cancelable && !e.inPassive {
+				e.defaultPrevented = true
+			}
+			return Undefined()
+		})
+	case "stopPropagation":
+		return method(func(args []Value) Value {
+			e.stop = true
+			return Undefined()
+		})
+	case "stopImmediatePropagation":
+		return method(func(args []Value) Value {
+			e.stop, e.stopImmediate = true, true
+			return Undefined()
+		})
+	case "composedPath":
+		return method(func(args []Value) Value {
+			var path []interface{}
+			if e.current != nil {
+				for cur := e.target; cur != nil; cur = cur.parent {
+					path = append(path, Value{cur})
+				}
+			}
+			return ValueOf(path)
+		})
+	}
+	return e.props.get(name)
+}
+
+func (e *event) set(name string, value Value) {
+	switch name {
+	case "type", "bubbles", "cancelable", "defaultPrevented", "eventPhase",
+		"target", "srcElement", "currentTarget", "isTrusted", "timeStamp":
+		return
+	}
+	e.props.set(name, value)
+}
+
+// invoke calls the listeners of e.current that match the given phase.
+func (e *event) invoke(capture bool) {
+	listeners := append([]*listener(nil), e.current.listeners...)
+	for _, l := range listeners {
+		if l.removed || l.typ != e.typ || l.capture != capture {
+			continue
+		}
+		if l.once {
+			e.current.removeListener(l)
+		}
+		e.inPassive = l.passive
+		if fn, ok := l.callback.ref.(*function); ok {
+			fn.call(Value{e.current}, []Value{{e}})
+		} else {
+			l.callback.Call("handleEvent", Value{e})
+		}
+		e.inPassive = false
+		if e.stopImmediate {
+			return
+		}
+	}
+}
+
+// dispatch dispatches e with n as target. Returns false if the event has
+// been canceled.
+//
+// Panics raised by listeners are not caught, so that they fail tests.
+func (n *node) dispatch(e *event) bool {
+	if e.dispatched {
+		throw("InvalidStateError", "the event is already being dispatched")
+	}
+	e.dispatched, e.target = true, n
+	defer func() {
+		e.dispatched, e.phase, e.current = false, phaseNone, nil
+		e.stop, e.stopImmediate = false, false
+	}()
+	var path []*node
+	for cur := n.parent; cur != nil; cur = cur.parent {
+		path = append(path, cur)
+	}
+
+	e.phase = phaseCapturing
+	for i := len(path) - 1; i >= 0 && !e.stop; i-- {
+		e.current = path[i]
+		e.invoke(true)
+	}
+	if !e.stop {
+		e.phase, e.current = phaseAtTarget, n
+		e.invoke(true)
+		if !e.stop {
+			e.invoke(false)
+		}
+	}
+	if e.bubbles {
+		e.phase = phaseBubbling
+		for i := 0; i < len(path) && !e.stop; i++ {
+			e.current = path[i]
+			e.invoke(false)
+		}
+	}
+	return !e.defaultPrevented
+}
+
+// click implements HTMLElement.click, including the activation behavior of
+// checkboxes, radio buttons and submit and reset buttons.
+func (n *node) click() {
+	if n.hasAttr("disabled") && n.isFormControl() {
+		return
+	}
+	var wasChecked bool
+	checkable := n.isCheckable()
+	if checkable {
+		wasChecked = n.isChecked()
+		if n.inputType() == "checkbox" {
+			n.setChecked(!wasChecked)
+		} else {
+			n.setChecked(true)
+		}
+	}
+	e := newMouseEvent("click", true, true)
+	if !n.dispatch(e) {
+		if checkable {
+			n.checked = wasChecked
+		}
+		return
+	}
+	if checkable {
+		if n.isChecked() != wasChecked {
+			n.dispatch(newEvent("input", true, false))
+			n.dispatch(newEvent("change", true, false))
+		}
+		return
+	}
+	var kind string
+	switch {
+	case n.isHTML("input"):
+		kind = n.inputType()
+	case n.isHTML("button"):
+		kind = toString(n.get("type"))
+	default:
+		return
+	}
+	form := n.form()
+	if form == nil {
+		return
+	}
+	switch kind {
+	case "submit":
+		form.requestSubmit()
+	case "reset":
+		if form.dispatch(newEvent("reset", true, true)) {
+			form.reset()
+		}
+	}
+}
+
+// requestSubmit dispatches a submit event at the form n. There is no
+// navigation in the headless DOM, so submitting has no further effect.
+func (n *node) requestSubmit() {
+	n.dispatch(newEvent("submit", true, true))
+}
+
+func newMouseEvent(typ string, bubbles, cancelable bool) *event {
+	e := newEvent(typ, bubbles, cancelable)
+	e.class = "MouseEvent"
+	for name, value := range eventDefaults["MouseEvent"] {
+		e.props.set(name, value)
+	}
+	return e
+}
+
+// eventDefaults contains the properties of the supported event classes with
+// their default values. They can be set via the constructor's options.
+var eventDefaults = map[string]map[string]Value{
+	"Event":       {},
+	"CustomEvent": {"detail": Null()},
+	"UIEvent":     {"detail": ValueOf(0)},
+	"FocusEvent":  {"detail": ValueOf(0), "relatedTarget": Null()},
+	"InputEvent": {"detail": ValueOf(0), "data": Null(),
+		"inputType": ValueOf(""), "isComposing": ValueOf(false)},
+	"KeyboardEvent": {"detail": ValueOf(0), "key": ValueOf(""),
+		"code": ValueOf(""), "location": ValueOf(0), "ctrlKey": ValueOf(false),
+		"shiftKey": ValueOf(false), "altKey": ValueOf(false),
+		"metaKey": ValueOf(false), "repeat": ValueOf(false),
+		"isComposing": ValueOf(false), "keyCode": ValueOf(0),
+		"charCode": ValueOf(0), "which": ValueOf(0)},
+	"MouseEvent": {"detail": ValueOf(0), "screenX": ValueOf(0),
+		"screenY": ValueOf(0), "clientX": ValueOf(0), "clientY": ValueOf(0),
+		"ctrlKey": ValueOf(false), "shiftKey": ValueOf(false),
+		"altKey": ValueOf(false), "metaKey": ValueOf(false),
+		"button": ValueOf(0), "buttons": ValueOf(0), "relatedTarget": Null()},
+}
+
+// eventConstructor returns the constructor of the given event class.
+func eventConstructor(class string) Value {
+	defaults := eventDefaults[class]
+	return Value{&function{
+		fn: func(this Value, args []Value) Value {
+			throw("TypeError", "Failed to construct '"+class+
+				"': Please use the 'new' operator")
+			return Undefined()
+		},
+		construct: func(args []Value) Value {
+			if len(args) == 0 {
+				throw("TypeError", "Failed to construct '"+class+
+					"': 1 argument required, but only 0 present.")
+			}
+			options := arg(args, 1)
+			if !options.Type().isObject() {
+				options = ValueOf(map[string]interface{}{})
+			}
+			e := newEvent(toString(args[0]), options.Get("bubbles").Truthy(),
+				options.Get("cancelable").Truthy())
+			e.class = class
+			for name, value := range defaults {
+				if given := options.Get(name); !given.IsUndefined() {
+					value = given
+				}
+				e.props.set(name, value)
+			}
+			return Value{e}
+		},
+		instance: func(v Value) bool {
+			e, ok := v.ref.(*event)
+			return ok && (class == "Event" || e.class == class)
+		},
+	}}
+}
@@ -0,0 +1,137 @@
+//go:build !js
+// +build !js
+
+package dom
+
+import (
+	"strconv"
+	"strings"
+	"testing"
+)
+
+// recorder returns a listener that appends the given label to log.
+func recorder(log *[]string, label string) Func {
+	return FuncOf(func(this Value, args []Value) interface{} {
+		*log = append(*log, label+":"+strconv.Itoa(args[0].Get("eventPhase").Int()))
+		return nil
+	})
+}
+
+func TestDispatchPhases(t *testing.T) {
+	Reset()
+	outer := create(t, "<div><p><span></span></p></div>")
+	inner := outer.Get("firstChild")
+	target := inner.Get("firstChild")
+	var log []string
+	outer.Call("addEventListener", "x", recorder(&log, "outer-capture"), true)
+	outer.Call("addEventListener", "x", recorder(&log, "outer"))
+	inner.Call("addEventListener", "x", recorder(&log, "inner"))
+	target.Call("addEventListener", "x", recorder(&log, "target"))
+	target.Call("addEventListener", "x", recorder(&log, "target-capture"),
+		map[string]interface{}{"capture": true})
+
+	e := Global().Get("Event").New("x", map[string]interface{}{"bubbles": true})
+	target.Call("dispatchEvent", e)
+	if s := strings.Join(log, " "); s != "outer-capture:1 target-capture:2 target:2 inner:3 outer:3" {
+		t.Errorf("unexpected order: %s", s)
+	}
+	if !e.Get("target").Equal(target) || !e.Get("currentTarget").IsNull() {
+		t.Error("unexpected targets after dispatch")
+	}
+
+	log = nil
+	target.Call("dispatchEvent", Global().Get("Event").New("x"))
+	if s := strings.Join(log, " "); s != "outer-capture:1 target-capture:2 target:2" {
+		t.Errorf("unexpected order without bubbling: %s", s)
+	}
+}
+
+func TestListenerOptions(t *testing.T) {
+	Reset()
+	e := create(t, "<button></button>")
+	count := 0
+	fn := FuncOf(func(this Value, args []Value) interface{} {
+		count++
+		args[0].Call("preventDefault")
+		return nil
+	})
+	e.Call("addEventListener", "click", fn, map[string]interface{}{"once": true})
+	// adding the same listener again has no effect
+	e.Call("addEventListener", "click", fn, map[string]interface{}{"once": true})
+	e.Call("click")
+	e.Call("click")
+	if count != 1 {
+		t.Errorf("once listener called %d times", count)
+	}
+
+	e.Call("addEventListener", "click", fn, map[string]interface{}{"passive": true})
+	ev := Global().Get("MouseEvent").New("click", map[string]interface{}{"cancelable": true})
+	if !e.Call("dispatchEvent", ev).Bool() || ev.Get("defaultPrevented").Bool() {
+		t.Error("passive listener prevented the default action")
+	}
+	e.Call("removeEventListener", "click", fn)
+	e.Call("click")
+	if count != 2 {
+		t.Errorf("removed listener has been called")
+	}
+	fn.Release()
+}
+
+func TestStopPropagation(t *testing.T) {
+	Reset()
+	outer := create(t, "<div><p></p></div>")
+	inner := outer.Get("firstChild")
+	var log []string
+	inner.Call("addEventListener", "x", FuncOf(func(this Value, args []Value) interface{} {
+		args[0].Call("stopImmediatePropagation")
+		log = append(log, "first")
+		return nil
+	}))
+	inner.Call("addEventListener", "x", recorder(&log, "second"))
+	outer.Call("addEventListener", "x", recorder(&log, "outer"))
+	inner.Call("dispatchEvent", Global().Get("Event").New("x",
+		map[string]interface{}{"bubbles": true}))
+	if s := strings.Join(log, " "); s != "first" {
+		t.Errorf("unexpected listeners called: %s", s)
+	}
+}
+
+func TestEventConstructors(t *testing.T) {
+	Reset()
+	ce := Global().Get("CustomEvent").New("my", map[string]interface{}{"detail": 42})
+	if ce.Get("detail").Int() != 42 || ce.Get("type").String() != "my" ||
+		!ce.InstanceOf(Global().Get("Event")) {
+		t.Error("unexpected custom event")
+	}
+	ke := Global().Get("KeyboardEvent").New("keydown",
+		map[string]interface{}{"key": "Enter", "ctrlKey": true})
+	if ke.Get("key").String() != "Enter" || !ke.Get("ctrlKey").Bool() ||
+		ke.Get("shiftKey").Bool() {
+		t.Error("unexpected keyboard event")
+	}
+	me := Global().Get("MouseEvent").New("click", map[string]interface{}{"button": 2})
+	if me.Get("button").Int() != 2 || me.Get("bubbles").Bool() {
+		t.Error("unexpected mouse event")
+	}
+	expectThrow(t, "TypeError", func() {
+		Global().Get("Event").Invoke("x")
+	})
+}
+
+func TestClickSubmitsForm(t *testing.T) {
+	Reset()
+	form := create(t, `<form><input name="a" value="1"><button>Go</button>
+		<input type="reset"></form>`)
+	var log []string
+	form.Call("addEventListener", "submit", recorder(&log, "submit"))
+	form.Call("addEventListener", "reset", recorder(&log, "reset"))
+	form.Call("querySelector", "button").Call("click")
+	form.Get("elements").Get("a").Set("value", "2")
+	form.Call("querySelector", "[type=reset]").Call("click")
+	if s := strings.Join(log, " "); s != "submit:2 reset:2" {
+		t.Errorf("unexpected events: %s", s)
+	}
+	if v := form.Get("elements").Get("a").Get("value").String(); v != "1" {
+		t.Errorf("reset button did not reset the form: %s", v)
+	}
+}
@@ -0,0 +1,261 @@
+//go:build !js
+// +build !js
+
+package dom
+
+import (
+	"errors"
+	"io"
+	"strings"
+
+	"github.com/flyx/net/html"
+	"github.com/flyx/net/html/atom"
+)
+
+// fromHTML converts a parsed HTML node to a DOM node.
+func fromHTML(h *html.Node) *node {
+	var n *node
+	switch h.Type {
+	case html.ElementNode:
+		n = newElement(h.Data, h.Namespace)
+		for _, a := range h.Attr {
+			n.attrs = append(n.attrs, attribute{namespace: a.Namespace, key: a.Key,
+				value: a.Val})
+		}
+	case html.TextNode:
+		n = newNode(textNode)
+		n.data = h.Data
+	case html.CommentNode:
+		n = newNode(commentNode)
+		n.data = h.Data
+	case html.DoctypeNode:
+		n = newNode(doctypeNode)
+		n.tag = h.Data
+	case html.DocumentNode:
+		n = newNode(documentNode)
+	default:
+		return nil
+	}
+	// the parser stores the content of templates as children.
+	target := n
+	if n.content != nil {
+		target = n.content
+	}
+	for c := h.FirstChild; c != nil; c = c.NextSibling {
+		if child := fromHTML(c); child != nil {
+			target.insert(child, nil)
+		}
+	}
+	return n
+}
+
+// toHTML converts a DOM node to a node that can be rendered. If state is
+// true, the current state of form controls is rendered as attributes.
+func toHTML(n *node, state bool) *html.Node {
+	var h *html.Node
+	switch n.typ {
+	case elementNode:
+		h = &html.Node{Type: html.ElementNode, Data: n.tag, Namespace: n.namespace}
+		if n.namespace == "" {
+			h.DataAtom = atom.Lookup([]byte(n.tag))
+		}
+		for _, a := range n.attrs {
+			h.Attr = append(h.Attr, html.Attribute{Namespace: a.namespace,
+				Key: a.key, Val: a.value})
+		}
+		if state && n.namespace == "" {
+			if n.renderState(h) {
+				return h
+			}
+		}
+	case textNode:
+		h = &html.Node{Type: html.TextNode, Data: n.data}
+	case commentNode:
+		h = &html.Node{Type: html.CommentNode, Data: n.data}
+	case doctypeNode:
+		h = &html.Node{Type: html.DoctypeNode, Data: n.tag}
+	default:
+		h = &html.Node{Type: html.DocumentNode}
+	}
+	source := n
+	if n.content != nil {
+		source = n.content
+	}
+	for c := source.first; c != nil; c = c.next {
+		h.AppendChild(toHTML(c, state))
+	}
+	if state && !(h.Namespace == "" && rawText[h.Data]) {
+		markText(h)
+	}
+	return h
+}
+
+// Data of the comments that mark text nodes which would otherwise get lost
+// when the rendered HTML is parsed. The askew runtime removes them when
+// hydrating server-rendered components.
+const (
+	splitMarker = "a:split"
+	emptyMarker = "a:empty"
+)
+
+// rawText contains the elements whose content is not parsed as HTML and
+// therefore cannot contain markers.
+var rawText = map[string]bool{"iframe": true, "noembed": true,
+	"noframes": true, "noscript": true, "plaintext": true, "script": true,
+	"style": true, "textarea": true, "title": true, "xmp": true}
+
+// markText replaces empty text children of h with empty markers and
+// separates adjacent text children with split markers.
+func markText(h *html.Node) {
+	for c := h.FirstChild; c != nil; c = c.NextSibling {
+		if c.Type != html.TextNode {
+			continue
+		}
+		if c.Data == "" {
+			c.Type, c.Data = html.CommentNode, emptyMarker
+		} else if next := c.NextSibling; next != nil &&
+			next.Type == html.TextNode && next.Data != "" {
+			h.InsertBefore(&html.Node{Type: html.CommentNode, Data: splitMarker},
+				next)
+		}
+	}
+}
+
+// render serializes n to HTML.
+func render(n *node) string {
+	var b strings.Builder
+	if err := html.Render(&b, toHTML(n, false)); err != nil {
+		throw("Error", err.Error())
+	}
+	return b.String()
+}
+
+func (n *node) innerHTML() string {
+	h := toHTML(n, false)
+	var b strings.Builder
+	for c := h.FirstChild; c != nil; c = c.NextSibling {
+		if err := html.Render(&b, c); err != nil {
+			throw("Error", err.Error())
+		}
+	}
+	return b.String()
+}
+
+// parseFragment parses the given HTML in the context of the given element
+// and returns a document fragment containing the resulting nodes.
+func parseFragment(source string, context *node) *node {
+	ctx := &html.Node{Type: html.ElementNode, Data: context.tag,
+		Namespace: context.namespace}
+	if context.namespace == "" {
+		ctx.DataAtom = atom.Lookup([]byte(context.tag))
+	}
+	nodes, err := html.ParseFragment(strings.NewReader(source), ctx)
+	if err != nil {
+		throw("SyntaxError", err.Error())
+	}
+	frag := newNode(fragmentNode)
+	for _, h := range nodes {
+		if child := fromHTML(h); child != nil {
+			frag.insert(child, nil)
+		}
+	}
+	return frag
+}
+
+func (n *node) setInnerHTML(source string) {
+	target := n
+	if n.content != nil {
+		target = n.content
+	}
+	frag := parseFragment(source, n)
+	target.removeAll()
+	target.insertBefore(frag, nil)
+}
+
+// parseDocument parses the given HTML as a complete document.
+func parseDocument(source string) *node {
+	h, err := html.Parse(strings.NewReader(source))
+	if err != nil {
+		throw("SyntaxError", err.Error())
+	}
+	return fromHTML(h)
+}
+
+// Render writes the HTML serialization of the node v to w. Document
+// fragments are rendered as the sequence of their children. Unlike outerHTML,
+// Render includes the current values of form controls.
+//
+// Render inserts the comments <!--a:split--> between adjacent text nodes and
+// renders empty text nodes as <!--a:empty-->, so that the structure of the
+// DOM is preserved when the HTML is parsed again. Text at the start and end
+// of a fragment is also separated from its surroundings. These comments
+// are removed when hydrating components.
+//
+// Render is only available in the headless DOM. It can be used to render
+// HTML on the server.
+func Render(w io.Writer, v Value) error {
+	n := nodeOf(v)
+	if n == nil {
+		return errors.New("cannot render " + v.String() + ": not a node")
+	}
+	h := toHTML(n, true)
+	if n.typ != fragmentNode {
+		return html.Render(w, h)
+	}
+	if h.FirstChild != nil && h.FirstChild.Type == html.TextNode {
+		h.InsertBefore(&html.Node{Type: html.CommentNode, Data: splitMarker},
+			h.FirstChild)
+	}
+	if h.LastChild != nil && h.LastChild.Type == html.TextNode {
+		h.AppendChild(&html.Node{Type: html.CommentNode, Data: splitMarker})
+	}
+	for c := h.FirstChild; c != nil; c = c.NextSibling {
+		if err := html.Render(w, c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// setHTMLAttr sets the attribute with the given key of h, or removes it if
+// present is false.
+func setHTMLAttr(h *html.Node, key, value string, present bool) {
+	for i := range h.Attr {
+		if h.Attr[i].Namespace == "" && h.Attr[i].Key == key {
+			if present {
+				h.Attr[i].Val = value
+			} else {
+				h.Attr = append(h.Attr[:i], h.Attr[i+1:]...)
+			}
+			return
+		}
+	}
+	if present {
+		h.Attr = append(h.Attr, html.Attribute{Key: key, Val: value})
+	}
+}
+
+// renderState sets the attributes of h to the state of the form control n.
+// Returns true if the children of h have been set.
+func (n *node) renderState(h *html.Node) bool {
+	switch n.tag {
+	case "input":
+		if n.isCheckable() {
+			if n.checkedDirty {
+				setHTMLAttr(h, "checked", "", n.checked)
+			}
+		} else if n.value != nil {
+			setHTMLAttr(h, "value", *n.value, true)
+		}
+	case "textarea":
+		if n.value != nil {
+			h.AppendChild(&html.Node{Type: html.TextNode, Data: *n.value})
+			return true
+		}
+	case "option":
+		if n.selectDirty {
+			setHTMLAttr(h, "selected", "", n.selected)
+		}
+	}
+	return false
+}
@@ -0,0 +1,70 @@
+//go:build !js
+// +build !js
+
+package dom
+
+import (
+	"strings"
+	"testing"
+)
+
+func renderString(t *testing.T, v Value) string {
+	t.Helper()
+	var b strings.Builder
+	if err := Render(&b, v); err != nil {
+		t.Fatal(err)
+	}
+	return b.String()
+}
+
+func TestInnerHTML(t *testing.T) {
+	Reset()
+	e := create(t, "<div></div>")
+	e.Set("innerHTML", `<p class="x">a &amp; b</p><!--c--><br>`)
+	if n := names(e); n != "P #comment BR" {
+		t.Errorf("unexpected children: %s", n)
+	}
+	if s := e.Get("innerHTML").String(); s != `<p class="x">a &amp; b</p><!--c--><br/>` {
+		t.Errorf("unexpected innerHTML: %s", s)
+	}
+	// parsing happens in the context of the element
+	table := create(t, "<table></table>")
+	table.Set("innerHTML", "<tr><td>1</td></tr>")
+	if n := names(table); n != "TBODY" {
+		t.Errorf("unexpected children of table: %s", n)
+	}
+}
+
+func TestRender(t *testing.T) {
+	Reset()
+	e := create(t, `<form><input name="a" value="1"><input type="checkbox"></form>`)
+	e.Get("elements").Index(0).Set("value", "2")
+	e.Get("elements").Index(1).Set("checked", true)
+	if s := renderString(t, e); s != `<form><input name="a" value="2"/><input type="checkbox" checked=""/></form>` {
+		t.Errorf("unexpected rendering of form state: %s", s)
+	}
+	if s := e.Get("outerHTML").String(); s != `<form><input name="a" value="1"/><input type="checkbox"/></form>` {
+		t.Errorf("outerHTML must not contain the state: %s", s)
+	}
+
+	// adjacent and empty text nodes are preserved
+	p := create(t, "<p></p>")
+	p.Call("appendChild", doc().Call("createTextNode", "a"))
+	p.Call("appendChild", doc().Call("createTextNode", "b"))
+	p.Call("appendChild", doc().Call("createTextNode", ""))
+	if s := renderString(t, p); s != "<p>a<!--a:split-->b<!--a:empty--></p>" {
+		t.Errorf("unexpected rendering of text nodes: %s", s)
+	}
+
+	frag := doc().Call("createDocumentFragment")
+	frag.Call("appendChild", doc().Call("createTextNode", "t"))
+	frag.Call("appendChild", doc().Call("createElement", "hr"))
+	if s := renderString(t, frag); s != "<!--a:split-->t<hr/>" {
+		t.Errorf("unexpected rendering of fragment: %s", s)
+	}
+
+	var b strings.Builder
+	if err := Render(&b, ValueOf(1)); err == nil {
+		t.Error("rendering a number must fail")
+	}
+}
@@ -0,0 +1,59 @@
+//go:build js
+// +build js
+
+package dom
+
+import "syscall/js"
+
+// Value is a JavaScript value.
+type Value = js.Value
+
+// Func is a wrapped Go function to be called by JavaScript.
+type Func = js.Func
+
+// Type is the type of a JavaScript value.
+type Type = js.Type
+
+// Error wraps a JavaScript error.
+type Error = js.Error
+
+// ValueError occurs when a Value method is invoked on a value that does not
+// support it.
+type ValueError = js.ValueError
+
+// The types of JavaScript values.
+const (
+	TypeUndefined = js.TypeUndefined
+	TypeNull      = js.TypeNull
+	TypeBoolean   = js.TypeBoolean
+	TypeNumber    = js.TypeNumber
+	TypeString    = js.TypeString
+	TypeSymbol    = js.TypeSymbol
+	TypeObject    = js.TypeObject
+	TypeFunction  = js.TypeFunction
+)
+
+// Global returns the JavaScript global object, usually "window".
+func Global() Value {
+	return js.Global()
+}
+
+// Undefined returns the JavaScript value "undefined".
+func Undefined() Value {
+	return js.Undefined()
+}
+
+// Null returns the JavaScript value "null".
+func Null() Value {
+	return js.Null()
+}
+
+// ValueOf returns x as a JavaScript value.
+func ValueOf(x interface{}) Value {
+	return js.ValueOf(x)
+}
+
+// FuncOf returns a function to be used by JavaScript.
+func FuncOf(fn func(this Value, args []Value) interface{}) Func {
+	return js.FuncOf(fn)
+}
@@ -0,0 +1,830 @@
+//go:build !js
+// +build !js
+
+package dom
+
+import (
+	"strings"
+)
+
+type nodeType int
+
+// values of the nodeType property.
+const (
+	elementNode  nodeType = 1
+	textNode     nodeType = 3
+	commentNode  nodeType = 8
+	documentNode nodeType = 9
+	doctypeNode  nodeType = 10
+	fragmentNode nodeType = 11
+)
+
+type attribute struct {
+	namespace, key, value string
+}
+
+// node is a DOM node. Its JavaScript properties and methods are implemented
+// by get and set.
+type node struct {
+	typ nodeType
+	// local name of elements, name of doctypes.
+	tag string
+	// namespace of elements, empty for HTML.
+	namespace string
+	// content of text and comment nodes.
+	data  string
+	attrs []attribute
+
+	parent, first, last, prev, next *node
+	// content of template elements.
+	content *node
+
+	listeners []*listener
+	// properties set from JavaScript that are not part of the DOM API.
+	props map[string]Value
+
+	// state of form controls. value is nil as long as the value has not been
+	// set, in which case the value is derived from the attributes.
+	value                 *string
+	checked, checkedDirty bool
+	selected, selectDirty bool
+
+	// cached objects, so that repeated access yields equal values.
+	childNodes, children *nodeList
+	classList            *tokenList
+	dataset              *stringMap
+	style                *style
+}
+
+func newNode(typ nodeType) *node {
+	return &node{typ: typ}
+}
+
+func newElement(tag, namespace string) *node {
+	n := &node{typ: elementNode, tag: tag, namespace: namespace}
+	if n.isHTML("template") {
+		n.content = newNode(fragmentNode)
+	}
+	return n
+}
+
+// nodeOf returns the node v refers to, or nil if v is not a node.
+func nodeOf(v Value) *node {
+	n, _ := v.ref.(*node)
+	return n
+}
+
+// mustNode returns the node v refers to and throws a TypeError if v is not
+// a node.
+func mustNode(v Value, method string) *node {
+	n := nodeOf(v)
+	if n == nil {
+		throw("TypeError", "Failed to execute '"+method+
+			"' on 'Node': parameter is not of type 'Node'.")
+	}
+	return n
+}
+
+func (n *node) val() Value {
+	if n == nil {
+		return Null()
+	}
+	return Value{n}
+}
+
+func (n *node) isHTML(tag string) bool {
+	return n.typ == elementNode && n.namespace == "" && n.tag == tag
+}
+
+func (n *node) isContainer() bool {
+	return n.typ == elementNode || n.typ == documentNode || n.typ == fragmentNode
+}
+
+func (n *node) className() string {
+	switch n.typ {
+	case textNode:
+		return "Text"
+	case commentNode:
+		return "Comment"
+	case documentNode:
+		return "HTMLDocument"
+	case doctypeNode:
+		return "DocumentType"
+	case fragmentNode:
+		return "DocumentFragment"
+	}
+	switch n.namespace {
+	case "svg":
+		return "SVGElement"
+	case "":
+		if name, ok := elementClasses[n.tag]; ok {
+			return "HTML" + name + "Element"
+		}
+		return "HTMLElement"
+	default:
+		return "Element"
+	}
+}
+
+var elementClasses = map[string]string{
+	"a": "Anchor", "body": "Body", "br": "BR", "button": "Button",
+	"div": "Div", "fieldset": "FieldSet", "form": "Form", "h1": "Heading",
+	"h2": "Heading", "h3": "Heading", "h4": "Heading", "h5": "Heading",
+	"h6": "Heading", "head": "Head", "hr": "HR", "html": "Html",
+	"img": "Image", "input": "Input", "label": "Label", "li": "LI",
+	"ol": "OList", "option": "Option", "p": "Paragraph", "select": "Select",
+	"span": "Span", "table": "Table", "tbody": "TableSection",
+	"td": "TableCell", "template": "Template", "textarea": "TextArea",
+	"th": "TableCell", "thead": "TableSection", "tr": "TableRow",
+	"ul": "UList",
+}
+
+// nodeName returns the value of the nodeName property.
+func (n *node) nodeName() string {
+	switch n.typ {
+	case textNode:
+		return "#text"
+	case commentNode:
+		return "#comment"
+	case documentNode:
+		return "#document"
+	case fragmentNode:
+		return "#document-fragment"
+	case doctypeNode:
+		return n.tag
+	}
+	if n.namespace == "" {
+		return strings.ToUpper(n.tag)
+	}
+	return n.tag
+}
+
+// root returns the topmost ancestor of n.
+func (n *node) root() *node {
+	for n.parent != nil {
+		n = n.parent
+	}
+	return n
+}
+
+// isInclusiveAncestorOf checks whether n is other or one of its ancestors.
+func (n *node) isInclusiveAncestorOf(other *node) bool {
+	for ; other != nil; other = other.parent {
+		if other == n {
+			return true
+		}
+	}
+	return false
+}
+
+// walk calls fn for n and all of its descendants in tree order until fn
+// returns false. Does not descend into template contents.
+func (n *node) walk(fn func(*node) bool) bool {
+	if !fn(n) {
+		return false
+	}
+	for c := n.first; c != nil; c = c.next {
+		if !c.walk(fn) {
+			return false
+		}
+	}
+	return true
+}
+
+// descendants returns all descendant elements of n in tree order that match
+// the given filter.
+func (n *node) descendants(filter func(*node) bool) []*node {
+	var ret []*node
+	for c := n.first; c != nil; c = c.next {
+		c.walk(func(d *node) bool {
+			if d.typ == elementNode && filter(d) {
+				ret = append(ret, d)
+			}
+			return true
+		})
+	}
+	return ret
+}
+
+// closestHTML returns the closest inclusive ancestor of n that is an HTML
+// element with the given tag.
+func (n *node) closestHTML(tag string) *node {
+	for cur := n; cur != nil; cur = cur.parent {
+		if cur.isHTML(tag) {
+			return cur
+		}
+	}
+	return nil
+}
+
+func (n *node) textContent() string {
+	switch n.typ {
+	case textNode, commentNode:
+		return n.data
+	case elementNode, fragmentNode:
+		var b strings.Builder
+		n.walk(func(d *node) bool {
+			if d.typ == textNode {
+				b.WriteString(d.data)
+			}
+			return true
+		})
+		return b.String()
+	}
+	return ""
+}
+
+func (n *node) setTextContent(text string) {
+	switch n.typ {
+	case textNode, commentNode:
+		n.data = text
+	case elementNode, fragmentNode:
+		n.removeAll()
+		if text != "" {
+			t := newNode(textNode)
+			t.data = text
+			n.insert(t, nil)
+		}
+	}
+}
+
+// detach removes n from its parent.
+func (n *node) detach() {
+	p := n.parent
+	if p == nil {
+		return
+	}
+	if n.prev == nil {
+		p.first = n.next
+	} else {
+		n.prev.next = n.next
+	}
+	if n.next == nil {
+		p.last = n.prev
+	} else {
+		n.next.prev = n.prev
+	}
+	n.parent, n.prev, n.next = nil, nil, nil
+}
+
+// insert inserts the detached node c into n before ref, or at the end if ref
+// is nil.
+func (n *node) insert(c, ref *node) {
+	c.parent = n
+	if ref == nil {
+		c.prev = n.last
+		if n.last == nil {
+			n.first = c
+		} else {
+			n.last.next = c
+		}
+		n.last = c
+	} else {
+		c.prev, c.next = ref.prev, ref
+		if ref.prev == nil {
+			n.first = c
+		} else {
+			ref.prev.next = c
+		}
+		ref.prev = c
+	}
+}
+
+func (n *node) removeAll() {
+	for n.first != nil {
+		n.first.detach()
+	}
+}
+
+// insertBefore implements Node.insertBefore. Document fragments are inserted
+// by moving their children.
+func (n *node) insertBefore(c, ref *node) {
+	if !n.isContainer() {
+		throw("HierarchyRequestError", "this node type does not support children")
+	}
+	if c.isInclusiveAncestorOf(n) {
+		throw("HierarchyRequestError",
+			"the new child element contains the parent")
+	}
+	if ref != nil && ref.parent != n {
+		throw("NotFoundError",
+			"the node before which the new node is to be inserted is not a child of this node")
+	}
+	if c.typ == documentNode {
+		throw("HierarchyRequestError", "cannot insert a document")
+	}
+	if ref == c {
+		ref = c.next
+	}
+	if c.typ == fragmentNode {
+		for c.first != nil {
+			child := c.first
+			child.detach()
+			n.insert(child, ref)
+		}
+		return
+	}
+	c.detach()
+	n.insert(c, ref)
+}
+
+// optionalNode returns the node v refers to, or nil if v is null or
+// undefined.
+func optionalNode(v Value, method string) *node {
+	if v.IsNull() || v.IsUndefined() {
+		return nil
+	}
+	return mustNode(v, method)
+}
+
+// clone implements Node.cloneNode.
+func (n *node) clone(deep bool) *node {
+	c := &node{typ: n.typ, tag: n.tag, namespace: n.namespace, data: n.data,
+		attrs: append([]attribute(nil), n.attrs...),
+		value: n.value, checked: n.checked, checkedDirty: n.checkedDirty,
+		selected: n.selected, selectDirty: n.selectDirty}
+	if n.content != nil {
+		c.content = newNode(fragmentNode)
+		if deep {
+			for child := n.content.first; child != nil; child = child.next {
+				c.content.insert(child.clone(true), nil)
+			}
+		}
+	}
+	if deep {
+		for child := n.first; child != nil; child = child.next {
+			c.insert(child.clone(true), nil)
+		}
+	}
+	return c
+}
+
+func (n *node) attrIndex(name string) int {
+	if n.namespace == "" {
+		name = strings.ToLower(name)
+	}
+	for i, a := range n.attrs {
+		qualified := a.key
+		if a.namespace != "" {
+			qualified = a.namespace + ":" + a.key
+		}
+		if qualified == name {
+			return i
+		}
+	}
+	return -1
+}
+
+func (n *node) getAttr(name string) (string, bool) {
+	if i := n.attrIndex(name); i != -1 {
+		return n.attrs[i].value, true
+	}
+	return "", false
+}
+
+func (n *node) attr(name string) string {
+	value, _ := n.getAttr(name)
+	return value
+}
+
+func (n *node) hasAttr(name string) bool {
+	return n.attrIndex(name) != -1
+}
+
+func (n *node) setAttr(name, value string) {
+	if i := n.attrIndex(name); i != -1 {
+		n.attrs[i].value = value
+		return
+	}
+	if n.namespace == "" {
+		name = strings.ToLower(name)
+	}
+	n.attrs = append(n.attrs, attribute{key: name, value: value})
+}
+
+func (n *node) removeAttr(name string) {
+	if i := n.attrIndex(name); i != -1 {
+		n.attrs = append(n.attrs[:i], n.attrs[i+1:]...)
+	}
+}
+
+func (n *node) setBoolAttr(name string, value bool) {
+	if value {
+		if !n.hasAttr(name) {
+			n.setAttr(name, "")
+		}
+	} else {
+		n.removeAttr(name)
+	}
+}
+
+// reflected string attributes, by property name.
+var stringAttrs = map[string]string{
+	"id": "id", "className": "class", "name": "name", "title": "title",
+	"href": "href", "src": "src", "alt": "alt", "placeholder": "placeholder",
+	"htmlFor": "for", "lang": "lang", "min": "min", "max": "max",
+	"step": "step", "pattern": "pattern", "action": "action",
+	"method": "method", "target": "target", "rel": "rel", "slot": "slot",
+}
+
+// reflected boolean attributes, by property name.
+var boolAttrs = map[string]string{
+	"disabled": "disabled", "hidden": "hidden", "required": "required",
+	"readOnly": "readonly", "multiple": "multiple", "autofocus": "autofocus",
+	"defaultChecked": "checked", "defaultSelected": "selected",
+	"noValidate": "novalidate", "open": "open",
+}
+
+func (n *node) get(name string) Value {
+	if v, ok := n.getCommon(name); ok {
+		return v
+	}
+	switch n.typ {
+	case elementNode:
+		if v, ok := n.getElement(name); ok {
+			return v
+		}
+	case documentNode:
+		if v, ok := n.getDocument(name); ok {
+			return v
+		}
+	case textNode, commentNode:
+		switch name {
+		case "data", "nodeValue":
+			return ValueOf(n.data)
+		case "length":
+			return ValueOf(len([]rune(n.data)))
+		}
+	case doctypeNode:
+		if name == "name" {
+			return ValueOf(n.tag)
+		}
+	}
+	return n.props[name]
+}
+
+// getCommon implements the properties and methods that are shared by all
+// nodes.
+func (n *node) getCommon(name string) (Value, bool) {
+	switch name {
+	case "nodeType":
+		return ValueOf(int(n.typ)), true
+	case "nodeName":
+		return ValueOf(n.nodeName()), true
+	case "ownerDocument":
+		if n.typ == documentNode {
+			return Null(), true
+		}
+		return Value{document}, true
+	case "isConnected":
+		return ValueOf(n.root() == document), true
+	case "parentNode":
+		return n.parent.val(), true
+	case "parentElement":
+		if n.parent != nil && n.parent.typ == elementNode {
+			return Value{n.parent}, true
+		}
+		return Null(), true
+	case "childNodes":
+		if n.childNodes == nil {
+			n.childNodes = &nodeList{parent: n}
+		}
+		return Value{n.childNodes}, true
+	case "firstChild":
+		return n.first.val(), true
+	case "lastChild":
+		return n.last.val(), true
+	case "nextSibling":
+		return n.next.val(), true
+	case "previousSibling":
+		return n.prev.val(), true
+	case "textContent":
+		if n.typ == documentNode || n.typ == doctypeNode {
+			return Null(), true
+		}
+		return ValueOf(n.textContent()), true
+	case "appendChild":
+		return method(func(args []Value) Value {
+			n.insertBefore(mustNode(arg(args, 0), "appendChild"), nil)
+			return arg(args, 0)
+		}), true
+	case "insertBefore":
+		return method(func(args []Value) Value {
+			n.insertBefore(mustNode(arg(args, 0), "insertBefore"),
+				optionalNode(arg(args, 1), "insertBefore"))
+			return arg(args, 0)
+		}), true
+	case "removeChild":
+		return method(func(args []Value) Value {
+			c := mustNode(arg(args, 0), "removeChild")
+			if c.parent != n {
+				throw("NotFoundError",
+					"the node to be removed is not a child of this node")
+			}
+			c.detach()
+			return arg(args, 0)
+		}), true
+	case "replaceChild":
+		return method(func(args []Value) Value {
+			c := mustNode(arg(args, 0), "replaceChild")
+			old := mustNode(arg(args, 1), "replaceChild")
+			if old.parent != n {
+				throw("NotFoundError",
+					"the node to be replaced is not a child of this node")
+			}
+			if c != old {
+				ref := old.next
+				if ref == c {
+					ref = c.next
+				}
+				old.detach()
+				n.insertBefore(c, ref)
+			}
+			return arg(args, 1)
+		}), true
+	case "remove":
+		return method(func(args []Value) Value {
+			n.detach()
+			return Undefined()
+		}), true
+	case "contains":
+		return method(func(args []Value) Value {
+			other := nodeOf(arg(args, 0))
+			return ValueOf(other != nil && n.isInclusiveAncestorOf(other))
+		}), true
+	case "hasChildNodes":
+		return method(func(args []Value) Value {
+			return ValueOf(n.first != nil)
+		}), true
+	case "cloneNode":
+		return method(func(args []Value) Value {
+			return Value{n.clone(arg(args, 0).Truthy())}
+		}), true
+	case "isSameNode":
+		return method(func(args []Value) Value {
+			return ValueOf(nodeOf(arg(args, 0)) == n)
+		}), true
+	case "addEventListener":
+		return method(func(args []Value) Value {
+			n.addEventListener(args)
+			return Undefined()
+		}), true
+	case "removeEventListener":
+		return method(func(args []Value) Value {
+			n.removeEventListener(args)
+			return Undefined()
+		}), true
+	case "dispatchEvent":
+		return method(func(args []Value) Value {
+			e, ok := arg(args, 0).ref.(*event)
+			if !ok {
+				throw("TypeError", "parameter 1 is not of type 'Event'")
+			}
+			return ValueOf(n.dispatch(e))
+		}), true
+	}
+	if n.isContainer() {
+		return n.getParentNode(name)
+	}
+	return Value{}, false
+}
+
+// getParentNode implements the properties and methods of elements,
+// documents and document fragments.
+func (n *node) getParentNode(name string) (Value, bool) {
+	switch name {
+	case "children":
+		if n.children == nil {
+			n.children = &nodeList{parent: n, elements: true}
+		}
+		return Value{n.children}, true
+	case "childElementCount":
+		return ValueOf(len(n.elementChildren())), true
+	case "firstElementChild":
+		for c := n.first; c != nil; c = c.next {
+			if c.typ == elementNode {
+				return Value{c}, true
+			}
+		}
+		return Null(), true
+	case "lastElementChild":
+		for c := n.last; c != nil; c = c.prev {
+			if c.typ == elementNode {
+				return Value{c}, true
+			}
+		}
+		return Null(), true
+	case "append", "prepend":
+		return method(func(args []Value) Value {
+			ref := n.first
+			if name == "append" {
+				ref = nil
+			}
+			for _, a := range args {
+				c := nodeOf(a)
+				if c == nil {
+					c = newNode(textNode)
+					c.data = toString(a)
+				}
+				n.insertBefore(c, ref)
+			}
+			return Undefined()
+		}), true
+	case "replaceChildren":
+		return method(func(args []Value) Value {
+			n.removeAll()
+			for _, a := range args {
+				c := nodeOf(a)
+				if c == nil {
+					c = newNode(textNode)
+					c.data = toString(a)
+				}
+				n.insertBefore(c, nil)
+			}
+			return Undefined()
+		}), true
+	case "querySelector":
+		return method(func(args []Value) Value {
+			sel := parseSelector(toString(arg(args, 0)))
+			for _, d := range n.descendants(sel.matches) {
+				return Value{d}
+			}
+			return Null()
+		}), true
+	case "querySelectorAll":
+		return method(func(args []Value) Value {
+			sel := parseSelector(toString(arg(args, 0)))
+			return Value{&staticNodeList{items: n.descendants(sel.matches)}}
+		}), true
+	case "getElementsByTagName":
+		return method(func(args []Value) Value {
+			tag := toString(arg(args, 0))
+			return Value{&staticNodeList{items: n.descendants(func(d *node) bool {
+				return tag == "*" || strings.EqualFold(d.tag, tag)
+			})}}
+		}), true
+	case "getElementsByClassName":
+		return method(func(args []Value) Value {
+			classes := strings.Fields(toString(arg(args, 0)))
+			return Value{&staticNodeList{items: n.descendants(func(d *node) bool {
+				for _, c := range classes {
+					if !d.hasClass(c) {
+						return false
+					}
+				}
+				return len(classes) > 0
+			})}}
+		}), true
+	}
+	return Value{}, false
+}
+
+func (n *node) elementChildren() []*node {
+	var ret []*node
+	for c := n.first; c != nil; c = c.next {
+		if c.typ == elementNode {
+			ret = append(ret, c)
+		}
+	}
+	return ret
+}
+
+func (n *node) hasClass(name string) bool {
+	for _, c := range strings.Fields(n.attr("class")) {
+		if c == name {
+			return true
+		}
+	}
+	return false
+}
+
+func (n *node) set(name string, value Value) {
+	switch name {
+	case "textContent":
+		if n.typ != documentNode && n.typ != doctypeNode {
+			n.setTextContent(stringOrEmpty(value))
+		}
+		return
+	case "data", "nodeValue":
+		if n.typ == textNode || n.typ == commentNode {
+			n.data = stringOrEmpty(value)
+			return
+		}
+	}
+	if n.typ == elementNode && n.setElement(name, value) {
+		return
+	}
+	if n.props == nil {
+		n.props = make(map[string]Value)
+	}
+	n.props[name] = value
+}
+
+func (n *node) delete(name string) {
+	delete(n.props, name)
+}
+
+// stringOrEmpty converts v to a string, treating null as the empty string.
+func stringOrEmpty(v Value) string {
+	if v.IsNull() {
+		return ""
+	}
+	return toString(v)
+}
+
+// nodeList is a live NodeList of a node's children.
+type nodeList struct {
+	parent   *node
+	elements bool
+}
+
+func (l *nodeList) className() string {
+	if l.elements {
+		return "HTMLCollection"
+	}
+	return "NodeList"
+}
+
+func (l *nodeList) items() []*node {
+	if l.elements {
+		return l.parent.elementChildren()
+	}
+	var ret []*node
+	for c := l.parent.first; c != nil; c = c.next {
+		ret = append(ret, c)
+	}
+	return ret
+}
+
+func (l *nodeList) get(name string) Value {
+	return getList(l, l.items, name)
+}
+
+func (l *nodeList) set(name string, value Value) {}
+
+func (l *nodeList) index(i int) Value {
+	if i < 0 {
+		return Undefined()
+	}
+	cur := l.parent.first
+	for ; cur != nil; cur = cur.next {
+		if l.elements && cur.typ != elementNode {
+			continue
+		}
+		if i == 0 {
+			return Value{cur}
+		}
+		i--
+	}
+	return Undefined()
+}
+
+func (l *nodeList) setIndex(i int, value Value) {}
+
+// staticNodeList is a NodeList that does not change when the DOM changes.
+type staticNodeList struct {
+	items []*node
+}
+
+func (l *staticNodeList) className() string {
+	return "NodeList"
+}
+
+func (l *staticNodeList) get(name string) Value {
+	return getList(l, func() []*node { return l.items }, name)
+}
+
+func (l *staticNodeList) set(name string, value Value) {}
+
+func (l *staticNodeList) index(i int) Value {
+	if i < 0 || i >= len(l.items) {
+		return Undefined()
+	}
+	return Value{l.items[i]}
+}
+
+func (l *staticNodeList) setIndex(i int, value Value) {}
+
+// getList implements the properties of node lists.
+func getList(l indexer, items func() []*node, name string) Value {
+	switch name {
+	case "length":
+		return ValueOf(len(items()))
+	case "item":
+		return method(func(args []Value) Value {
+			ret := l.index(int(toNumber(arg(args, 0))))
+			if ret.IsUndefined() {
+				return Null()
+			}
+			return ret
+		})
+	case "forEach":
+		return method(func(args []Value) Value {
+			for i, item := range items() {
+				arg(args, 0).Invoke(Value{item}, i)
+			}
+			return Undefined()
+		})
+	}
+	return Undefined()
+}
@@ -0,0 +1,157 @@
+//go:build !js
+// +build !js
+
+package dom
+
+import (
+	"strings"
+	"testing"
+)
+
+func doc() Value {
+	return Global().Get("document")
+}
+
+func create(t *testing.T, source string) Value {
+	t.Helper()
+	tmpl := doc().Call("createElement", "template")
+	tmpl.Set("innerHTML", source)
+	return tmpl.Get("content").Get("firstChild")
+}
+
+// names returns the node names of the children of v, separated by spaces.
+func names(v Value) string {
+	var ret []string
+	for c := v.Get("firstChild"); !c.IsNull(); c = c.Get("nextSibling") {
+		ret = append(ret, c.Get("nodeName").String())
+	}
+	return strings.Join(ret, " ")
+}
+
+func expectThrow(t *testing.T, name string, fn func()) {
+	t.Helper()
+	defer func() {
+		t.Helper()
+		e, ok := recover().(Error)
+		if !ok {
+			t.Errorf("expected %s to be thrown", name)
+		} else if actual := e.Get("name").String(); actual != name {
+			t.Errorf("expected %s to be thrown, got %s", name, actual)
+		}
+	}()
+	fn()
+}
+
+func TestInsertBefore(t *testing.T) {
+	Reset()
+	parent := create(t, "<div><a></a><b></b></div>")
+	a, b := parent.Get("firstChild"), parent.Get("lastChild")
+	i := doc().Call("createElement", "i")
+	parent.Call("insertBefore", i, b)
+	if n := names(parent); n != "A I B" {
+		t.Errorf("unexpected children after insertBefore: %s", n)
+	}
+	if !i.Get("previousSibling").Equal(a) || !i.Get("nextSibling").Equal(b) ||
+		!i.Get("parentNode").Equal(parent) {
+		t.Error("inserted node is not linked properly")
+	}
+
+	// a node that is already in the tree is moved
+	parent.Call("insertBefore", b, a)
+	if n := names(parent); n != "B A I" {
+		t.Errorf("unexpected children after moving: %s", n)
+	}
+	// null reference node appends
+	parent.Call("insertBefore", b, Null())
+	if n := names(parent); n != "A I B" {
+		t.Errorf("unexpected children after appending: %s", n)
+	}
+
+	// the children of a fragment are inserted, leaving it empty
+	frag := doc().Call("createDocumentFragment")
+	frag.Call("appendChild", doc().Call("createElement", "p"))
+	frag.Call("appendChild", doc().Call("createTextNode", "x"))
+	parent.Call("insertBefore", frag, i)
+	if n := names(parent); n != "A P #text I B" {
+		t.Errorf("unexpected children after inserting fragment: %s", n)
+	}
+	if frag.Get("childNodes").Length() != 0 {
+		t.Error("fragment is not empty after insertion")
+	}
+
+	expectThrow(t, "HierarchyRequestError", func() {
+		a.Call("insertBefore", parent, Null())
+	})
+	expectThrow(t, "NotFoundError", func() {
+		a.Call("insertBefore", doc().Call("createElement", "p"), b)
+	})
+}
+
+func TestRemoveAndReplace(t *testing.T) {
+	Reset()
+	parent := create(t, "<ul><li>1</li><li>2</li><li>3</li></ul>")
+	second := parent.Get("children").Index(1)
+	parent.Call("removeChild", second)
+	if parent.Get("textContent").String() != "13" ||
+		!second.Get("parentNode").IsNull() {
+		t.Errorf("unexpected content after removeChild: %s",
+			parent.Get("textContent"))
+	}
+	parent.Call("replaceChild", second, parent.Get("firstChild"))
+	if parent.Get("textContent").String() != "23" {
+		t.Errorf("unexpected content after replaceChild: %s",
+			parent.Get("textContent"))
+	}
+	parent.Get("lastChild").Call("remove")
+	if parent.Get("outerHTML").String() != "<ul><li>2</li></ul>" {
+		t.Errorf("unexpected content after remove: %s", parent.Get("outerHTML"))
+	}
+}
+
+func TestCloneNode(t *testing.T) {
+	Reset()
+	orig := create(t, `<div class="a" data-x="1"><p>text<!--c--></p></div>`)
+	handled := false
+	orig.Call("addEventListener", "click", FuncOf(func(this Value, args []Value) interface{} {
+		handled = true
+		return nil
+	}))
+
+	shallow := orig.Call("cloneNode")
+	if shallow.Get("outerHTML").String() != `<div class="a" data-x="1"></div>` {
+		t.Errorf("unexpected shallow clone: %s", shallow.Get("outerHTML"))
+	}
+	deep := orig.Call("cloneNode", true)
+	if deep.Get("outerHTML").String() != orig.Get("outerHTML").String() {
+		t.Errorf("unexpected deep clone: %s", deep.Get("outerHTML"))
+	}
+	if !deep.Get("parentNode").IsNull() {
+		t.Error("clone must not have a parent")
+	}
+
+	// clones are independent of the original
+	deep.Get("classList").Call("add", "b")
+	deep.Get("firstChild").Get("firstChild").Set("data", "changed")
+	if orig.Get("outerHTML").String() != `<div class="a" data-x="1"><p>text<!--c--></p></div>` {
+		t.Errorf("original has been modified: %s", orig.Get("outerHTML"))
+	}
+	// listeners are not cloned
+	deep.Call("click")
+	if handled {
+		t.Error("listener has been cloned")
+	}
+
+	// the content of templates is cloned
+	tmpl := create(t, "<template><span></span></template>")
+	clone := tmpl.Call("cloneNode", true)
+	if names(clone.Get("content")) != "SPAN" {
+		t.Errorf("template content has not been cloned: %s", clone.Get("innerHTML"))
+	}
+
+	// form control state is cloned
+	input := create(t, `<input value="default">`)
+	input.Set("value", "current")
+	if v := input.Call("cloneNode").Get("value").String(); v != "current" {
+		t.Errorf("unexpected value of cloned input: %s", v)
+	}
+}
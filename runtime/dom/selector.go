@@ -0,0 +1,254 @@
+//go:build !js
+// +build !js
+
+package dom
+
+import "strings"
+
+// selector is a list of complex selectors, separated by commas in CSS.
+//
+// Supported are type, universal, id, class and attribute selectors
+// ([a], [a=v], [a~=v], [a^=v], [a$=v], [a*=v]) combined with the
+// descendant, child, next-sibling and subsequent-sibling combinators.
+type selector []complexSelector
+
+// complexSelector is a sequence of compound selectors. combinators[i] is
+// the combinator between compounds[i] and compounds[i+1].
+type complexSelector struct {
+	compounds   []compoundSelector
+	combinators []byte
+}
+
+type attrSelector struct {
+	name, op, value string
+}
+
+type compoundSelector struct {
+	tag     string
+	id      string
+	classes []string
+	attrs   []attrSelector
+}
+
+type selectorParser struct {
+	source string
+	pos    int
+}
+
+func (p *selectorParser) fail() {
+	throw("SyntaxError", "'"+p.source+"' is not a valid selector")
+}
+
+func (p *selectorParser) skipSpace() bool {
+	start := p.pos
+	for p.pos < len(p.source) && strings.IndexByte(" \t\n\r\f", p.source[p.pos]) != -1 {
+		p.pos++
+	}
+	return p.pos > start
+}
+
+func isIdentChar(c byte) bool {
+	return c == '-' || c == '_' || c >= 0x80 || (c >= 'a' && c <= 'z') ||
+		(c >= 'A' && c <= 'Z') || (c >= '0' && c <= '9')
+}
+
+func (p *selectorParser) ident() string {
+	start := p.pos
+	for p.pos < len(p.source) && isIdentChar(p.source[p.pos]) {
+		p.pos++
+	}
+	if p.pos == start {
+		p.fail()
+	}
+	return p.source[start:p.pos]
+}
+
+func (p *selectorParser) attr() attrSelector {
+	p.skipSpace()
+	ret := attrSelector{name: strings.ToLower(p.ident())}
+	p.skipSpace()
+	if p.pos >= len(p.source) {
+		p.fail()
+	}
+	if p.source[p.pos] != ']' {
+		if strings.IndexByte("~^$*|", p.source[p.pos]) != -1 {
+			ret.op = p.source[p.pos : p.pos+1]
+			p.pos++
+		}
+		if p.pos >= len(p.source) || p.source[p.pos] != '=' {
+			p.fail()
+		}
+		ret.op += "="
+		p.pos++
+		p.skipSpace()
+		if p.pos < len(p.source) && (p.source[p.pos] == '"' || p.source[p.pos] == '\'') {
+			end := strings.IndexByte(p.source[p.pos+1:], p.source[p.pos])
+			if end == -1 {
+				p.fail()
+			}
+			ret.value = p.source[p.pos+1 : p.pos+1+end]
+			p.pos += end + 2
+		} else {
+			ret.value = p.ident()
+		}
+		p.skipSpace()
+		if p.pos >= len(p.source) || p.source[p.pos] != ']' {
+			p.fail()
+		}
+	}
+	p.pos++
+	return ret
+}
+
+func (p *selectorParser) compound() compoundSelector {
+	var ret compoundSelector
+	start := p.pos
+	if p.pos < len(p.source) && p.source[p.pos] == '*' {
+		p.pos++
+	} else if p.pos < len(p.source) && isIdentChar(p.source[p.pos]) {
+		ret.tag = strings.ToLower(p.ident())
+	}
+	for p.pos < len(p.source) {
+		switch p.source[p.pos] {
+		case '#':
+			p.pos++
+			ret.id = p.ident()
+		case '.':
+			p.pos++
+			ret.classes = append(ret.classes, p.ident())
+		case '[':
+			p.pos++
+			ret.attrs = append(ret.attrs, p.attr())
+		default:
+			if p.pos == start {
+				p.fail()
+			}
+			return ret
+		}
+	}
+	return ret
+}
+
+func parseSelector(source string) selector {
+	p := selectorParser{source: source}
+	var ret selector
+	for {
+		var cur complexSelector
+		p.skipSpace()
+		cur.compounds = append(cur.compounds, p.compound())
+		for {
+			space := p.skipSpace()
+			if p.pos >= len(p.source) || p.source[p.pos] == ',' {
+				break
+			}
+			combinator := byte(' ')
+			if c := p.source[p.pos]; c == '>' || c == '+' || c == '~' {
+				combinator = c
+				p.pos++
+				p.skipSpace()
+			} else if !space {
+				p.fail()
+			}
+			cur.combinators = append(cur.combinators, combinator)
+			cur.compounds = append(cur.compounds, p.compound())
+		}
+		ret = append(ret, cur)
+		if p.pos >= len(p.source) {
+			return ret
+		}
+		p.pos++
+	}
+}
+
+func (a attrSelector) matches(n *node) bool {
+	value, ok := n.getAttr(a.name)
+	if !ok {
+		return false
+	}
+	switch a.op {
+	case "=":
+		return value == a.value
+	case "~=":
+		return contains(strings.Fields(value), a.value)
+	case "^=":
+		return a.value != "" && strings.HasPrefix(value, a.value)
+	case "$=":
+		return a.value != "" && strings.HasSuffix(value, a.value)
+	case "*=":
+		return a.value != "" && strings.Contains(value, a.value)
+	case "|=":
+		return value == a.value || strings.HasPrefix(value, a.value+"-")
+	}
+	return true
+}
+
+func (c *compoundSelector) matches(n *node) bool {
+	if n.typ != elementNode {
+		return false
+	}
+	if c.tag != "" && !strings.EqualFold(c.tag, n.tag) {
+		return false
+	}
+	if c.id != "" && n.attr("id") != c.id {
+		return false
+	}
+	for _, class := range c.classes {
+		if !n.hasClass(class) {
+			return false
+		}
+	}
+	for _, a := range c.attrs {
+		if !a.matches(n) {
+			return false
+		}
+	}
+	return true
+}
+
+func previousElement(n *node) *node {
+	for cur := n.prev; cur != nil; cur = cur.prev {
+		if cur.typ == elementNode {
+			return cur
+		}
+	}
+	return nil
+}
+
+// matchesAt checks whether n matches the compounds up to index i.
+func (c *complexSelector) matchesAt(n *node, i int) bool {
+	if !c.compounds[i].matches(n) {
+		return false
+	}
+	if i == 0 {
+		return true
+	}
+	switch c.combinators[i-1] {
+	case '>':
+		return n.parent != nil && c.matchesAt(n.parent, i-1)
+	case '+':
+		prev := previousElement(n)
+		return prev != nil && c.matchesAt(prev, i-1)
+	case '~':
+		for prev := previousElement(n); prev != nil; prev = previousElement(prev) {
+			if c.matchesAt(prev, i-1) {
+				return true
+			}
+		}
+	default:
+		for anc := n.parent; anc != nil; anc = anc.parent {
+			if c.matchesAt(anc, i-1) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func (s selector) matches(n *node) bool {
+	for i := range s {
+		if s[i].matchesAt(n, len(s[i].compounds)-1) {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,712 @@
+//go:build !js
+// +build !js
+
+package dom
+
+import (
+	"math"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// Type is the type of a JavaScript value.
+type Type int
+
+// The types of JavaScript values.
+const (
+	TypeUndefined Type = iota
+	TypeNull
+	TypeBoolean
+	TypeNumber
+	TypeString
+	TypeSymbol
+	TypeObject
+	TypeFunction
+)
+
+func (t Type) String() string {
+	switch t {
+	case TypeUndefined:
+		return "undefined"
+	case TypeNull:
+		return "null"
+	case TypeBoolean:
+		return "boolean"
+	case TypeNumber:
+		return "number"
+	case TypeString:
+		return "string"
+	case TypeSymbol:
+		return "symbol"
+	case TypeObject:
+		return "object"
+	case TypeFunction:
+		return "function"
+	default:
+		panic("bad type")
+	}
+}
+
+func (t Type) isObject() bool {
+	return t == TypeObject || t == TypeFunction
+}
+
+// object is implemented by everything that is a JavaScript object.
+type object interface {
+	get(name string) Value
+	set(name string, value Value)
+}
+
+// deleter is implemented by objects that support deleting properties.
+type deleter interface {
+	delete(name string)
+}
+
+// indexer is implemented by array-like objects.
+type indexer interface {
+	index(i int) Value
+	setIndex(i int, value Value)
+}
+
+// className is implemented by objects that have a class name other than
+// Object, which is used when converting the object to a string.
+type className interface {
+	className() string
+}
+
+type null struct{}
+
+// Value is a JavaScript value in the headless DOM.
+//
+// Its API mirrors syscall/js.Value. The zero Value is undefined.
+type Value struct {
+	// nil, null, bool, float64, string or object.
+	ref interface{}
+}
+
+// Error wraps a JavaScript error.
+type Error struct {
+	// Value is the underlying JavaScript error value.
+	Value
+}
+
+// Error implements the error interface.
+func (e Error) Error() string {
+	return "JavaScript error: " + e.Get("message").String()
+}
+
+// ValueError occurs when a Value method is invoked on a value that does not
+// support it.
+type ValueError struct {
+	Method string
+	Type   Type
+}
+
+func (e *ValueError) Error() string {
+	return "syscall/js: call of " + e.Method + " on " + e.Type.String()
+}
+
+// throw panics with a JavaScript error of the given kind.
+func throw(name, message string) {
+	err := newPlainObject()
+	err.set("name", ValueOf(name))
+	err.set("message", ValueOf(message))
+	panic(Error{Value{err}})
+}
+
+// Undefined returns the JavaScript value "undefined".
+func Undefined() Value {
+	return Value{}
+}
+
+// Null returns the JavaScript value "null".
+func Null() Value {
+	return Value{null{}}
+}
+
+// ValueOf returns x as a JavaScript value:
+//
+//	| Go                     | JavaScript             |
+//	| ---------------------- | ---------------------- |
+//	| dom.Value              | [its value]            |
+//	| dom.Func               | function               |
+//	| nil                    | null                   |
+//	| bool                   | boolean                |
+//	| integers and floats    | number                 |
+//	| string                 | string                 |
+//	| []interface{}          | new array              |
+//	| map[string]interface{} | new object             |
+//
+// Panics if x is not one of the expected types.
+func ValueOf(x interface{}) Value {
+	switch x := x.(type) {
+	case Value:
+		return x
+	case Func:
+		return x.Value
+	case nil:
+		return Null()
+	case bool:
+		return Value{x}
+	case int:
+		return Value{float64(x)}
+	case int8:
+		return Value{float64(x)}
+	case int16:
+		return Value{float64(x)}
+	case int32:
+		return Value{float64(x)}
+	case int64:
+		return Value{float64(x)}
+	case uint:
+		return Value{float64(x)}
+	case uint8:
+		return Value{float64(x)}
+	case uint16:
+		return Value{float64(x)}
+	case uint32:
+		return Value{float64(x)}
+	case uint64:
+		return Value{float64(x)}
+	case uintptr:
+		return Value{float64(x)}
+	case float32:
+		return Value{float64(x)}
+	case float64:
+		return Value{x}
+	case string:
+		return Value{x}
+	case []interface{}:
+		a := &array{}
+		for _, item := range x {
+			a.items = append(a.items, ValueOf(item))
+		}
+		return Value{a}
+	case map[string]interface{}:
+		o := newPlainObject()
+		for k, v := range x {
+			o.set(k, ValueOf(v))
+		}
+		return Value{o}
+	default:
+		panic("ValueOf: invalid value")
+	}
+}
+
+func valuesOf(args []interface{}) []Value {
+	ret := make([]Value, len(args))
+	for i, arg := range args {
+		ret[i] = ValueOf(arg)
+	}
+	return ret
+}
+
+// Type returns the JavaScript type of the value v.
+func (v Value) Type() Type {
+	switch v.ref.(type) {
+	case nil:
+		return TypeUndefined
+	case null:
+		return TypeNull
+	case bool:
+		return TypeBoolean
+	case float64:
+		return TypeNumber
+	case string:
+		return TypeString
+	case *function:
+		return TypeFunction
+	default:
+		return TypeObject
+	}
+}
+
+func (v Value) object(method string) object {
+	if o, ok := v.ref.(object); ok {
+		return o
+	}
+	if v.Type() == TypeUndefined || v.Type() == TypeNull {
+		throw("TypeError", "cannot access properties of "+toString(v))
+	}
+	panic(&ValueError{Method: method, Type: v.Type()})
+}
+
+// Get returns the JavaScript property p of value v.
+func (v Value) Get(p string) Value {
+	switch r := v.ref.(type) {
+	case string:
+		if p == "length" {
+			return ValueOf(len([]rune(r)))
+		}
+		return Undefined()
+	}
+	return v.object("Value.Get").get(p)
+}
+
+// Set sets the JavaScript property p of value v to ValueOf(x).
+func (v Value) Set(p string, x interface{}) {
+	v.object("Value.Set").set(p, ValueOf(x))
+}
+
+// Delete deletes the JavaScript property p of value v.
+func (v Value) Delete(p string) {
+	if d, ok := v.object("Value.Delete").(deleter); ok {
+		d.delete(p)
+	}
+}
+
+// Index returns JavaScript index i of value v.
+func (v Value) Index(i int) Value {
+	if idx, ok := v.object("Value.Index").(indexer); ok {
+		return idx.index(i)
+	}
+	return v.Get(strconv.Itoa(i))
+}
+
+// SetIndex sets the JavaScript index i of value v to ValueOf(x).
+func (v Value) SetIndex(i int, x interface{}) {
+	if idx, ok := v.object("Value.SetIndex").(indexer); ok {
+		idx.setIndex(i, ValueOf(x))
+		return
+	}
+	v.Set(strconv.Itoa(i), x)
+}
+
+// Length returns the JavaScript property "length" of v.
+func (v Value) Length() int {
+	return v.Get("length").Int()
+}
+
+// Call does a JavaScript call to the method m of value v with the given
+// arguments. Panics if v has no method m.
+func (v Value) Call(m string, args ...interface{}) Value {
+	fn, ok := v.object("Value.Call").get(m).ref.(*function)
+	if !ok {
+		throw("TypeError", m+" is not a function")
+	}
+	return fn.call(v, valuesOf(args))
+}
+
+// Invoke does a JavaScript call of the value v with the given arguments.
+// Panics if v is not a function.
+func (v Value) Invoke(args ...interface{}) Value {
+	fn, ok := v.ref.(*function)
+	if !ok {
+		panic(&ValueError{Method: "Value.Invoke", Type: v.Type()})
+	}
+	return fn.call(Undefined(), valuesOf(args))
+}
+
+// New uses JavaScript's "new" operator with value v as constructor and the
+// given arguments. Panics if v is not a constructor.
+func (v Value) New(args ...interface{}) Value {
+	fn, ok := v.ref.(*function)
+	if !ok {
+		panic(&ValueError{Method: "Value.New", Type: v.Type()})
+	}
+	if fn.construct == nil {
+		throw("TypeError", "not a constructor")
+	}
+	return fn.construct(valuesOf(args))
+}
+
+// InstanceOf reports whether v is an instance of type t according to
+// JavaScript's instanceof operator.
+func (v Value) InstanceOf(t Value) bool {
+	if !v.Type().isObject() {
+		return false
+	}
+	fn, ok := t.ref.(*function)
+	if !ok || fn.instance == nil {
+		throw("TypeError", "right-hand side of 'instanceof' is not callable")
+	}
+	return fn.instance(v)
+}
+
+// Equal reports whether v and w are equal according to JavaScript's ===
+// operator.
+func (v Value) Equal(w Value) bool {
+	if f, ok := v.ref.(float64); ok {
+		g, ok := w.ref.(float64)
+		return ok && f == g
+	}
+	return v.ref == w.ref
+}
+
+// IsUndefined reports whether v is the JavaScript value "undefined".
+func (v Value) IsUndefined() bool {
+	return v.ref == nil
+}
+
+// IsNull reports whether v is the JavaScript value "null".
+func (v Value) IsNull() bool {
+	_, ok := v.ref.(null)
+	return ok
+}
+
+// IsNaN reports whether v is the JavaScript value "NaN".
+func (v Value) IsNaN() bool {
+	f, ok := v.ref.(float64)
+	return ok && math.IsNaN(f)
+}
+
+// Float returns the value v as a float64. Panics if v is not a JavaScript
+// number.
+func (v Value) Float() float64 {
+	f, ok := v.ref.(float64)
+	if !ok {
+		panic(&ValueError{Method: "Value.Float", Type: v.Type()})
+	}
+	return f
+}
+
+// Int returns the value v truncated to an int. Panics if v is not a
+// JavaScript number.
+func (v Value) Int() int {
+	f, ok := v.ref.(float64)
+	if !ok {
+		panic(&ValueError{Method: "Value.Int", Type: v.Type()})
+	}
+	return int(f)
+}
+
+// Bool returns the value v as a bool. Panics if v is not a JavaScript
+// boolean.
+func (v Value) Bool() bool {
+	b, ok := v.ref.(bool)
+	if !ok {
+		panic(&ValueError{Method: "Value.Bool", Type: v.Type()})
+	}
+	return b
+}
+
+// Truthy returns the JavaScript "truthiness" of the value v.
+func (v Value) Truthy() bool {
+	switch r := v.ref.(type) {
+	case nil, null:
+		return false
+	case bool:
+		return r
+	case float64:
+		return r != 0 && !math.IsNaN(r)
+	case string:
+		return r != ""
+	default:
+		return true
+	}
+}
+
+// String returns the value v as a string. Unlike the other getters, String
+// does not panic if v's type is not TypeString. Instead, it returns a string
+// of the form "<T>" or "<T: V>" where T is v's type and V is a string
+// representation of v's value.
+func (v Value) String() string {
+	switch r := v.ref.(type) {
+	case string:
+		return r
+	case nil:
+		return "<undefined>"
+	case null:
+		return "<null>"
+	case bool, float64:
+		return "<" + v.Type().String() + ": " + toString(v) + ">"
+	default:
+		return "<" + v.Type().String() + ">"
+	}
+}
+
+// formatNumber converts f to a string the way JavaScript does.
+func formatNumber(f float64) string {
+	switch {
+	case math.IsNaN(f):
+		return "NaN"
+	case math.IsInf(f, 1):
+		return "Infinity"
+	case math.IsInf(f, -1):
+		return "-Infinity"
+	case f == 0:
+		return "0"
+	}
+	abs := math.Abs(f)
+	if abs >= 1e-6 && abs < 1e21 {
+		return strconv.FormatFloat(f, 'f', -1, 64)
+	}
+	s := strconv.FormatFloat(f, 'e', -1, 64)
+	// JavaScript does not pad the exponent.
+	e := strings.IndexByte(s, 'e')
+	exp := strings.TrimLeft(s[e+2:], "0")
+	return s[:e+2] + exp
+}
+
+// toString converts v to a string the way JavaScript's String() does.
+func toString(v Value) string {
+	switch r := v.ref.(type) {
+	case nil:
+		return "undefined"
+	case null:
+		return "null"
+	case bool:
+		return strconv.FormatBool(r)
+	case float64:
+		return formatNumber(r)
+	case string:
+		return r
+	case *function:
+		return "function () { [native code] }"
+	case *array:
+		items := make([]string, len(r.items))
+		for i, item := range r.items {
+			if item.Type() != TypeUndefined && item.Type() != TypeNull {
+				items[i] = toString(item)
+			}
+		}
+		return strings.Join(items, ",")
+	case className:
+		return "[object " + r.className() + "]"
+	default:
+		return "[object Object]"
+	}
+}
+
+// toNumber converts v to a number the way JavaScript's Number() does.
+func toNumber(v Value) float64 {
+	switch r := v.ref.(type) {
+	case nil:
+		return math.NaN()
+	case null:
+		return 0
+	case bool:
+		if r {
+			return 1
+		}
+		return 0
+	case float64:
+		return r
+	case string:
+		s := strings.TrimSpace(r)
+		switch s {
+		case "":
+			return 0
+		case "Infinity", "+Infinity":
+			return math.Inf(1)
+		case "-Infinity":
+			return math.Inf(-1)
+		}
+		if len(s) > 2 && s[0] == '0' && (s[1] == 'x' || s[1] == 'X') {
+			if i, err := strconv.ParseUint(s[2:], 16, 64); err == nil {
+				return float64(i)
+			}
+			return math.NaN()
+		}
+		// reject the syntax ParseFloat accepts beyond JavaScript's.
+		lower := strings.ToLower(strings.TrimLeft(s, "+-"))
+		if strings.ContainsAny(s, "_xXpP") || strings.HasPrefix(lower, "in") ||
+			strings.HasPrefix(lower, "na") {
+			return math.NaN()
+		}
+		f, err := strconv.ParseFloat(s, 64)
+		if err != nil {
+			return math.NaN()
+		}
+		return f
+	default:
+		return toNumber(Value{toString(v)})
+	}
+}
+
+// parseInt implements JavaScript's parseInt().
+func parseInt(s string, radix int) float64 {
+	s = strings.TrimLeftFunc(s, unicode.IsSpace)
+	sign := 1.0
+	if s != "" && (s[0] == '+' || s[0] == '-') {
+		if s[0] == '-' {
+			sign = -1
+		}
+		s = s[1:]
+	}
+	if radix == 0 {
+		radix = 10
+		if len(s) > 1 && s[0] == '0' && (s[1] == 'x' || s[1] == 'X') {
+			radix, s = 16, s[2:]
+		}
+	} else if radix == 16 && len(s) > 1 && s[0] == '0' && (s[1] == 'x' || s[1] == 'X') {
+		s = s[2:]
+	}
+	if radix < 2 || radix > 36 {
+		return math.NaN()
+	}
+	var ret float64
+	digits := 0
+	for _, c := range s {
+		var d int
+		switch {
+		case c >= '0' && c <= '9':
+			d = int(c - '0')
+		case c >= 'a' && c <= 'z':
+			d = int(c-'a') + 10
+		case c >= 'A' && c <= 'Z':
+			d = int(c-'A') + 10
+		default:
+			d = radix
+		}
+		if d >= radix {
+			break
+		}
+		ret = ret*float64(radix) + float64(d)
+		digits++
+	}
+	if digits == 0 {
+		return math.NaN()
+	}
+	return sign * ret
+}
+
+// function is a JavaScript function.
+type function struct {
+	fn func(this Value, args []Value) Value
+	// construct implements the "new" operator; nil if the function is not a
+	// constructor.
+	construct func(args []Value) Value
+	// instance implements the "instanceof" operator.
+	instance func(v Value) bool
+	released bool
+	props    map[string]Value
+}
+
+func (f *function) call(this Value, args []Value) Value {
+	if f.released {
+		panic("call to released function")
+	}
+	return f.fn(this, args)
+}
+
+func (f *function) get(name string) Value {
+	return f.props[name]
+}
+
+func (f *function) set(name string, value Value) {
+	if f.props == nil {
+		f.props = make(map[string]Value)
+	}
+	f.props[name] = value
+}
+
+// method returns a function value that calls fn.
+func method(fn func(args []Value) Value) Value {
+	return Value{&function{fn: func(this Value, args []Value) Value {
+		return fn(args)
+	}}}
+}
+
+// arg returns the argument at index i, or undefined if there is none.
+func arg(args []Value, i int) Value {
+	if i < len(args) {
+		return args[i]
+	}
+	return Undefined()
+}
+
+// Func is a wrapped Go function to be called by JavaScript.
+type Func struct {
+	// Value is the JavaScript function that invokes the Go function.
+	Value
+}
+
+// FuncOf returns a function to be used by JavaScript.
+//
+// Unlike in the browser, fn is called synchronously in the goroutine that
+// triggered it, e.g. by dispatching an event.
+func FuncOf(fn func(this Value, args []Value) interface{}) Func {
+	return Func{Value{&function{fn: func(this Value, args []Value) Value {
+		return ValueOf(fn(this, args))
+	}}}}
+}
+
+// Release frees up resources allocated for the function. The function must
+// not be invoked after calling Release.
+func (c Func) Release() {
+	c.ref.(*function).released = true
+}
+
+// plainObject is an ordinary JavaScript object.
+type plainObject struct {
+	keys  []string
+	props map[string]Value
+}
+
+func newPlainObject() *plainObject {
+	return &plainObject{props: make(map[string]Value)}
+}
+
+func (o *plainObject) get(name string) Value {
+	return o.props[name]
+}
+
+func (o *plainObject) set(name string, value Value) {
+	if _, ok := o.props[name]; !ok {
+		o.keys = append(o.keys, name)
+	}
+	o.props[name] = value
+}
+
+func (o *plainObject) delete(name string) {
+	if _, ok := o.props[name]; !ok {
+		return
+	}
+	delete(o.props, name)
+	for i, key := range o.keys {
+		if key == name {
+			o.keys = append(o.keys[:i], o.keys[i+1:]...)
+			break
+		}
+	}
+}
+
+// array is a JavaScript array.
+type array struct {
+	items []Value
+}
+
+func (a *array) className() string {
+	return "Array"
+}
+
+func (a *array) get(name string) Value {
+	switch name {
+	case "length":
+		return ValueOf(len(a.items))
+	case "push":
+		return method(func(args []Value) Value {
+			a.items = append(a.items, args...)
+			return ValueOf(len(a.items))
+		})
+	}
+	if i, err := strconv.Atoi(name); err == nil {
+		return a.index(i)
+	}
+	return Undefined()
+}
+
+func (a *array) set(name string, value Value) {
+	if i, err := strconv.Atoi(name); err == nil {
+		a.setIndex(i, value)
+	}
+}
+
+func (a *array) index(i int) Value {
+	if i < 0 || i >= len(a.items) {
+		return Undefined()
+	}
+	return a.items[i]
+}
+
+func (a *array) setIndex(i int, value Value) {
+	if i < 0 {
+		return
+	}
+	for len(a.items) <= i {
+		a.items = append(a.items, Undefined())
+	}
+	a.items[i] = value
+}
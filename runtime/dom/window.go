@@ -0,0 +1,142 @@
+//go:build !js
+// +build !js
+
+package dom
+
+import (
+	"math"
+	"strings"
+)
+
+const emptyDocument = "<!DOCTYPE html><html><head></head><body></body></html>"
+
+var (
+	window   *plainObject
+	document *node
+)
+
+func init() {
+	window = newPlainObject()
+	for _, name := range []string{"window", "self", "globalThis"} {
+		window.set(name, Value{window})
+	}
+	for class := range eventDefaults {
+		window.set(class, eventConstructor(class))
+	}
+	for tag, name := range elementClasses {
+		tag, class := tag, "HTML"+name+"Element"
+		other := nodeClasses[class]
+		nodeClasses[class] = func(n *node) bool {
+			return n.isHTML(tag) || (other != nil && other(n))
+		}
+	}
+	for class, filter := range nodeClasses {
+		window.set(class, nodeConstructor(filter, nil))
+	}
+	// Text, Comment and DocumentFragment can be constructed.
+	for class, typ := range map[string]nodeType{"Text": textNode,
+		"Comment": commentNode, "DocumentFragment": fragmentNode} {
+		typ := typ
+		window.set(class, nodeConstructor(nodeClasses[class], func(args []Value) Value {
+			n := newNode(typ)
+			if len(args) > 0 && typ != fragmentNode {
+				n.data = toString(args[0])
+			}
+			return Value{n}
+		}))
+	}
+	window.set("parseInt", method(func(args []Value) Value {
+		radix := toNumber(arg(args, 1))
+		if math.IsNaN(radix) || math.IsInf(radix, 0) {
+			radix = 0
+		}
+		return ValueOf(parseInt(toString(arg(args, 0)), int(radix)))
+	}))
+	window.set("parseFloat", method(func(args []Value) Value {
+		s := strings.TrimSpace(toString(arg(args, 0)))
+		// parseFloat uses the longest prefix that is a valid number.
+		for end := len(s); end > 0; end-- {
+			if f := toNumber(ValueOf(s[:end])); !math.IsNaN(f) &&
+				strings.TrimSpace(s[:end]) == s[:end] {
+				return ValueOf(f)
+			}
+		}
+		return ValueOf(math.NaN())
+	}))
+	window.set("Number", method(func(args []Value) Value {
+		if len(args) == 0 {
+			return ValueOf(0)
+		}
+		return ValueOf(toNumber(args[0]))
+	}))
+	window.set("String", method(func(args []Value) Value {
+		if len(args) == 0 {
+			return ValueOf("")
+		}
+		return ValueOf(toString(args[0]))
+	}))
+	window.set("isNaN", method(func(args []Value) Value {
+		return ValueOf(math.IsNaN(toNumber(arg(args, 0))))
+	}))
+	Reset()
+}
+
+// Reset replaces the document with a new, empty one. Nodes of the previous
+// document stay valid but are not connected to the new document.
+func Reset() {
+	document = parseDocument(emptyDocument)
+	window.set("document", Value{document})
+}
+
+// Global returns the JavaScript global object, usually "window".
+//
+// The headless global object provides the document and the constructors of
+// nodes and events, as well as parseInt, parseFloat, Number, String and
+// isNaN. Other globals, like alert, can be set as needed.
+func Global() Value {
+	return Value{window}
+}
+
+// nodeClasses are the available node interfaces with a function that
+// checks whether a node implements them.
+var nodeClasses = map[string]func(n *node) bool{
+	"Node": func(n *node) bool { return true },
+	"Element": func(n *node) bool {
+		return n.typ == elementNode
+	},
+	"HTMLElement": func(n *node) bool {
+		return n.typ == elementNode && n.namespace == ""
+	},
+	"CharacterData": func(n *node) bool {
+		return n.typ == textNode || n.typ == commentNode
+	},
+	"Text": func(n *node) bool {
+		return n.typ == textNode
+	},
+	"Comment": func(n *node) bool {
+		return n.typ == commentNode
+	},
+	"Document": func(n *node) bool {
+		return n.typ == documentNode
+	},
+	"DocumentFragment": func(n *node) bool {
+		return n.typ == fragmentNode
+	},
+}
+
+// nodeConstructor returns the constructor of a node interface. construct is
+// nil for interfaces that cannot be constructed.
+func nodeConstructor(filter func(n *node) bool,
+	construct func(args []Value) Value) Value {
+	return Value{&function{
+		fn: func(this Value, args []Value) Value {
+			throw("TypeError", "Illegal constructor")
+			return Undefined()
+		},
+		construct: construct,
+		instance: func(v Value) bool {
+			n := nodeOf(v)
+			return n != nil && filter(n)
+		},
+	}}
+}
@@ -0,0 +1,88 @@
+package askew
+
+import (
+	"strings"
+
+	js "github.com/flyx/askew/runtime/dom"
+)
+
+// EventFilter restricts the keyboard and mouse events a listener is called
+// for. In the browser, events are filtered in JavaScript, so that events that
+// do not match do not cause a call into Go.
+type EventFilter struct {
+	// Keys are the accepted values of the event's `key`. Keys that are single
+	// characters are compared case-insensitively. If empty, all keys are
+	// accepted.
+	Keys []string
+	// Buttons are the accepted values of the event's `button`. If empty, all
+	// buttons are accepted.
+	Buttons []int
+	// Ctrl, Shift, Alt and Meta are the modifier keys that must be pressed.
+	// Modifier keys that are not given must not be pressed, except for Shift
+	// if the filter has Keys and the pressed key is a single character, since
+	// Shift may be needed to type it, e.g. for `A` or `?`.
+	Ctrl, Shift, Alt, Meta bool
+}
+
+// matches returns true if the given event passes the filter. It is used
+// outside of the browser; in the browser, eventFilterSource implements the
+// same check in JavaScript.
+func (f *EventFilter) matches(e js.Value) bool {
+	ignoreShift := false
+	if len(f.Keys) > 0 {
+		key := e.Get("key")
+		if key.Type() != js.TypeString || !f.acceptsKey(key.String()) {
+			return false
+		}
+		ignoreShift = !f.Shift && len([]rune(key.String())) == 1
+	}
+	if len(f.Buttons) > 0 {
+		button := e.Get("button")
+		if button.Type() != js.TypeNumber || !f.acceptsButton(button.Int()) {
+			return false
+		}
+	}
+	return e.Get("ctrlKey").Truthy() == f.Ctrl &&
+		(ignoreShift || e.Get("shiftKey").Truthy() == f.Shift) &&
+		e.Get("altKey").Truthy() == f.Alt && e.Get("metaKey").Truthy() == f.Meta
+}
+
+func (f *EventFilter) acceptsKey(key string) bool {
+	for _, k := range f.Keys {
+		if k == key || (len([]rune(k)) == 1 && strings.EqualFold(k, key)) {
+			return true
+		}
+	}
+	return false
+}
+
+func (f *EventFilter) acceptsButton(button int) bool {
+	for _, b := range f.Buttons {
+		if b == button {
+			return true
+		}
+	}
+	return false
+}
+
+// modifiers returns the modifier flags in the order expected by the
+// JavaScript implementation.
+func (f *EventFilter) modifiers() []interface{} {
+	return []interface{}{f.Ctrl, f.Shift, f.Alt, f.Meta}
+}
+
+func (f *EventFilter) jsKeys() []interface{} {
+	ret := make([]interface{}, len(f.Keys))
+	for i := range f.Keys {
+		ret[i] = f.Keys[i]
+	}
+	return ret
+}
+
+func (f *EventFilter) jsButtons() []interface{} {
+	ret := make([]interface{}, len(f.Buttons))
+	for i := range f.Buttons {
+		ret[i] = f.Buttons[i]
+	}
+	return ret
+}
@@ -0,0 +1,77 @@
+//go:build !js
+// +build !js
+
+package askew
+
+import (
+	"testing"
+
+	js "github.com/flyx/askew/runtime/dom"
+)
+
+func keyEvent(key string, mods ...string) js.Value {
+	init := map[string]interface{}{"key": key, "bubbles": true}
+	for _, m := range mods {
+		init[m+"Key"] = true
+	}
+	return js.Global().Get("KeyboardEvent").New("keydown", init)
+}
+
+func TestFilterMatches(t *testing.T) {
+	js.Reset()
+	for _, c := range []struct {
+		name    string
+		filter  EventFilter
+		event   js.Value
+		matches bool
+	}{
+		{"key", EventFilter{Keys: []string{"Enter"}}, keyEvent("Enter"), true},
+		{"other key", EventFilter{Keys: []string{"Enter"}}, keyEvent("Escape"), false},
+		{"case", EventFilter{Keys: []string{"s"}}, keyEvent("S"), true},
+		{"case of names", EventFilter{Keys: []string{"enter"}}, keyEvent("Enter"), false},
+		{"one of keys", EventFilter{Keys: []string{"a", "?"}}, keyEvent("?"), true},
+		{"shift for character", EventFilter{Keys: []string{"a"}}, keyEvent("A", "shift"), true},
+		{"shift for punctuation", EventFilter{Keys: []string{"?"}}, keyEvent("?", "shift"), true},
+		{"shift for name", EventFilter{Keys: []string{"Enter"}}, keyEvent("Enter", "shift"), false},
+		{"required shift", EventFilter{Keys: []string{"a"}, Shift: true}, keyEvent("A", "shift"), true},
+		{"missing shift", EventFilter{Keys: []string{"a"}, Shift: true}, keyEvent("a"), false},
+		{"ctrl", EventFilter{Keys: []string{"s"}, Ctrl: true}, keyEvent("s", "ctrl"), true},
+		{"missing ctrl", EventFilter{Keys: []string{"s"}, Ctrl: true}, keyEvent("s"), false},
+		{"additional alt", EventFilter{Keys: []string{"s"}, Ctrl: true}, keyEvent("s", "ctrl", "alt"), false},
+		{"additional meta", EventFilter{Keys: []string{"s"}}, keyEvent("s", "meta"), false},
+		{"no key", EventFilter{Keys: []string{"s"}},
+			js.Global().Get("Event").New("keydown"), false},
+		{"button", EventFilter{Buttons: []int{0, 2}},
+			js.Global().Get("MouseEvent").New("click", map[string]interface{}{"button": 2}), true},
+		{"other button", EventFilter{Buttons: []int{0}},
+			js.Global().Get("MouseEvent").New("click", map[string]interface{}{"button": 1}), false},
+		{"shift with button", EventFilter{Buttons: []int{0}},
+			js.Global().Get("MouseEvent").New("click", map[string]interface{}{"shiftKey": true}), false},
+	} {
+		if c.filter.matches(c.event) != c.matches {
+			t.Errorf("%s: expected match to be %v", c.name, c.matches)
+		}
+	}
+}
+
+func TestFilteredOnce(t *testing.T) {
+	js.Reset()
+	target := newContainer("")
+	calls := 0
+	fn := js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		calls++
+		return nil
+	})
+	handler, release := filtered(fn.Value, &EventFilter{Keys: []string{"Enter"}}, true, false)
+	target.Call("addEventListener", "keydown", handler)
+	target.Call("dispatchEvent", keyEvent("a"))
+	target.Call("dispatchEvent", keyEvent("Enter"))
+	target.Call("dispatchEvent", keyEvent("Enter"))
+	if calls != 1 {
+		t.Errorf("expected one call, got %d", calls)
+	}
+	release()
+	if !invokeReleased(handler) {
+		t.Error("filter has not been released")
+	}
+}
@@ -1,7 +1,7 @@
 package askew
 
 import (
-	"syscall/js"
+	js "github.com/flyx/askew/runtime/dom"
 )
 
 // GenericList is a list of Components whose manipulation methods auto-update
@@ -19,6 +19,28 @@ func (l *GenericList) Init(container js.Value, index int) {
 	l.items = nil
 }
 
+// InitHydrated initializes the list with server-rendered items, discarding
+// previous data. The items start at first and are followed by end.
+// They must be added to the list by hydrating them one by one, starting at
+// Pending(), and giving them to Adopt.
+func (l *GenericList) InitHydrated(first, end js.Value) {
+	l.mgr = HydrateListManager(first, end)
+	l.items = nil
+}
+
+// Pending returns the first node of the server-rendered items that have not
+// been adopted yet, or null if there are none.
+func (l *GenericList) Pending() js.Value {
+	return l.mgr.Pending()
+}
+
+// Adopt appends the given item, which must have been hydrated starting at
+// Pending(), to the list.
+func (l *GenericList) Adopt(item Component) {
+	l.mgr.Adopt(item)
+	l.items = append(l.items, item)
+}
+
 // Len returns the number of items in the list.
 func (l *GenericList) Len() int {
 	return len(l.items)
@@ -55,10 +77,71 @@ func (l *GenericList) Insert(index int, item Component) {
 	return
 }
 
+// Reconcile updates the list so that it contains one item for each of the
+// given keys, in that order. Keys must be comparable and unique; if they
+// contain a duplicate, an error is returned and the list is not changed.
+//
+// Items that have been created for a key by a previous call to Reconcile are
+// reused for that key and given to update, unless it is nil. Other items,
+// including those added with Append or Insert, are destroyed. For keys
+// without an item, a new item is created by create. Reused items are moved in
+// the document only if their order changes, so their state is kept.
+func (l *GenericList) Reconcile(keys []interface{},
+	create func(key interface{}) Component,
+	update func(item Component, key interface{})) error {
+	var doUpdate func(item Component, index int)
+	if update != nil {
+		doUpdate = func(item Component, index int) {
+			update(item, keys[index])
+		}
+	}
+	items, err := l.mgr.Reconcile(l.items, keys, func(index int) Component {
+		return create(keys[index])
+	}, doUpdate)
+	l.items = items
+	return err
+}
+
+// Move moves the item at index from to index to, shifting the items in
+// between. Only the nodes of the moved item are moved in the document.
+func (l *GenericList) Move(from, to int) {
+	l.items = l.mgr.Move(l.items, from, to)
+}
+
+// Swap swaps the items at the indexes i and j.
+func (l *GenericList) Swap(i, j int) {
+	l.items = l.mgr.Swap(l.items, i, j)
+}
+
+// SortFunc sorts the list with the given less function. The sort is stable.
+// As few items as possible are moved in the document.
+func (l *GenericList) SortFunc(less func(a, b Component) bool) {
+	l.items = l.mgr.Sort(l.items, less)
+}
+
+// Retain keeps the items for which keep returns true and destroys all other
+// items.
+func (l *GenericList) Retain(keep func(item Component) bool) {
+	kept := l.items[:0]
+	for _, item := range l.items {
+		if keep(item) {
+			kept = append(kept, item)
+		} else {
+			item.Destroy()
+			l.mgr.Forget(item)
+		}
+	}
+	for i := len(kept); i < len(l.items); i++ {
+		l.items[i] = nil
+	}
+	l.items = kept
+}
+
 // Remove removes the item at the given index from the list and returns it.
 func (l *GenericList) Remove(index int) Component {
 	item := l.items[index]
 	item.Extract()
+	l.mgr.Forget(item)
 	copy(l.items[index:], l.items[index+1:])
 	l.items = l.items[:len(l.items)-1]
 	return item
@@ -67,6 +150,7 @@ func (l *GenericList) Remove(index int) Component {
 // Destroy destroys the item at the given index in the list.
 func (l *GenericList) Destroy(index int) {
 	l.items[index].Destroy()
+	l.mgr.Forget(l.items[index])
 	copy(l.items[index:], l.items[index+1:])
 	l.items = l.items[:len(l.items)-1]
 }
@@ -76,6 +160,7 @@ func (l *GenericList) DestroyAll() {
 	for _, item := range l.items {
 		item.Destroy()
 	}
+	l.mgr.ForgetAll()
 	l.items = l.items[:0]
 }
 
@@ -85,6 +170,14 @@ func (l *GenericList) DoUpdateParent(oldParent, newParent, newEnd js.Value) {
 	l.mgr.UpdateParent(oldParent, newParent, newEnd)
 }
 
+// DoNotify passes the notification of the list's parent on to the items.
+// This is an implementation detail and should not be called from user code.
+func (l *GenericList) DoNotify(connected bool) {
+	for _, item := range l.items {
+		Notify(item, connected)
+	}
+}
+
 // GenericOptional is a container that may optionally hold one arbitrary component.
 type GenericOptional struct {
 	mgr ListManager
@@ -99,6 +192,27 @@ func (o *GenericOptional) Init(container js.Value, index int) {
 	o.cur = nil
 }
 
+// InitHydrated initializes the container with a server-rendered item, if
+// any, which starts at first and is followed by end. The item must be
+// hydrated starting at Pending() and given to Adopt.
+func (o *GenericOptional) InitHydrated(first, end js.Value) {
+	o.mgr = HydrateListManager(first, end)
+	o.cur = nil
+}
+
+// Pending returns the first node of the server-rendered item if it has not
+// been adopted yet, or null.
+func (o *GenericOptional) Pending() js.Value {
+	return o.mgr.Pending()
+}
+
+// Adopt sets the contained item to the given item, which must have been
+// hydrated starting at Pending().
+func (o *GenericOptional) Adopt(value Component) {
+	o.mgr.Adopt(value)
+	o.cur = value
+}
+
 // Item returns the current item, or nil if no item is assigned
 func (o *GenericOptional) Item() Component {
 	return o.cur
@@ -133,3 +247,12 @@ func (o *GenericOptional) Remove() Component {
 func (o *GenericOptional) DoUpdateParent(oldParent, newParent, newEnd js.Value) {
 	o.mgr.UpdateParent(oldParent, newParent, newEnd)
 }
+
+// DoNotify passes the notification of the container's parent on to the
+// current item.
+// This is an implementation detail and should not be called from user code.
+func (o *GenericOptional) DoNotify(connected bool) {
+	if o.cur != nil {
+		Notify(o.cur, connected)
+	}
+}
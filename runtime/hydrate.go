@@ -0,0 +1,208 @@
+package askew
+
+import js "github.com/flyx/askew/runtime/dom"
+
+// Data of the comments that dom.Render inserts into server-rendered HTML to
+// preserve the structure of the DOM. Without them, the browser would merge
+// adjacent text nodes and drop empty ones when parsing the HTML.
+const (
+	splitMarker = "a:split"
+	emptyMarker = "a:empty"
+)
+
+const commentNodeType = 8
+
+func isComment(node js.Value, data string) bool {
+	return !equals(node, js.Null()) && !equals(node, js.Undefined()) &&
+		node.Get("nodeType").Int() == commentNodeType &&
+		node.Get("data").String() == data
+}
+
+// normalize restores the structure of server-rendered nodes, starting with
+// the given node and including all its following siblings. It removes split
+// markers and replaces empty markers with empty text nodes.
+// Returns the node at the position of the given node afterwards.
+func normalize(node js.Value) js.Value {
+	for isComment(node, splitMarker) {
+		next := node.Get("nextSibling")
+		node.Call("remove")
+		node = next
+	}
+	if equals(node, js.Null()) {
+		return node
+	}
+	ret := node
+	document := js.Global().Get("document")
+	for cur := node; !equals(cur, js.Null()); {
+		next := cur.Get("nextSibling")
+		if isComment(cur, splitMarker) {
+			cur.Call("remove")
+		} else if isComment(cur, emptyMarker) {
+			text := document.Call("createTextNode", "")
+			cur.Get("parentNode").Call("replaceChild", text, cur)
+			if equals(cur, ret) {
+				ret = text
+			}
+		}
+		cur = next
+	}
+	return ret
+}
+
+func isEmbed(node js.Value) bool {
+	if node.Get("nodeType").Int() != commentNodeType {
+		return false
+	}
+	data := node.Get("data").String()
+	return len(data) > 6 && data[:6] == "embed("
+}
+
+// Hydrator locates the nodes of a server-rendered component instance.
+//
+// It is used by the generated code to initialize a component from existing
+// DOM nodes instead of instantiating its template. This requires the
+// component's template with its control blocks processed in the same way as
+// on the server. Paths into the template are then resolved in the existing
+// nodes, skipping the content of embedded components.
+type Hydrator struct {
+	template, first js.Value
+	// hidden are the elements of the template whose a:if.live has hidden them
+	// when the existing nodes were rendered.
+	hidden []js.Value
+}
+
+// NewHydrator creates a Hydrator for the server-rendered component whose
+// first node is first. template must be a clone of the component's template
+// whose control blocks have been processed.
+func NewHydrator(template, first js.Value) *Hydrator {
+	return &Hydrator{template: template, first: normalize(first)}
+}
+
+func mismatch(msg string) {
+	panic("server-rendered DOM does not match component template: " + msg)
+}
+
+// resolve returns the existing node that corresponds to the child of
+// tParent with the given index, given the existing node corresponding to
+// tParent's first child. If embedded is true and the child is an embed, the
+// first node of the embedded content is returned instead. The elements of an
+// a:for.live are handled like embedded content.
+func (h *Hydrator) resolve(tParent, first js.Value, index int, embedded bool) js.Value {
+	t, s := tParent.Get("firstChild"), first
+	for i := 0; ; i++ {
+		if equals(t, js.Null()) {
+			panic("path does not exist in template")
+		}
+		if equals(s, js.Null()) {
+			mismatch("missing nodes")
+		}
+		if isEmbed(t) || isComment(t, liveForMarker) {
+			start := s
+			for !isComment(s, t.Get("data").String()) {
+				s = s.Get("nextSibling")
+				if equals(s, js.Null()) {
+					mismatch("missing <!--" + t.Get("data").String() + "-->")
+				}
+			}
+			if i == index && embedded {
+				return start
+			}
+		}
+		if i == index {
+			if h.isHidden(t) {
+				if !isComment(s, liveMarker) {
+					mismatch("expected <!--" + liveMarker + "-->, found " +
+						s.Get("nodeName").String())
+				}
+				return s
+			}
+			if t.Get("nodeName").String() != s.Get("nodeName").String() {
+				mismatch("expected " + t.Get("nodeName").String() + ", found " +
+					s.Get("nodeName").String())
+			}
+			return s
+		}
+		t, s = t.Get("nextSibling"), s.Get("nextSibling")
+	}
+}
+
+func (h *Hydrator) walk(embedded bool, path []int) js.Value {
+	if len(path) == 0 {
+		panic("cannot hydrate empty path")
+	}
+	t, s := h.template, h.first
+	for i, index := range path {
+		s = h.resolve(t, s, index, embedded && i == len(path)-1)
+		t = t.Get("childNodes").Index(index)
+		if i < len(path)-1 {
+			if h.isHidden(t) {
+				// the existing nodes do not contain the hidden element, so the
+				// LiveIf takes it from the template.
+				return WalkPath(t, path[i+1:]...)
+			}
+			s = normalize(s.Get("firstChild"))
+		}
+	}
+	return s
+}
+
+func (h *Hydrator) isHidden(node js.Value) bool {
+	for _, hidden := range h.hidden {
+		if equals(hidden, node) {
+			return true
+		}
+	}
+	return false
+}
+
+// First returns the first node of the component.
+func (h *Hydrator) First() js.Value {
+	return h.first
+}
+
+// Last returns the last node of the component.
+func (h *Hydrator) Last() js.Value {
+	return h.walk(false, []int{h.template.Get("childNodes").Length() - 1})
+}
+
+// Walk returns the existing node that corresponds to the template node at the
+// given path.
+func (h *Hydrator) Walk(path ...int) js.Value {
+	return h.walk(false, path)
+}
+
+// Embedded returns the first existing node of the content embedded at the
+// given path, which must lead to the comment node of an embed or of an
+// a:for.live. If nothing is embedded, the comment node is returned.
+func (h *Hydrator) Embedded(path ...int) js.Value {
+	return h.walk(true, path)
+}
+
+// Discard removes the content embedded at the given path, which must lead
+// to the comment node of an embed. Returns the comment node.
+func (h *Hydrator) Discard(path ...int) js.Value {
+	end := h.walk(false, path)
+	for cur := h.walk(true, path); !equals(cur, end); {
+		next := cur.Get("nextSibling")
+		cur.Call("remove")
+		cur = next
+	}
+	return end
+}
+
+// Locate returns the existing node that corresponds to the given node of the
+// template.
+func (h *Hydrator) Locate(node js.Value) js.Value {
+	var path []int
+	for cur := node; !equals(cur, h.template); cur = cur.Get("parentNode") {
+		if equals(cur, js.Null()) {
+			panic("node is not part of the template")
+		}
+		index := 0
+		for prev := cur.Get("previousSibling"); !equals(prev, js.Null()); prev = prev.Get("previousSibling") {
+			index++
+		}
+		path = append([]int{index}, path...)
+	}
+	return h.walk(false, path)
+}
@@ -0,0 +1,136 @@
+//go:build !js
+// +build !js
+
+package askew
+
+import (
+	"strings"
+	"testing"
+
+	js "github.com/flyx/askew/runtime/dom"
+)
+
+// serverRender renders a clone of the given template's content and parses the
+// result into a new container, like the browser does with server-rendered
+// HTML.
+func serverRender(t *testing.T, tmpl js.Value) js.Value {
+	t.Helper()
+	var b strings.Builder
+	if err := js.Render(&b, tmpl.Call("cloneNode", true)); err != nil {
+		t.Fatal(err)
+	}
+	return newContainer(b.String())
+}
+
+func expectPanic(t *testing.T, prefix string, fn func()) {
+	t.Helper()
+	defer func() {
+		t.Helper()
+		msg, _ := recover().(string)
+		if !strings.HasPrefix(msg, prefix) {
+			t.Errorf("expected panic starting with %q, got %q", prefix, msg)
+		}
+	}()
+	fn()
+}
+
+func TestNormalize(t *testing.T) {
+	js.Reset()
+	container := newContainer("<!--a:split-->a<!--a:split-->b<p></p><!--a:empty-->")
+	first := normalize(container.Get("firstChild"))
+	nodes := container.Get("childNodes")
+	if nodes.Length() != 4 || !first.Equal(nodes.Index(0)) ||
+		nodes.Index(0).Get("data").String() != "a" ||
+		nodes.Index(1).Get("data").String() != "b" ||
+		nodes.Index(3).Get("nodeType").Int() != 3 ||
+		nodes.Index(3).Get("data").String() != "" {
+		t.Errorf("unexpected nodes after normalizing: %s", container.Get("innerHTML"))
+	}
+}
+
+func TestHydratorWalk(t *testing.T) {
+	js.Reset()
+	tmpl := newContainer("text<div><span>a</span><!--embed(E)--><b></b></div><p></p>")
+
+	server := newContainer("")
+	server.Set("innerHTML", "<!--a:split-->text<div><span>a</span><i>embedded</i>"+
+		"<i>content</i><!--embed(E)--><b></b></div><p></p>")
+	h := NewHydrator(tmpl, server.Get("firstChild"))
+	if !h.First().Equal(server.Get("firstChild")) || h.First().Get("data").String() != "text" {
+		t.Error("unexpected first node")
+	}
+	if !h.Last().Equal(server.Get("lastChild")) {
+		t.Error("unexpected last node")
+	}
+	div := server.Get("childNodes").Index(1)
+	if !h.Walk(1, 0).Equal(div.Get("firstChild")) {
+		t.Error("unexpected node at path 1, 0")
+	}
+	if !h.Walk(1, 2).Equal(div.Get("lastChild")) {
+		t.Error("walking must skip embedded content")
+	}
+	if !h.Embedded(1, 1).Equal(div.Get("childNodes").Index(1)) {
+		t.Error("unexpected embedded content")
+	}
+	if !h.Locate(tmpl.Get("childNodes").Index(1).Get("lastChild")).Equal(div.Get("lastChild")) {
+		t.Error("unexpected located node")
+	}
+	end := h.Discard(1, 1)
+	if end.Get("data").String() != "embed(E)" ||
+		div.Get("innerHTML").String() != "<span>a</span><!--embed(E)--><b></b>" {
+		t.Errorf("unexpected content after discarding: %s", div.Get("innerHTML"))
+	}
+}
+
+func TestHydratorMismatch(t *testing.T) {
+	js.Reset()
+	tmpl := newContainer("<div><span></span></div>")
+	server := newContainer("<div><em></em></div>")
+	h := NewHydrator(tmpl, server.Get("firstChild"))
+	expectPanic(t, "server-rendered DOM does not match component template: expected SPAN, found EM",
+		func() { h.Walk(0, 0) })
+	expectPanic(t, "path does not exist in template", func() { h.Walk(0, 1) })
+	server.Get("firstChild").Set("innerHTML", "")
+	expectPanic(t, "server-rendered DOM does not match component template: missing nodes",
+		func() { h.Walk(0, 0) })
+}
+
+func TestHydrateComponentData(t *testing.T) {
+	js.Reset()
+	c := newTestComponent("c", "<p>a</p><p>b</p>")
+	server := serverRender(t, c.cd.DocumentFragment())
+	var hydrated testComponent
+	hydrated.cd.Hydrate(server.Get("firstChild"), server.Get("lastChild"))
+	if !hydrated.FirstNode().Equal(server.Get("firstChild")) {
+		t.Error("unexpected first node")
+	}
+	hydrated.Extract()
+	if server.Get("childNodes").Length() != 0 ||
+		hydrated.cd.DocumentFragment().Get("childNodes").Length() != 2 {
+		t.Error("hydrated component has not been extracted")
+	}
+}
+
+func TestHydrateList(t *testing.T) {
+	js.Reset()
+	server := newContainer("<p>1</p><p>2</p><!--embed(L)-->")
+	var l GenericList
+	l.InitHydrated(server.Get("firstChild"), server.Get("lastChild"))
+	for !l.Pending().IsNull() {
+		var item testComponent
+		first := l.Pending()
+		item.cd.Hydrate(first, first)
+		l.Adopt(&item)
+	}
+	if l.Len() != 2 {
+		t.Fatalf("expected 2 adopted items, got %d", l.Len())
+	}
+	l.Append(newTestComponent("3", "<p>3</p>"))
+	l.Move(2, 0)
+	if s := server.Get("innerHTML").String(); s != "<p>3</p><p>1</p><p>2</p><!--embed(L)-->" {
+		t.Errorf("unexpected content: %s", s)
+	}
+	expectPanic(t, "adopted object does not start at the first pending node", func() {
+		l.Adopt(newTestComponent("x", "<p></p>"))
+	})
+}
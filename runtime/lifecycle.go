@@ -0,0 +1,67 @@
+package askew
+
+import (
+	js "github.com/flyx/askew/runtime/dom"
+)
+
+// Inserted can be implemented by components that need to know when they have
+// been connected to the document. OnInsert is called when the component's
+// nodes become part of the document: By InsertInto if the given parent is part
+// of the document, when the component is embedded in a component that is
+// inserted into the document, and after the component has been hydrated.
+// Inserting a component into a parent that is not part of the document, like
+// the DocumentFragment of another component, does not call OnInsert.
+//
+// OnInsert of embedded components is called before the OnInsert of the
+// component embedding them, so they are ready when it is called.
+type Inserted interface {
+	OnInsert()
+}
+
+// Extracted can be implemented by components that need to know when they have
+// been disconnected from the document. The generated Extract calls OnExtract
+// afterwards if the component has been part of the document, which includes
+// removal from lists and optional embeds. Like OnInsert, it is also called on
+// the embedded components of the extracted component, after the OnExtract of
+// the component embedding them.
+type Extracted interface {
+	OnExtract()
+}
+
+// Destroyed can be implemented by components that need to know when they are
+// destroyed. The generated Destroy calls OnDestroy before it destroys the
+// component's embeds and removes its nodes, which includes destruction by
+// lists and optional embeds.
+type Destroyed interface {
+	OnDestroy()
+}
+
+// notifier is implemented by the generated components and by the containers
+// of embedded components, which pass notifications on to their items.
+type notifier interface {
+	DoNotify(connected bool)
+}
+
+// Notify tells c that it has been connected to the document if connected is
+// true, or disconnected from it otherwise. Generated components and the
+// containers of embedded components pass this on to their embedded components
+// and items. For other components, OnInsert or OnExtract is called if
+// implemented.
+//
+// This is an implementation detail and should not be called from user code.
+func Notify(c interface{}, connected bool) {
+	if n, ok := c.(notifier); ok {
+		n.DoNotify(connected)
+	} else if connected {
+		if h, ok := c.(Inserted); ok {
+			h.OnInsert()
+		}
+	} else if h, ok := c.(Extracted); ok {
+		h.OnExtract()
+	}
+}
+
+// IsConnected returns true if the given node is part of the document.
+func IsConnected(node js.Value) bool {
+	return node.Get("isConnected").Truthy()
+}
@@ -0,0 +1,91 @@
+//go:build !js
+// +build !js
+
+package askew
+
+import (
+	"reflect"
+	"testing"
+
+	js "github.com/flyx/askew/runtime/dom"
+)
+
+// hookedComponent records the calls of its lifecycle hooks in events.
+type hookedComponent struct {
+	*testComponent
+	events *[]string
+}
+
+func (c hookedComponent) OnInsert() {
+	*c.events = append(*c.events, "insert "+c.name)
+}
+
+func (c hookedComponent) OnExtract() {
+	*c.events = append(*c.events, "extract "+c.name)
+}
+
+func expectEvents(t *testing.T, events *[]string, expected ...string) {
+	t.Helper()
+	if !reflect.DeepEqual(*events, expected) {
+		t.Errorf("expected events %v, got %v", expected, *events)
+	}
+	*events = nil
+}
+
+func TestNotify(t *testing.T) {
+	js.Reset()
+	var events []string
+	Notify(hookedComponent{item("a"), &events}, true)
+	Notify(hookedComponent{item("a"), &events}, false)
+	Notify(item("b"), true)
+	expectEvents(t, &events, "insert a", "extract a")
+
+	container := newContainer("<hr/>")
+	var l GenericList
+	l.Init(container, 0)
+	l.Append(hookedComponent{item("a"), &events})
+	l.Append(hookedComponent{item("b"), &events})
+	var o GenericOptional
+	o.Init(container, 0)
+	o.DoNotify(true)
+	o.Set(hookedComponent{item("c"), &events})
+	// the container is not part of the document.
+	expectEvents(t, &events)
+
+	l.DoNotify(true)
+	o.DoNotify(true)
+	expectEvents(t, &events, "insert a", "insert b", "insert c")
+	l.DoNotify(false)
+	expectEvents(t, &events, "extract a", "extract b")
+}
+
+func TestIsConnected(t *testing.T) {
+	js.Reset()
+	container := newContainer("<p></p>")
+	if IsConnected(container.Get("firstChild")) {
+		t.Error("node in detached container reported as connected")
+	}
+	body := js.Global().Get("document").Get("body")
+	body.Call("appendChild", container)
+	if !IsConnected(container.Get("firstChild")) {
+		t.Error("node in document reported as not connected")
+	}
+}
+
+func TestVirtualListNotify(t *testing.T) {
+	js.Reset()
+	var events []string
+	v := &VirtualList{}
+	v.Init(newContainer(""), 0, 10)
+	v.SetData(3, func() Component {
+		return hookedComponent{item(""), &events}
+	}, func(item Component, index int) {
+		item.(hookedComponent).name = string(rune('a' + index))
+	})
+	expectEvents(t, &events)
+	v.DoNotify(true)
+	expectEvents(t, &events, "insert a", "insert b", "insert c")
+	v.DoNotify(false)
+	expectEvents(t, &events, "extract a", "extract b", "extract c")
+	v.Destroy()
+}
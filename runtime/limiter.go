@@ -0,0 +1,64 @@
+package askew
+
+import (
+	"sync"
+	"time"
+)
+
+// limiter calls the funcs given to call at most once per wait. If debounce is
+// true, the last func is called after call has not been called for wait.
+// Otherwise, the first func is called immediately and then the last func
+// given during each interval at its end.
+//
+// Delayed calls happen in their own goroutine.
+type limiter struct {
+	mutex    sync.Mutex
+	wait     time.Duration
+	debounce bool
+	timer    *time.Timer
+	pending  func()
+}
+
+func (l *limiter) call(fn func()) {
+	l.mutex.Lock()
+	if l.debounce {
+		if l.timer != nil {
+			l.timer.Stop()
+		}
+		l.pending = fn
+		l.timer = time.AfterFunc(l.wait, l.tick)
+	} else if l.timer == nil {
+		l.timer = time.AfterFunc(l.wait, l.tick)
+		l.mutex.Unlock()
+		fn()
+		return
+	} else {
+		l.pending = fn
+	}
+	l.mutex.Unlock()
+}
+
+func (l *limiter) tick() {
+	l.mutex.Lock()
+	fn := l.pending
+	l.pending = nil
+	if fn == nil || l.debounce {
+		l.timer = nil
+	} else {
+		l.timer = time.AfterFunc(l.wait, l.tick)
+	}
+	l.mutex.Unlock()
+	if fn != nil {
+		fn()
+	}
+}
+
+// cancel discards the pending call.
+func (l *limiter) cancel() {
+	l.mutex.Lock()
+	if l.timer != nil {
+		l.timer.Stop()
+	}
+	l.timer, l.pending = nil, nil
+	l.mutex.Unlock()
+}
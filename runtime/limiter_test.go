@@ -0,0 +1,76 @@
+package askew
+
+import (
+	"testing"
+	"time"
+)
+
+const limiterWait = 30 * time.Millisecond
+
+func collect(calls chan int) []int {
+	var seen []int
+	timeout := time.After(5 * limiterWait)
+	for {
+		select {
+		case v := <-calls:
+			seen = append(seen, v)
+		case <-timeout:
+			return seen
+		}
+	}
+}
+
+func expectCalls(t *testing.T, seen []int, expected ...int) {
+	t.Helper()
+	if len(seen) != len(expected) {
+		t.Fatalf("expected calls %v, got %v", expected, seen)
+	}
+	for i := range seen {
+		if seen[i] != expected[i] {
+			t.Fatalf("expected calls %v, got %v", expected, seen)
+		}
+	}
+}
+
+func send(l *limiter, calls chan int, v int) {
+	l.call(func() { calls <- v })
+}
+
+func TestDebounce(t *testing.T) {
+	calls := make(chan int, 10)
+	l := &limiter{wait: limiterWait, debounce: true}
+	for i := 0; i < 3; i++ {
+		send(l, calls, i)
+	}
+	expectCalls(t, collect(calls), 2)
+	send(l, calls, 3)
+	expectCalls(t, collect(calls), 3)
+}
+
+func TestThrottle(t *testing.T) {
+	calls := make(chan int, 10)
+	l := &limiter{wait: limiterWait}
+	for i := 0; i < 3; i++ {
+		send(l, calls, i)
+	}
+	expectCalls(t, collect(calls), 0, 2)
+	send(l, calls, 3)
+	expectCalls(t, collect(calls), 3)
+}
+
+func TestLimiterCancel(t *testing.T) {
+	calls := make(chan int, 10)
+	l := &limiter{wait: limiterWait, debounce: true}
+	send(l, calls, 0)
+	l.cancel()
+	expectCalls(t, collect(calls))
+}
+
+func TestLimitDestroy(t *testing.T) {
+	calls := make(chan int, 10)
+	var cd ComponentData
+	limit := cd.Limit(limiterWait, true)
+	limit(func() { calls <- 0 })
+	cd.releaseListeners()
+	expectCalls(t, collect(calls))
+}
@@ -1,10 +1,19 @@
 package askew
 
-import "syscall/js"
+import (
+	"fmt"
+	"sort"
+
+	js "github.com/flyx/askew/runtime/dom"
+)
 
 // ListManager is the backend for component lists.
 type ListManager struct {
 	parent, end js.Value
+	// first server-rendered node that has not been adopted yet
+	pending js.Value
+	// keys of the objects created or updated by Reconcile
+	keys map[Component]interface{}
 }
 
 // CreateListManager creates a list manager that inserts list objects at the given
@@ -14,6 +23,51 @@ func CreateListManager(parent js.Value, insertAt int) ListManager {
 		parent: parent, end: parent.Get("childNodes").Index(insertAt)}
 }
 
+// HydrateListManager creates a list manager for server-rendered objects.
+// They start at first and are followed by the node end, in front of which
+// new objects are inserted. The objects must be adopted after they have been
+// hydrated.
+func HydrateListManager(first, end js.Value) ListManager {
+	lm := ListManager{parent: end.Get("parentNode"), end: end}
+	if !equals(first, end) {
+		lm.pending = first
+	}
+	return lm
+}
+
+// Pending returns the first node of the server-rendered objects that have
+// not been adopted yet, or null if there are none.
+func (lm *ListManager) Pending() js.Value {
+	if equals(lm.pending, js.Undefined()) {
+		return js.Null()
+	}
+	return lm.pending
+}
+
+// lastNode returns the last node of the given object, which must implement
+// LastNode().
+func lastNode(c Component) js.Value {
+	last, ok := c.(interface{ LastNode() js.Value })
+	if !ok {
+		panic("object does not implement LastNode()")
+	}
+	return last.LastNode()
+}
+
+// Adopt marks the given object, which must have been hydrated starting at the
+// first pending node, as being managed by the list. The object must
+// implement LastNode() to tell where it ends.
+func (lm *ListManager) Adopt(c Component) {
+	if equals(lm.pending, js.Undefined()) ||
+		!equals(c.FirstNode(), lm.pending) {
+		panic("adopted object does not start at the first pending node")
+	}
+	lm.pending = lastNode(c).Get("nextSibling")
+	if equals(lm.pending, lm.end) {
+		lm.pending = js.Undefined()
+	}
+}
+
 // UpdateParent sets a new parent node for the manager.
 // You need to do this when you move the content nodes from one container to
 // another. This commonly happens if the nodes are initially part of a
@@ -48,3 +102,214 @@ func (lm ListManager) Append(c Component) {
 func (lm ListManager) Insert(c Component, before js.Value) {
 	c.InsertInto(lm.parent, before)
 }
+
+// Forget discards the key of the given object, which has been removed from
+// the list.
+func (lm ListManager) Forget(c Component) {
+	delete(lm.keys, c)
+}
+
+// ForgetAll discards the keys of all objects.
+func (lm *ListManager) ForgetAll() {
+	lm.keys = nil
+}
+
+// Reconcile updates the given objects, which must be the current objects of
+// the list in order, so that there is one object for each of the given keys.
+// Keys must be comparable and unique. Returns the resulting objects.
+//
+// An existing object is reused for a key if it has been given that key in a
+// previous call to Reconcile; update is called on it then, unless it is nil.
+// Objects that are not reused are destroyed, which includes all objects that
+// have not been created by Reconcile. For all other keys, an object is
+// created via create and inserted into the list. index is the index of the
+// key.
+//
+// To get the reused objects into the right order, as few of them as possible
+// are moved (see moveBefore).
+//
+// If keys contains a duplicate, an error is returned together with the
+// unchanged objects.
+func (lm *ListManager) Reconcile(items []Component, keys []interface{},
+	create func(index int) Component,
+	update func(item Component, index int)) ([]Component, error) {
+	newIndex := make(map[interface{}]int, len(keys))
+	for i, key := range keys {
+		if j, ok := newIndex[key]; ok {
+			return items, fmt.Errorf(
+				"duplicate key at indexes %d and %d: %v", j, i, key)
+		}
+		newIndex[key] = i
+	}
+	ret := make([]Component, len(keys))
+	// for each key, the index of the reused object in items, or -1.
+	sources := make([]int, len(keys))
+	for i := range sources {
+		sources[i] = -1
+	}
+	for i, item := range items {
+		key, ok := lm.keys[item]
+		if ok {
+			var j int
+			if j, ok = newIndex[key]; ok {
+				ret[j], sources[j] = item, i
+			}
+		}
+		if !ok {
+			delete(lm.keys, item)
+			item.Destroy()
+		}
+	}
+	if lm.keys == nil {
+		lm.keys = make(map[Component]interface{}, len(keys))
+	}
+	for i := range ret {
+		if sources[i] == -1 {
+			ret[i] = create(i)
+			lm.keys[ret[i]] = keys[i]
+		} else if update != nil {
+			update(ret[i], i)
+		}
+	}
+	lm.arrange(ret, sources)
+	return ret, nil
+}
+
+// Reorder arranges the given objects, which must be the current objects of
+// the list in order, so that the object at index order[i] is at index i.
+// order must be a permutation of the indexes of items. As few objects as
+// possible are moved. Returns the reordered objects.
+func (lm *ListManager) Reorder(items []Component, order []int) []Component {
+	if len(order) != len(items) {
+		panic("order must have the same length as items")
+	}
+	ret := make([]Component, len(items))
+	for i, j := range order {
+		ret[i] = items[j]
+	}
+	lm.arrange(ret, order)
+	return ret
+}
+
+// Move moves the object at index from to index to, shifting the objects in
+// between. items must be the current objects of the list in order.
+// Returns the reordered objects.
+func (lm *ListManager) Move(items []Component, from, to int) []Component {
+	if from < 0 || from >= len(items) || to < 0 || to >= len(items) {
+		panic("index out of range")
+	}
+	order := make([]int, 0, len(items))
+	for i := range items {
+		if i != from {
+			order = append(order, i)
+		}
+	}
+	order = append(order[:to], append([]int{from}, order[to:]...)...)
+	return lm.Reorder(items, order)
+}
+
+// Swap swaps the objects at the indexes i and j. items must be the current
+// objects of the list in order. Returns the reordered objects.
+func (lm *ListManager) Swap(items []Component, i, j int) []Component {
+	order := identity(len(items))
+	order[i], order[j] = j, i
+	return lm.Reorder(items, order)
+}
+
+// Sort sorts the objects with the given less function. The sort is stable.
+// items must be the current objects of the list in order. Returns the sorted
+// objects.
+func (lm *ListManager) Sort(items []Component,
+	less func(a, b Component) bool) []Component {
+	order := identity(len(items))
+	sort.SliceStable(order, func(a, b int) bool {
+		return less(items[order[a]], items[order[b]])
+	})
+	return lm.Reorder(items, order)
+}
+
+func identity(n int) []int {
+	ret := make([]int, n)
+	for i := range ret {
+		ret[i] = i
+	}
+	return ret
+}
+
+// arrange places the given objects in the list in order. sources contains
+// the current index of each object, or -1 for new objects that need to be
+// inserted. Objects that are not part of the longest increasing subsequence
+// of sources are moved.
+func (lm *ListManager) arrange(items []Component, sources []int) {
+	stays := increasingSubsequence(sources)
+	next := lm.end
+	for i := len(items) - 1; i >= 0; i-- {
+		if sources[i] == -1 {
+			items[i].InsertInto(lm.parent, next)
+		} else if !stays[i] {
+			moveBefore(items[i], lm.parent, next)
+		}
+		next = items[i].FirstNode()
+	}
+}
+
+// moveBefore moves the nodes of the inserted object c in front of before.
+// Objects that do not implement LastNode() are extracted and inserted again
+// instead, which calls their lifecycle hooks.
+func moveBefore(c Component, parent, before js.Value) {
+	lc, ok := c.(interface{ LastNode() js.Value })
+	if !ok {
+		c.Extract()
+		c.InsertInto(parent, before)
+		return
+	}
+	last := lc.LastNode()
+	for cur := c.FirstNode(); ; {
+		next := cur.Get("nextSibling")
+		parent.Call("insertBefore", cur, before)
+		if equals(cur, last) {
+			break
+		}
+		cur = next
+	}
+}
+
+// increasingSubsequence returns the positions of a longest strictly increasing
+// subsequence of the non-negative values in seq.
+func increasingSubsequence(seq []int) []bool {
+	// tails[k] is the position of the smallest value ending an increasing
+	// subsequence of length k+1, prev links each position to its predecessor.
+	var tails []int
+	prev := make([]int, len(seq))
+	for i, v := range seq {
+		if v < 0 {
+			continue
+		}
+		lo, hi := 0, len(tails)
+		for lo < hi {
+			mid := (lo + hi) / 2
+			if seq[tails[mid]] < v {
+				lo = mid + 1
+			} else {
+				hi = mid
+			}
+		}
+		if lo > 0 {
+			prev[i] = tails[lo-1]
+		} else {
+			prev[i] = -1
+		}
+		if lo == len(tails) {
+			tails = append(tails, i)
+		} else {
+			tails[lo] = i
+		}
+	}
+	ret := make([]bool, len(seq))
+	if len(tails) > 0 {
+		for i := tails[len(tails)-1]; i >= 0; i = prev[i] {
+			ret[i] = true
+		}
+	}
+	return ret
+}
@@ -0,0 +1,206 @@
+//go:build !js
+// +build !js
+
+package askew
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	js "github.com/flyx/askew/runtime/dom"
+)
+
+// plainComponent does not implement LastNode() and counts how often it has
+// been extracted.
+type plainComponent struct {
+	c         *testComponent
+	extracted int
+}
+
+func (p *plainComponent) FirstNode() js.Value {
+	return p.c.FirstNode()
+}
+
+func (p *plainComponent) InsertInto(parent js.Value, before js.Value) {
+	p.c.InsertInto(parent, before)
+}
+
+func (p *plainComponent) Extract() {
+	p.extracted++
+	p.c.Extract()
+}
+
+func (p *plainComponent) Destroy() {
+	p.c.Destroy()
+}
+
+// item returns a component for name that consists of two nodes.
+func item(name string) *testComponent {
+	return newTestComponent(name, "<b>"+name+"</b><i>"+name+"</i>")
+}
+
+func expectContent(t *testing.T, container js.Value, names ...string) {
+	t.Helper()
+	var b strings.Builder
+	for _, name := range names {
+		b.WriteString("<b>" + name + "</b><i>" + name + "</i>")
+	}
+	b.WriteString("<hr/>")
+	if s := container.Get("innerHTML").String(); s != b.String() {
+		t.Errorf("expected content %s, got %s", b.String(), s)
+	}
+}
+
+func TestIncreasingSubsequence(t *testing.T) {
+	for _, c := range []struct {
+		seq      []int
+		expected []bool
+	}{
+		{[]int{}, []bool{}},
+		{[]int{-1, -1}, []bool{false, false}},
+		{[]int{0, 1, 2}, []bool{true, true, true}},
+		{[]int{2, 0, 1}, []bool{false, true, true}},
+		{[]int{1, 0}, []bool{false, true}},
+		{[]int{3, -1, 0, 4, 1, 2}, []bool{false, false, true, false, true, true}},
+		{[]int{0, 4, 1, 2, 3}, []bool{true, false, true, true, true}},
+	} {
+		if actual := increasingSubsequence(c.seq); !reflect.DeepEqual(actual, c.expected) {
+			t.Errorf("%v: expected %v, got %v", c.seq, c.expected, actual)
+		}
+	}
+}
+
+func TestArrange(t *testing.T) {
+	js.Reset()
+	container := newContainer("<hr/>")
+	var l GenericList
+	l.Init(container, 0)
+	for _, name := range []string{"a", "b", "c", "d"} {
+		l.Append(item(name))
+	}
+	expectContent(t, container, "a", "b", "c", "d")
+
+	l.Move(0, 3)
+	expectContent(t, container, "b", "c", "d", "a")
+	l.Swap(0, 3)
+	expectContent(t, container, "a", "c", "d", "b")
+	l.SortFunc(func(a, b Component) bool {
+		return a.(*testComponent).name < b.(*testComponent).name
+	})
+	expectContent(t, container, "a", "b", "c", "d")
+	l.Retain(func(item Component) bool {
+		return item.(*testComponent).name != "b"
+	})
+	expectContent(t, container, "a", "c", "d")
+	if l.Len() != 3 {
+		t.Errorf("expected 3 items, got %d", l.Len())
+	}
+}
+
+func TestArrangeWithoutLastNode(t *testing.T) {
+	js.Reset()
+	container := newContainer("<hr/>")
+	var l GenericList
+	l.Init(container, 0)
+	items := make([]*plainComponent, 4)
+	for i, name := range []string{"c", "a", "b", "d"} {
+		items[i] = &plainComponent{c: item(name)}
+		l.Append(items[i])
+	}
+	l.SortFunc(func(a, b Component) bool {
+		return a.(*plainComponent).c.name < b.(*plainComponent).c.name
+	})
+	expectContent(t, container, "a", "b", "c", "d")
+	moved := 0
+	for _, item := range items {
+		moved += item.extracted
+	}
+	if moved != 1 {
+		t.Errorf("expected one moved item, got %d", moved)
+	}
+}
+
+func TestReconcile(t *testing.T) {
+	js.Reset()
+	container := newContainer("<hr/>")
+	var l GenericList
+	l.Init(container, 0)
+	keyless := item("x")
+	l.Append(keyless)
+	keylessNode := keyless.FirstNode()
+
+	create := func(key interface{}) Component {
+		return item(key.(string))
+	}
+	var updated []interface{}
+	update := func(item Component, key interface{}) {
+		if item.(*testComponent).name != key {
+			t.Errorf("item %s updated with key %v", item.(*testComponent).name, key)
+		}
+		updated = append(updated, key)
+	}
+	if err := l.Reconcile([]interface{}{"a", "b", "c"}, create, update); err != nil {
+		t.Fatal(err)
+	}
+	expectContent(t, container, "a", "b", "c")
+	if keylessNode.Get("parentNode").Truthy() {
+		t.Error("item without key has not been destroyed")
+	}
+	if len(updated) != 0 {
+		t.Errorf("new items must not be updated, got %v", updated)
+	}
+
+	a, c := l.Item(0).FirstNode(), l.Item(2).FirstNode()
+	if err := l.Reconcile([]interface{}{"c", "a", "d"}, create, update); err != nil {
+		t.Fatal(err)
+	}
+	expectContent(t, container, "c", "a", "d")
+	if !reflect.DeepEqual(updated, []interface{}{"c", "a"}) {
+		t.Errorf("unexpected updates: %v", updated)
+	}
+	if !l.Item(0).FirstNode().Equal(c) || !l.Item(1).FirstNode().Equal(a) {
+		t.Error("nodes of reused items have been recreated")
+	}
+
+	err := l.Reconcile([]interface{}{"a", "e", "a"}, create, nil)
+	if err == nil || err.Error() != "duplicate key at indexes 0 and 2: a" {
+		t.Errorf("unexpected error for duplicate key: %v", err)
+	}
+	expectContent(t, container, "c", "a", "d")
+	if l.Len() != 3 {
+		t.Errorf("list changed by failed Reconcile: %d items", l.Len())
+	}
+}
+
+func TestMove(t *testing.T) {
+	js.Reset()
+	for from := 0; from < 4; from++ {
+		for to := 0; to < 4; to++ {
+			container := newContainer("<hr/>")
+			var l GenericList
+			l.Init(container, 0)
+			names := []string{"a", "b", "c", "d"}
+			for _, name := range names {
+				l.Append(item(name))
+			}
+			l.Move(from, to)
+			expected := append([]string{}, names[:from]...)
+			expected = append(expected, names[from+1:]...)
+			expected = append(expected[:to], append([]string{names[from]}, expected[to:]...)...)
+			expectContent(t, container, expected...)
+			for i, name := range expected {
+				if actual := l.Item(i).(*testComponent).name; actual != name {
+					t.Errorf("Move(%d, %d): expected %s at %d, got %s", from, to, name, i, actual)
+				}
+			}
+		}
+	}
+	var l GenericList
+	l.Init(newContainer("<hr/>"), 0)
+	l.Append(item("a"))
+	expectPanic(t, "index out of range", func() { l.Move(0, 1) })
+	expectPanic(t, "order must have the same length", func() {
+		l.mgr.Reorder(l.items, []int{0, 1})
+	})
+}
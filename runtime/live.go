@@ -0,0 +1,180 @@
+package askew
+
+import js "github.com/flyx/askew/runtime/dom"
+
+// liveMarker is the data of the comment that takes the place of a hidden
+// element with a:if.live.
+const liveMarker = "a:if.live"
+
+// LiveIf shows and hides the element of an a:if.live. While it is hidden, the
+// element is replaced by a comment. It keeps its content, so that bindings and
+// event listeners of its descendants stay intact.
+//
+// LiveIf implements BoundValue so that it can observe an Observable with a
+// bool value.
+type LiveIf struct {
+	node, placeholder js.Value
+	shown             bool
+}
+
+// NewLiveIf creates a LiveIf for the given element, which is currently shown.
+func NewLiveIf(node js.Value) *LiveIf {
+	return &LiveIf{node: node, placeholder: js.Global().Get("document").Call(
+		"createComment", liveMarker), shown: true}
+}
+
+// HydrateLiveIf creates a LiveIf for the given element of the hydrator's
+// template. The value of src tells whether the element has been shown when
+// the existing nodes were rendered. If it has not, the hydrator resolves nodes
+// inside of the element in the template instead of the existing nodes.
+func HydrateLiveIf(h *Hydrator, node js.Value, src Observable) *LiveIf {
+	ret := NewLiveIf(node)
+	if liveValue(src.Value()) {
+		ret.node = h.Locate(node)
+		return ret
+	}
+	h.hidden = append(h.hidden, node)
+	// if the element is inside of another hidden element, it is not part of
+	// the existing nodes and currently shown inside of the template.
+	if existing := h.Locate(node); !equals(existing, node) {
+		ret.placeholder, ret.shown = existing, false
+	}
+	return ret
+}
+
+func liveValue(value interface{}) bool {
+	ret, ok := value.(bool)
+	if !ok {
+		panic("a:if.live requires an observable with a bool value")
+	}
+	return ret
+}
+
+func (li *LiveIf) get() js.Value {
+	return js.ValueOf(li.shown)
+}
+
+func (li *LiveIf) set(value interface{}) {
+	show := liveValue(value)
+	if show == li.shown {
+		return
+	}
+	from, to := li.node, li.placeholder
+	if show {
+		from, to = to, from
+	}
+	from.Get("parentNode").Call("replaceChild", to, from)
+	li.shown = show
+}
+
+// liveForMarker is the data of the comment that takes the place of the
+// element of an a:for.live. The elements of the items are in front of it.
+const liveForMarker = "a:for.live"
+
+// LiveFor manages the elements of an a:for.live. They are created like those
+// of an a:for, but can be re-created at any time, which the generated code
+// does whenever the component's Refresh method is called. The listeners of
+// the elements are released when they are removed.
+//
+// Creating the elements is done by calling Reset, then Add for each item, and
+// finally Commit.
+type LiveFor struct {
+	template, marker js.Value
+	// items are the current elements, added are those created by Add since the
+	// last Reset. bound holds the bindings of each added element, which are
+	// attached on Commit if the LiveFor is hydrating.
+	items, added []js.Value
+	bound        [][]func(h *Hydrator)
+	hydrating    bool
+	cd           ComponentData
+}
+
+// Init replaces the given element, which has a:for.live, with a comment that
+// marks where the elements are inserted. The element is used as template for
+// the elements. Previous data is discarded.
+func (lf *LiveFor) Init(node js.Value) {
+	lf.cd.releaseListeners()
+	lf.template = node
+	lf.marker = js.Global().Get("document").Call("createComment", liveForMarker)
+	node.Get("parentNode").Call("replaceChild", lf.marker, node)
+	lf.items, lf.added, lf.bound, lf.hydrating = nil, nil, nil, false
+}
+
+// Hydrate takes the server-rendered elements from first up to marker, which
+// is the comment following them, as current elements. The next elements
+// created with Add are not inserted. Instead, their bindings are attached to
+// the existing elements on Commit.
+func (lf *LiveFor) Hydrate(first, marker js.Value) {
+	lf.marker, lf.items, lf.hydrating = marker, nil, true
+	for cur := first; !equals(cur, marker); cur = cur.Get("nextSibling") {
+		lf.items = append(lf.items, cur)
+	}
+}
+
+// Reset removes the current elements and releases their listeners, unless
+// the LiveFor is hydrating. Returns the ComponentData that listeners of the
+// new elements are to be added to.
+func (lf *LiveFor) Reset() *ComponentData {
+	lf.cd.releaseListeners()
+	if !lf.hydrating {
+		for _, item := range lf.items {
+			item.Call("remove")
+		}
+		lf.items = nil
+	}
+	lf.added, lf.bound = nil, nil
+	return &lf.cd
+}
+
+// Add creates the element of the next item from the template. It returns the
+// element and the func that attaches a binding to a node inside of it, which
+// is called immediately unless the LiveFor is hydrating.
+func (lf *LiveFor) Add() (js.Value, func(node js.Value, fn func(node js.Value))) {
+	block := lf.template.Call("cloneNode", true)
+	lf.added = append(lf.added, block)
+	if !lf.hydrating {
+		return block, func(node js.Value, fn func(node js.Value)) {
+			fn(node)
+		}
+	}
+	index := len(lf.bound)
+	lf.bound = append(lf.bound, nil)
+	return block, func(node js.Value, fn func(node js.Value)) {
+		lf.bound[index] = append(lf.bound[index], func(h *Hydrator) {
+			fn(h.Locate(node))
+		})
+	}
+}
+
+// Commit inserts the elements created by Add in front of the marker. If the
+// LiveFor is hydrating, their bindings are attached to the existing elements
+// instead, which must have been rendered from the same items.
+func (lf *LiveFor) Commit() {
+	if lf.hydrating {
+		if len(lf.added) != len(lf.items) {
+			mismatch("a:for.live has a different number of items")
+		}
+		document := js.Global().Get("document")
+		for i, item := range lf.items {
+			tmpl := document.Call("createDocumentFragment")
+			tmpl.Call("appendChild", lf.added[i])
+			h := NewHydrator(tmpl, item)
+			for _, fn := range lf.bound[i] {
+				fn(h)
+			}
+		}
+		lf.hydrating = false
+	} else {
+		parent := lf.marker.Get("parentNode")
+		for _, item := range lf.added {
+			parent.Call("insertBefore", item, lf.marker)
+		}
+		lf.items = lf.added
+	}
+	lf.added, lf.bound = nil, nil
+}
+
+// Destroy releases the listeners of the current elements.
+func (lf *LiveFor) Destroy() {
+	lf.cd.releaseListeners()
+}
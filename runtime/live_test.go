@@ -0,0 +1,164 @@
+//go:build !js
+// +build !js
+
+package askew
+
+import (
+	"testing"
+
+	js "github.com/flyx/askew/runtime/dom"
+)
+
+func expectHTML(t *testing.T, container js.Value, expected string) {
+	t.Helper()
+	if actual := container.Get("innerHTML").String(); actual != expected {
+		t.Errorf("expected %q, got %q", expected, actual)
+	}
+}
+
+func TestLiveIf(t *testing.T) {
+	js.Reset()
+	container := newContainer("<p>a</p><section><b>b</b></section>")
+	section := container.Get("lastChild")
+	open := NewBoolSignal(true)
+	var cd ComponentData
+	cd.Observe(open, NewLiveIf(section))
+	expectHTML(t, container, "<p>a</p><section><b>b</b></section>")
+
+	open.Set(false)
+	expectHTML(t, container, "<p>a</p><!--a:if.live-->")
+	// the hidden element keeps its content.
+	section.Get("firstChild").Set("textContent", "c")
+	open.Set(false)
+	open.Set(true)
+	expectHTML(t, container, "<p>a</p><section><b>c</b></section>")
+
+	cd.releaseListeners()
+	open.Set(false)
+	expectHTML(t, container, "<p>a</p><section><b>c</b></section>")
+}
+
+func TestLiveIfValue(t *testing.T) {
+	js.Reset()
+	var cd ComponentData
+	expectPanic(t, "a:if.live requires an observable with a bool value", func() {
+		cd.Observe(NewIntSignal(1), NewLiveIf(newContainer("<p></p>").Get("firstChild")))
+	})
+}
+
+func TestHydrateLiveIf(t *testing.T) {
+	js.Reset()
+	tmpl := newContainer("<p>a</p><section><b>b</b></section>")
+	section := tmpl.Get("lastChild")
+	server := newContainer("<p>a</p><!--a:if.live-->")
+	h := NewHydrator(tmpl, server.Get("firstChild"))
+	open := NewBoolSignal(false)
+	li := HydrateLiveIf(h, section, open)
+	// nodes inside the hidden element are taken from the template.
+	if b := h.Walk(1, 0); !b.Equal(section.Get("firstChild")) {
+		t.Error("node inside hidden element has not been taken from the template")
+	}
+	if !h.Walk(0).Equal(server.Get("firstChild")) {
+		t.Error("unexpected node before hidden element")
+	}
+
+	var cd ComponentData
+	cd.Observe(open, li)
+	open.Set(true)
+	expectHTML(t, server, "<p>a</p><section><b>b</b></section>")
+	open.Set(false)
+	expectHTML(t, server, "<p>a</p><!--a:if.live-->")
+}
+
+func TestHydrateShownLiveIf(t *testing.T) {
+	js.Reset()
+	tmpl := newContainer("<section><b>b</b></section>")
+	server := newContainer("<section><b>b</b></section>")
+	h := NewHydrator(tmpl, server.Get("firstChild"))
+	open := NewBoolSignal(true)
+	li := HydrateLiveIf(h, tmpl.Get("firstChild"), open)
+	if !h.Walk(0, 0).Equal(server.Get("firstChild").Get("firstChild")) {
+		t.Error("node inside shown element has not been located in existing nodes")
+	}
+	var cd ComponentData
+	cd.Observe(open, li)
+	open.Set(false)
+	expectHTML(t, server, "<!--a:if.live-->")
+}
+
+func addItems(lf *LiveFor, texts ...string) []js.Value {
+	var bound []js.Value
+	for _, text := range texts {
+		block, bind := lf.Add()
+		block.Set("textContent", text)
+		bind(block, func(node js.Value) {
+			bound = append(bound, node)
+		})
+	}
+	lf.Commit()
+	return bound
+}
+
+func TestLiveFor(t *testing.T) {
+	js.Reset()
+	container := newContainer("<p>a</p><li>x</li><p>b</p>")
+	var lf LiveFor
+	lf.Init(container.Get("childNodes").Index(1))
+	expectHTML(t, container, "<p>a</p><!--a:for.live--><p>b</p>")
+
+	cd := lf.Reset()
+	addItems(&lf, "1", "2")
+	expectHTML(t, container, "<p>a</p><li>1</li><li>2</li><!--a:for.live--><p>b</p>")
+	fn := js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		return nil
+	})
+	cd.Listen(container.Call("querySelector", "li"), "click", fn)
+
+	lf.Reset()
+	if !invokeReleased(fn.Value) {
+		t.Error("listener of removed element has not been released")
+	}
+	addItems(&lf, "3")
+	expectHTML(t, container, "<p>a</p><li>3</li><!--a:for.live--><p>b</p>")
+	lf.Reset()
+	lf.Commit()
+	expectHTML(t, container, "<p>a</p><!--a:for.live--><p>b</p>")
+}
+
+func TestHydrateLiveFor(t *testing.T) {
+	js.Reset()
+	tmpl := newContainer("<p>a</p><li><b></b></li><p>b</p>")
+	var lf LiveFor
+	lf.Init(tmpl.Get("childNodes").Index(1))
+	server := newContainer("<p>a</p><li><b>1</b></li><li><b>2</b></li><!--a:for.live--><p>b</p>")
+	h := NewHydrator(tmpl, server.Get("firstChild"))
+	existing := server.Get("childNodes")
+	if !h.Walk(2).Equal(existing.Index(4)) {
+		t.Error("node after a:for.live not located behind its elements")
+	}
+	lf.Hydrate(h.Embedded(1), h.Walk(1))
+	lf.Reset()
+	bound := addItems(&lf, "1", "2")
+	if len(bound) != 2 || !bound[0].Equal(existing.Index(1)) || !bound[1].Equal(existing.Index(2)) {
+		t.Error("bindings have not been attached to the existing elements")
+	}
+	expectHTML(t, server, "<p>a</p><li><b>1</b></li><li><b>2</b></li><!--a:for.live--><p>b</p>")
+
+	lf.Reset()
+	addItems(&lf, "3")
+	expectHTML(t, server, "<p>a</p><li>3</li><!--a:for.live--><p>b</p>")
+}
+
+func TestHydrateLiveForMismatch(t *testing.T) {
+	js.Reset()
+	tmpl := newContainer("<li></li>")
+	var lf LiveFor
+	lf.Init(tmpl.Get("firstChild"))
+	server := newContainer("<li></li><!--a:for.live-->")
+	h := NewHydrator(tmpl, server.Get("firstChild"))
+	lf.Hydrate(h.Embedded(0), h.Walk(0))
+	lf.Reset()
+	expectPanic(t, "server-rendered DOM does not match component template: a:for.live has a different number of items", func() {
+		addItems(&lf, "1", "2")
+	})
+}
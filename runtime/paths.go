@@ -1,6 +1,6 @@
 package askew
 
-import "syscall/js"
+import js "github.com/flyx/askew/runtime/dom"
 
 // WalkPath starts at root, which is assumed to be an HTML node, and for each
 // path item, selects the child node with the index corresponding to that path
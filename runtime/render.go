@@ -0,0 +1,29 @@
+//go:build !js
+// +build !js
+
+package askew
+
+import (
+	"errors"
+	"io"
+
+	js "github.com/flyx/askew/runtime/dom"
+)
+
+// RenderHTML writes the HTML of the given component to w.
+//
+// This is only available outside of the browser, where components use the
+// headless DOM of the dom package. It allows rendering components to static
+// HTML on the server. The component must be in initial state, i.e. it must
+// not have been inserted anywhere.
+func RenderHTML(w io.Writer, c Component) error {
+	first := c.FirstNode()
+	if first.IsNull() {
+		return nil
+	}
+	fragment := first.Get("parentNode")
+	if fragment.IsNull() || fragment.Get("nodeType").Int() != 11 {
+		return errors.New("cannot render a component that has been inserted")
+	}
+	return js.Render(w, fragment)
+}
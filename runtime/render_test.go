@@ -0,0 +1,83 @@
+//go:build !js
+// +build !js
+
+package askew
+
+import (
+	"strings"
+	"testing"
+
+	js "github.com/flyx/askew/runtime/dom"
+)
+
+// testComponent is a minimal component consisting of the given HTML.
+type testComponent struct {
+	cd   ComponentData
+	name string
+}
+
+func newTestComponent(name, html string) *testComponent {
+	tmpl := js.Global().Get("document").Call("createElement", "template")
+	tmpl.Set("innerHTML", html)
+	ret := &testComponent{name: name}
+	ret.cd.Init(tmpl.Get("content"))
+	return ret
+}
+
+func (c *testComponent) FirstNode() js.Value {
+	return c.cd.First()
+}
+
+func (c *testComponent) LastNode() js.Value {
+	return c.cd.Last()
+}
+
+func (c *testComponent) InsertInto(parent js.Value, before js.Value) {
+	c.cd.DoInsert(parent, before)
+}
+
+func (c *testComponent) Extract() {
+	c.cd.DoExtract()
+}
+
+func (c *testComponent) Destroy() {
+	c.cd.DoDestroy()
+}
+
+// newContainer returns a new element with the given HTML content.
+func newContainer(html string) js.Value {
+	ret := js.Global().Get("document").Call("createElement", "div")
+	ret.Set("innerHTML", html)
+	return ret
+}
+
+func renderComponent(t *testing.T, c Component) string {
+	t.Helper()
+	var b strings.Builder
+	if err := RenderHTML(&b, c); err != nil {
+		t.Fatal(err)
+	}
+	return b.String()
+}
+
+func TestRenderHTML(t *testing.T) {
+	js.Reset()
+	c := newTestComponent("a", "text<p>para<input name=\"a\"></p>")
+	c.cd.Walk(1, 1).Set("value", "entered")
+	if s := renderComponent(t, c); s != `<!--a:split-->text<p>para<input name="a" value="entered"/></p>` {
+		t.Errorf("unexpected rendering: %s", s)
+	}
+	if s := renderComponent(t, newTestComponent("empty", "")); s != "" {
+		t.Errorf("unexpected rendering of empty component: %s", s)
+	}
+
+	c.InsertInto(newContainer(""), js.Null())
+	var b strings.Builder
+	if err := RenderHTML(&b, c); err == nil {
+		t.Error("rendering an inserted component must fail")
+	}
+	c.Extract()
+	if s := renderComponent(t, c); !strings.HasSuffix(s, "</p>") {
+		t.Errorf("unexpected rendering after extracting: %s", s)
+	}
+}
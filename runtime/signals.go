@@ -0,0 +1,256 @@
+package askew
+
+import (
+	"reflect"
+	"sync"
+)
+
+// Observable is a value that notifies its subscribers when it changes.
+// Values in the DOM can be bound to an Observable with `<-` in a:bindings.
+type Observable interface {
+	// Value returns the current value.
+	Value() interface{}
+	// Subscribe registers fn to be called after each change of the value.
+	// Calling the returned func cancels the subscription.
+	Subscribe(fn func()) (cancel func())
+}
+
+// Model is an Observable that can be updated from the DOM.
+// Values in the DOM can be bound to a Model with `<->` in a:bindings.
+type Model interface {
+	Observable
+	// Update sets the value to the current value of the given bound value.
+	Update(from BoundValue)
+}
+
+// signal implements the subscription handling of all observable values.
+// It may be used from multiple goroutines, e.g. by handlers called after a
+// delay. Subscribers are called in the goroutine that changes the value,
+// without holding the lock, so they may access the value and subscribe or
+// cancel subscriptions themselves.
+type signal struct {
+	mutex       sync.Mutex
+	value       interface{}
+	subscribers []subscriber
+	nextID      int
+}
+
+type subscriber struct {
+	id int
+	fn func()
+}
+
+// Value returns the current value.
+func (s *signal) Value() interface{} {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	return s.value
+}
+
+// Subscribe registers fn to be called after each change of the value.
+// Calling the returned func cancels the subscription.
+func (s *signal) Subscribe(fn func()) (cancel func()) {
+	s.mutex.Lock()
+	defer s.mutex.Unlock()
+	id := s.nextID
+	s.nextID++
+	s.subscribers = append(s.subscribers, subscriber{id, fn})
+	return func() {
+		s.mutex.Lock()
+		defer s.mutex.Unlock()
+		for i := range s.subscribers {
+			if s.subscribers[i].id == id {
+				s.subscribers = append(s.subscribers[:i:i], s.subscribers[i+1:]...)
+				return
+			}
+		}
+	}
+}
+
+// set sets the value and notifies the subscribers if it has changed.
+// If the value is changed concurrently, subscribers may see a newer value
+// than the one that caused their notification.
+func (s *signal) set(value interface{}) {
+	s.mutex.Lock()
+	if same(s.value, value) {
+		s.mutex.Unlock()
+		return
+	}
+	s.value = value
+	// subscribers may cancel their subscription while being notified. This
+	// does not modify the slice in place, so it is safe to iterate over it.
+	subscribers := s.subscribers
+	s.mutex.Unlock()
+	for _, sub := range subscribers {
+		sub.fn()
+	}
+}
+
+// same returns true if a and b are known to be equal. Values that cannot be
+// compared, like slices, are never the same.
+func same(a, b interface{}) bool {
+	if a == nil || b == nil {
+		return a == nil && b == nil
+	}
+	t := reflect.TypeOf(a)
+	return t == reflect.TypeOf(b) && t.Comparable() && a == b
+}
+
+// Signal is an observable value of arbitrary type.
+type Signal struct {
+	signal
+}
+
+// NewSignal creates a Signal with the given initial value.
+func NewSignal(value interface{}) *Signal {
+	return &Signal{signal{value: value}}
+}
+
+// Get returns the current value.
+func (s *Signal) Get() interface{} {
+	return s.Value()
+}
+
+// Set sets the value. Subscribers are notified if the value has changed.
+func (s *Signal) Set(value interface{}) {
+	s.set(value)
+}
+
+// StringSignal is an observable value of string type.
+type StringSignal struct {
+	signal
+}
+
+// NewStringSignal creates a StringSignal with the given initial value.
+func NewStringSignal(value string) *StringSignal {
+	return &StringSignal{signal{value: value}}
+}
+
+// Value returns the current value.
+func (s *StringSignal) Value() interface{} {
+	return s.Get()
+}
+
+// Get returns the current value.
+func (s *StringSignal) Get() string {
+	if value, ok := s.signal.Value().(string); ok {
+		return value
+	}
+	return ""
+}
+
+// Set sets the value. Subscribers are notified if the value has changed.
+func (s *StringSignal) Set(value string) {
+	s.set(value)
+}
+
+// Update sets the value to the string value of from.
+func (s *StringSignal) Update(from BoundValue) {
+	s.Set((&StringValue{from}).Get())
+}
+
+// IntSignal is an observable value of int type.
+type IntSignal struct {
+	signal
+}
+
+// NewIntSignal creates an IntSignal with the given initial value.
+func NewIntSignal(value int) *IntSignal {
+	return &IntSignal{signal{value: value}}
+}
+
+// Value returns the current value.
+func (s *IntSignal) Value() interface{} {
+	return s.Get()
+}
+
+// Get returns the current value.
+func (s *IntSignal) Get() int {
+	if value, ok := s.signal.Value().(int); ok {
+		return value
+	}
+	return 0
+}
+
+// Set sets the value. Subscribers are notified if the value has changed.
+func (s *IntSignal) Set(value int) {
+	s.set(value)
+}
+
+// Update sets the value to the int value of from.
+func (s *IntSignal) Update(from BoundValue) {
+	s.Set((&IntValue{from}).Get())
+}
+
+// BoolSignal is an observable value of bool type.
+type BoolSignal struct {
+	signal
+}
+
+// NewBoolSignal creates a BoolSignal with the given initial value.
+func NewBoolSignal(value bool) *BoolSignal {
+	return &BoolSignal{signal{value: value}}
+}
+
+// Value returns the current value.
+func (s *BoolSignal) Value() interface{} {
+	return s.Get()
+}
+
+// Get returns the current value.
+func (s *BoolSignal) Get() bool {
+	if value, ok := s.signal.Value().(bool); ok {
+		return value
+	}
+	return false
+}
+
+// Set sets the value. Subscribers are notified if the value has changed.
+func (s *BoolSignal) Set(value bool) {
+	s.set(value)
+}
+
+// Update sets the value to the bool value of from.
+func (s *BoolSignal) Update(from BoundValue) {
+	s.Set((&BoolValue{from}).Get())
+}
+
+// Computed is an observable value that is derived from other observables.
+// It is calculated anew whenever one of them changes.
+type Computed struct {
+	signal
+	compute func() interface{}
+	cancel  []func()
+}
+
+// NewComputed creates a value calculated by compute, which is called
+// initially and after each change of any of the given dependencies.
+//
+// The Computed stays subscribed to its dependencies until Release is called,
+// so it must be released if it lives shorter than its dependencies.
+func NewComputed(compute func() interface{}, deps ...Observable) *Computed {
+	c := &Computed{signal: signal{value: compute()}, compute: compute}
+	for _, dep := range deps {
+		c.cancel = append(c.cancel, dep.Subscribe(func() {
+			c.set(c.compute())
+		}))
+	}
+	return c
+}
+
+// Get returns the current value.
+func (c *Computed) Get() interface{} {
+	return c.Value()
+}
+
+// Release cancels the subscriptions to the dependencies. The value does not
+// change anymore afterwards.
+func (c *Computed) Release() {
+	c.mutex.Lock()
+	cancel := c.cancel
+	c.cancel = nil
+	c.mutex.Unlock()
+	for _, fn := range cancel {
+		fn()
+	}
+}
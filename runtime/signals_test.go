@@ -0,0 +1,118 @@
+package askew
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestSignalSubscribe(t *testing.T) {
+	s := NewStringSignal("a")
+	var seen []string
+	cancel := s.Subscribe(func() {
+		seen = append(seen, s.Get())
+	})
+	s.Set("a")
+	s.Set("b")
+	s.Set("c")
+	cancel()
+	s.Set("d")
+	if len(seen) != 2 || seen[0] != "b" || seen[1] != "c" {
+		t.Errorf("unexpected notifications: %v", seen)
+	}
+	cancel()
+}
+
+func TestSignalCancelWhileNotifying(t *testing.T) {
+	s := NewIntSignal(0)
+	calls := 0
+	var cancelFirst func()
+	cancelFirst = s.Subscribe(func() {
+		calls++
+		cancelFirst()
+	})
+	s.Subscribe(func() {
+		calls++
+		s.Subscribe(func() { calls++ })
+	})
+	s.Set(1)
+	if calls != 2 {
+		t.Errorf("expected 2 calls for first change, got %d", calls)
+	}
+	calls = 0
+	s.Set(2)
+	if calls != 2 {
+		t.Errorf("expected 2 calls for second change, got %d", calls)
+	}
+}
+
+func TestSignalValues(t *testing.T) {
+	var (
+		str  StringSignal
+		num  IntSignal
+		flag BoolSignal
+		any  Signal
+	)
+	if str.Get() != "" || num.Get() != 0 || flag.Get() || any.Get() != nil {
+		t.Error("zero signals must have zero values")
+	}
+	flag.Set(true)
+	if flag.Value() != true {
+		t.Errorf("unexpected value: %v", flag.Value())
+	}
+	calls := 0
+	any.Subscribe(func() { calls++ })
+	any.Set([]int{1})
+	any.Set([]int{1})
+	any.Set(nil)
+	any.Set(nil)
+	if calls != 3 {
+		t.Errorf("expected 3 notifications, got %d", calls)
+	}
+}
+
+func TestComputed(t *testing.T) {
+	a, b := NewIntSignal(1), NewIntSignal(2)
+	sum := NewComputed(func() interface{} {
+		return a.Get() + b.Get()
+	}, a, b)
+	if sum.Get() != 3 {
+		t.Errorf("unexpected initial value: %v", sum.Get())
+	}
+	calls := 0
+	sum.Subscribe(func() { calls++ })
+	a.Set(2)
+	b.Set(1)
+	if sum.Get() != 3 || calls != 2 {
+		t.Errorf("unexpected value %v after %d notifications", sum.Get(), calls)
+	}
+	sum.Release()
+	a.Set(10)
+	if sum.Get() != 3 || calls != 2 {
+		t.Error("released value has been updated")
+	}
+}
+
+// TestSignalConcurrentUse is meant to be run with the race detector.
+func TestSignalConcurrentUse(t *testing.T) {
+	s := NewIntSignal(0)
+	sum := NewComputed(func() interface{} {
+		return s.Get() * 2
+	}, s)
+	var wg sync.WaitGroup
+	for i := 1; i <= 10; i++ {
+		wg.Add(1)
+		go func(i int) {
+			defer wg.Done()
+			cancel := sum.Subscribe(func() {})
+			for j := 0; j < 100; j++ {
+				s.Set(i*1000 + j)
+				sum.Get()
+			}
+			cancel()
+		}(i)
+	}
+	wg.Wait()
+	if v := s.Get(); v%1000 != 99 {
+		t.Errorf("unexpected final value: %d", v)
+	}
+}
@@ -1,6 +1,6 @@
 package askew
 
-import "syscall/js"
+import js "github.com/flyx/askew/runtime/dom"
 
 // StringValue provides access to a dynamic value of string type.
 type StringValue struct {
@@ -0,0 +1,280 @@
+package askew
+
+import (
+	"strconv"
+
+	js "github.com/flyx/askew/runtime/dom"
+)
+
+// VirtualBuffer is the number of items a VirtualList renders before and after
+// the visible items.
+const VirtualBuffer = 5
+
+// VirtualList is a list of Components that only keeps the visible items in
+// the document. Its content is defined by data: The list has a length, and
+// items are created and bound to an index on demand. Items that leave the
+// visible area are extracted and reused for other indexes.
+//
+// The list must be the only content of its container element, which must be
+// scrollable, i.e. have a fixed height and `overflow: auto`. All items must
+// have the same height. Elements before and after the rendered items take
+// the space of the items that are not rendered.
+type VirtualList struct {
+	mgr           ListManager
+	container     js.Value
+	itemHeight, n int
+	create        func() Component
+	bind          func(item Component, index int)
+	first         int
+	items, pool   []Component
+	before, after js.Value
+	onScroll      js.Func
+	initialized   bool
+}
+
+// Init initializes the list, discarding previous data. The list's items will
+// be placed in the given container, starting at the given index. itemHeight
+// is the height of each item in pixels.
+func (v *VirtualList) Init(container js.Value, index int, itemHeight int) {
+	v.init(container, container.Get("childNodes").Index(index), itemHeight)
+}
+
+// InitHydrated initializes the list in place of server-rendered items, which
+// start at first and are followed by end. The server-rendered nodes are
+// removed; the list renders its items anew after SetData has been called.
+func (v *VirtualList) InitHydrated(first, end js.Value, itemHeight int) {
+	for cur := first; !equals(cur, end); {
+		next := cur.Get("nextSibling")
+		cur.Call("remove")
+		cur = next
+	}
+	v.init(end.Get("parentNode"), end, itemHeight)
+}
+
+func (v *VirtualList) init(container, end js.Value, itemHeight int) {
+	if v.initialized {
+		v.Destroy()
+	}
+	if itemHeight <= 0 {
+		panic("item height of virtual list must be positive")
+	}
+	document := js.Global().Get("document")
+	tag := "div"
+	switch container.Get("nodeName").String() {
+	case "UL", "OL":
+		tag = "li"
+	case "TABLE", "TBODY", "THEAD", "TFOOT":
+		tag = "tr"
+	}
+	v.before = document.Call("createElement", tag)
+	v.after = document.Call("createElement", tag)
+	for _, spacer := range []js.Value{v.before, v.after} {
+		spacer.Get("style").Set("height", "0px")
+		spacer.Get("style").Set("listStyle", "none")
+		container.Call("insertBefore", spacer, end)
+	}
+	v.container, v.itemHeight = container, itemHeight
+	v.mgr = ListManager{parent: container, end: v.after}
+	v.n, v.first, v.items, v.pool = 0, 0, nil, nil
+	v.create, v.bind = nil, nil
+	v.onScroll = js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		v.update()
+		return nil
+	})
+	container.Call("addEventListener", "scroll", v.onScroll,
+		map[string]interface{}{"passive": true})
+	v.initialized = true
+}
+
+// SetData sets the length of the list and the functions that create items
+// and bind them to an index. bind is called whenever an item is shown for an
+// index, which may be an item that previously showed another index.
+// All currently rendered items are bound anew.
+func (v *VirtualList) SetData(n int, create func() Component,
+	bind func(item Component, index int)) {
+	v.create, v.bind = create, bind
+	v.n = n
+	v.Refresh()
+}
+
+// SetLen sets the length of the list. Rendered items are only bound anew if
+// they come into view.
+func (v *VirtualList) SetLen(n int) {
+	v.n = n
+	v.update()
+}
+
+// Refresh binds all rendered items anew and renders the items that are
+// currently visible. Call it when the data of the list changes, or when the
+// size of the container changes.
+func (v *VirtualList) Refresh() {
+	for i, item := range v.items {
+		if v.first+i < v.n {
+			v.bind(item, v.first+i)
+		}
+	}
+	v.update()
+}
+
+// Len returns the number of items in the list.
+func (v *VirtualList) Len() int {
+	return v.n
+}
+
+// Item returns the item that is currently rendered for the given index, or
+// nil if the index is not rendered. Only the visible items and VirtualBuffer
+// items around them are rendered, so callers must handle nil for all other
+// indexes, even if they are smaller than Len().
+func (v *VirtualList) Item(index int) Component {
+	if index < v.first || index >= v.first+len(v.items) {
+		return nil
+	}
+	return v.items[index-v.first]
+}
+
+// Destroy destroys all items and removes the list from its container.
+// The list must be initialized again before it can be used.
+func (v *VirtualList) Destroy() {
+	if !v.initialized {
+		return
+	}
+	for _, item := range v.items {
+		item.Destroy()
+	}
+	for _, item := range v.pool {
+		item.Destroy()
+	}
+	v.container.Call("removeEventListener", "scroll", v.onScroll,
+		map[string]interface{}{"passive": true})
+	v.onScroll.Release()
+	v.before.Call("remove")
+	v.after.Call("remove")
+	v.items, v.pool, v.n, v.initialized = nil, nil, 0, false
+}
+
+// DoUpdateParent does nothing, since the list's container never changes.
+// The visible items are rendered when the list is connected to the document,
+// see DoNotify.
+// This is an implementation detail and should not be called from user code.
+func (v *VirtualList) DoUpdateParent(oldParent, newParent, newEnd js.Value) {}
+
+// DoNotify passes the notification of the list's parent on to the rendered
+// items. When the list has been connected to the document, it renders the
+// visible items afterwards, since the list's visible area may have changed.
+// This is an implementation detail and should not be called from user code.
+func (v *VirtualList) DoNotify(connected bool) {
+	for _, item := range v.items {
+		Notify(item, connected)
+	}
+	if connected {
+		v.update()
+	}
+}
+
+// pixels returns the numeric value of the given property of the container,
+// or 0 if it is not available, e.g. outside of the browser.
+func (v *VirtualList) pixels(name string) int {
+	value := v.container.Get(name)
+	if value.Type() != js.TypeNumber {
+		return 0
+	}
+	return value.Int()
+}
+
+// offset returns the distance between the top of the container's content,
+// which includes its padding, and the top of the list, or 0 if no layout is
+// available.
+func (v *VirtualList) offset() int {
+	if v.before.Get("getBoundingClientRect").Type() != js.TypeFunction {
+		return 0
+	}
+	top := v.before.Call("getBoundingClientRect").Get("top").Float() -
+		v.container.Call("getBoundingClientRect").Get("top").Float()
+	return int(top) - v.pixels("clientTop") + v.pixels("scrollTop")
+}
+
+// window returns the range of indexes that should be rendered.
+func (v *VirtualList) window() (first, last int) {
+	height := v.pixels("clientHeight")
+	if height <= 0 {
+		// no layout available, render the beginning of the list.
+		first, last = 0, 2*VirtualBuffer
+	} else {
+		// top is the position of the visible area relative to the list.
+		top := v.pixels("scrollTop") - v.offset()
+		first = top/v.itemHeight - VirtualBuffer
+		last = (top+height+v.itemHeight-1)/v.itemHeight + VirtualBuffer
+	}
+	if first < 0 {
+		first = 0
+	}
+	if last > v.n {
+		last = v.n
+	}
+	if first > last {
+		first = last
+	}
+	return
+}
+
+// take returns an item for the given index, reusing an extracted item if
+// possible.
+func (v *VirtualList) take(index int) Component {
+	var item Component
+	if len(v.pool) > 0 {
+		item = v.pool[len(v.pool)-1]
+		v.pool = v.pool[:len(v.pool)-1]
+	} else {
+		item = v.create()
+	}
+	v.bind(item, index)
+	return item
+}
+
+// update renders the items that are currently visible.
+func (v *VirtualList) update() {
+	if !v.initialized || v.create == nil {
+		return
+	}
+	first, last := v.window()
+	oldFirst, oldLast := v.first, v.first+len(v.items)
+	keepFirst, keepLast := first, last
+	if oldFirst > keepFirst {
+		keepFirst = oldFirst
+	}
+	if oldLast < keepLast {
+		keepLast = oldLast
+	}
+	if keepFirst > keepLast {
+		keepFirst, keepLast = first, first
+	}
+	items := make([]Component, 0, last-first)
+	for i, item := range v.items {
+		if index := oldFirst + i; index < keepFirst || index >= keepLast {
+			item.Extract()
+			v.pool = append(v.pool, item)
+		}
+	}
+	for index := first; index < keepFirst; index++ {
+		items = append(items, v.take(index))
+	}
+	if keepFirst < keepLast {
+		items = append(items, v.items[keepFirst-oldFirst:keepLast-oldFirst]...)
+	}
+	for index := keepLast; index < last; index++ {
+		items = append(items, v.take(index))
+	}
+	// insert new items; kept items are already in place.
+	next := v.after
+	for i := len(items) - 1; i >= 0; i-- {
+		if index := first + i; index < keepFirst || index >= keepLast {
+			items[i].InsertInto(v.container, next)
+		}
+		next = items[i].FirstNode()
+	}
+	v.first, v.items = first, items
+	v.before.Get("style").Set("height",
+		strconv.Itoa(first*v.itemHeight)+"px")
+	v.after.Get("style").Set("height",
+		strconv.Itoa((v.n-last)*v.itemHeight)+"px")
+}
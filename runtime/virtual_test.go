@@ -0,0 +1,94 @@
+//go:build !js
+// +build !js
+
+package askew
+
+import (
+	"strconv"
+	"testing"
+
+	js "github.com/flyx/askew/runtime/dom"
+)
+
+// rect lets getBoundingClientRect of the given element return top.
+func rect(e js.Value, top func() int) {
+	e.Set("getBoundingClientRect", js.FuncOf(func(this js.Value, args []js.Value) interface{} {
+		return map[string]interface{}{"top": top()}
+	}))
+}
+
+func newVirtualList(container js.Value, n int) *VirtualList {
+	v := &VirtualList{}
+	v.Init(container, 0, 10)
+	v.SetData(n, func() Component {
+		return newTestComponent("", "<p></p>")
+	}, func(item Component, index int) {
+		item.(*testComponent).name = strconv.Itoa(index)
+	})
+	return v
+}
+
+func expectWindow(t *testing.T, v *VirtualList, first, last int) {
+	t.Helper()
+	for i := 0; i < v.Len(); i++ {
+		item := v.Item(i)
+		if rendered := i >= first && i < last; rendered != (item != nil) {
+			t.Errorf("expected rendering of %d to be %v", i, rendered)
+		} else if item != nil && item.(*testComponent).name != strconv.Itoa(i) {
+			t.Errorf("item %d is bound to %s", i, item.(*testComponent).name)
+		}
+	}
+	if h := v.before.Get("style").Get("height").String(); h != strconv.Itoa(first*10)+"px" {
+		t.Errorf("unexpected height before items: %s", h)
+	}
+	if h := v.after.Get("style").Get("height").String(); h != strconv.Itoa((v.Len()-last)*10)+"px" {
+		t.Errorf("unexpected height after items: %s", h)
+	}
+}
+
+func TestVirtualListWithoutLayout(t *testing.T) {
+	js.Reset()
+	v := newVirtualList(newContainer(""), 100)
+	expectWindow(t, v, 0, 2*VirtualBuffer)
+	v.SetLen(3)
+	expectWindow(t, v, 0, 3)
+	v.Destroy()
+}
+
+func TestVirtualListWindow(t *testing.T) {
+	js.Reset()
+	// the container is at 100px, has a border of 2px and a padding of 50px.
+	container := newContainer("")
+	container.Set("clientHeight", 100)
+	container.Set("clientTop", 2)
+	container.Set("scrollTop", 0)
+	rect(container, func() int { return 100 })
+	v := &VirtualList{}
+	v.Init(container, 0, 10)
+	rect(v.before, func() int {
+		return 100 + 2 + 50 - container.Get("scrollTop").Int()
+	})
+	v.SetData(100, func() Component {
+		return newTestComponent("", "<p></p>")
+	}, func(item Component, index int) {
+		item.(*testComponent).name = strconv.Itoa(index)
+	})
+	// the padding is visible, items up to 50px are shown.
+	expectWindow(t, v, 0, 5+VirtualBuffer)
+
+	container.Set("scrollTop", 200)
+	container.Call("dispatchEvent", js.Global().Get("Event").New("scroll"))
+	// 150px to 250px of the list are visible.
+	expectWindow(t, v, 15-VirtualBuffer, 25+VirtualBuffer)
+	if n := container.Get("childNodes").Length(); n != 2+20 {
+		t.Errorf("expected 20 items and 2 spacers in container, got %d nodes", n)
+	}
+
+	container.Set("scrollTop", 950)
+	v.Refresh()
+	expectWindow(t, v, 90-VirtualBuffer, 100)
+	v.Destroy()
+	if n := container.Get("childNodes").Length(); n != 0 {
+		t.Errorf("destroyed list left %d nodes", n)
+	}
+}
@@ -7,7 +7,7 @@ import (
 
 	"github.com/flyx/askew/test/ui"
 
-	"syscall/js"
+	js "github.com/flyx/askew/runtime/dom"
 )
 
 type handler struct{}
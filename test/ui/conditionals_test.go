@@ -0,0 +1,53 @@
+//go:build !js
+// +build !js
+
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	js "github.com/flyx/askew/runtime/dom"
+)
+
+func TestElseIf(t *testing.T) {
+	for n, expected := range []string{"none", "one", "two", "many", "many"} {
+		js.Reset()
+		NewCount(n).InsertInto(js.Global().Get("document").Get("body"), js.Null())
+		expectContent(t, "p", expected, 1)
+	}
+}
+
+func expectContent(t *testing.T, selector, expected string, children int) {
+	t.Helper()
+	root := js.Global().Get("document").Call("querySelector", selector)
+	if actual := strings.TrimSpace(root.Get("textContent").String()); actual != expected {
+		t.Errorf("expected %q, got %q", expected, actual)
+	}
+	if actual := root.Get("children").Length(); actual != children {
+		t.Errorf("expected %d child elements, got %d", children, actual)
+	}
+}
+
+func TestSwitch(t *testing.T) {
+	for tab, expected := range map[string]string{
+		"overview": "overview", "settings": "settings", "advanced": "settings",
+		"other": "not found",
+	} {
+		js.Reset()
+		NewTabs(tab).InsertInto(js.Global().Get("document").Get("body"), js.Null())
+		expectContent(t, "div", expected, 1)
+	}
+}
+
+func TestSwitchTrue(t *testing.T) {
+	for n, expected := range map[int]string{-1: "negative", 1: "positive", 0: ""} {
+		js.Reset()
+		NewSign(n).InsertInto(js.Global().Get("document").Get("body"), js.Null())
+		children := 1
+		if expected == "" {
+			children = 0
+		}
+		expectContent(t, "div", expected, children)
+	}
+}
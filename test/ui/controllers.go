@@ -4,7 +4,7 @@ import (
 	"fmt"
 	"strconv"
 
-	"syscall/js"
+	js "github.com/flyx/askew/runtime/dom"
 )
 
 func (o *row) foo() {}
@@ -57,3 +57,50 @@ func (o *AutoFieldTest) click() {
 		js.Global().Call("alert", o.content)
 	}()
 }
+
+func (o *EventOptions) outer() bool {
+	o.calls = append(o.calls, "outer")
+	return false
+}
+
+func (o *EventOptions) own() bool {
+	o.calls = append(o.calls, "own")
+	return false
+}
+
+func (o *EventOptions) inner() bool {
+	o.calls = append(o.calls, "inner")
+	return false
+}
+
+func (o *EventOptions) once() bool {
+	o.calls = append(o.calls, "once")
+	return false
+}
+
+func (o *Search) search(q string) {
+	o.queries <- q
+}
+
+func (o *Details) close() bool {
+	o.Open.Set(false)
+	return false
+}
+
+func (o *Grid) choose(row, col int) bool {
+	o.chosen = append(o.chosen, row*10+col)
+	return false
+}
+
+func (o *Todos) remove(index int) bool {
+	o.removed = append(o.removed, o.Items[index])
+	o.Items = append(o.Items[:index:index], o.Items[index+1:]...)
+	o.Refresh()
+	return false
+}
+
+func (o *Todos) clear() bool {
+	o.Items = nil
+	o.Refresh()
+	return false
+}
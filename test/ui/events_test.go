@@ -0,0 +1,78 @@
+//go:build !js
+// +build !js
+
+package ui
+
+import (
+	"reflect"
+	"testing"
+	"time"
+
+	js "github.com/flyx/askew/runtime/dom"
+)
+
+func TestEventOptions(t *testing.T) {
+	js.Reset()
+	o := NewEventOptions()
+	o.InsertInto(js.Global().Get("document").Get("body"), js.Null())
+	click := func(selector string, expected ...string) {
+		t.Helper()
+		o.calls = nil
+		js.Global().Get("document").Call("querySelector", selector).Call("click")
+		if !reflect.DeepEqual(o.calls, expected) {
+			t.Errorf("%s: expected calls %v, got %v", selector, expected, o.calls)
+		}
+	}
+	click("section", "own", "outer")
+	click("button", "inner")
+	click("span", "once", "outer")
+	click("span", "outer")
+	click("div", "outer")
+}
+
+func expectQueries(t *testing.T, s *Search, expected ...string) {
+	t.Helper()
+	for _, q := range expected {
+		select {
+		case actual := <-s.queries:
+			if actual != q {
+				t.Errorf("expected query %q, got %q", q, actual)
+			}
+		case <-time.After(time.Second):
+			t.Fatalf("missing query %q", q)
+		}
+	}
+	select {
+	case actual := <-s.queries:
+		t.Errorf("unexpected query %q", actual)
+	case <-time.After(100 * time.Millisecond):
+	}
+}
+
+func TestLimitedCaptureReadsValuesAtEvent(t *testing.T) {
+	js.Reset()
+	s := NewSearch()
+	s.InsertInto(js.Global().Get("document").Get("body"), js.Null())
+	input := js.Global().Get("document").Call("querySelector", "input")
+	send := func(value, event string, init map[string]interface{}) {
+		input.Set("value", value)
+		input.Call("dispatchEvent", js.Global().Get("KeyboardEvent").New(event, init))
+	}
+
+	send("a", "input", nil)
+	send("ab", "input", nil)
+	// changing the value without an event must not affect the pending call.
+	input.Set("value", "abc")
+	expectQueries(t, s, "ab")
+
+	enter := map[string]interface{}{"key": "Enter"}
+	send("x", "keydown", enter)
+	send("y", "keydown", enter)
+	send("z", "keydown", enter)
+	input.Set("value", "none")
+	expectQueries(t, s, "x", "z")
+
+	send("pending", "input", nil)
+	s.Destroy()
+	expectQueries(t, s)
+}
@@ -0,0 +1,59 @@
+//go:build !js
+// +build !js
+
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	askew "github.com/flyx/askew/runtime"
+	js "github.com/flyx/askew/runtime/dom"
+)
+
+func TestHydrateNameForms(t *testing.T) {
+	js.Reset()
+	server := NewNameForms(false, "after")
+	first := NewNameForm(1)
+	first.Name.Set("First")
+	server.Forms.Append(first)
+	var b strings.Builder
+	if err := askew.RenderHTML(&b, server); err != nil {
+		t.Fatal(err)
+	}
+
+	js.Reset()
+	body := js.Global().Get("document").Get("body")
+	body.Set("innerHTML", b.String())
+	para := body.Call("querySelector", "p")
+	forms := HydrateNameForms(body.Get("firstChild"), false, "after")
+	c := &nameFormController{submitted: make(chan submission, 1)}
+	forms.Forms.DefaultController = c
+	for i := 1; !forms.Forms.Pending().IsNull(); i++ {
+		forms.Forms.Adopt(HydrateNameForm(forms.Forms.Pending(), i))
+	}
+	if forms.Forms.Len() != 1 {
+		t.Fatalf("expected one adopted form, got %d", forms.Forms.Len())
+	}
+	hydrated := forms.Forms.Item(0)
+	if hydrated.Name.Get() != "First" {
+		t.Errorf("unexpected bound value: %q", hydrated.Name.Get())
+	}
+	if !body.Call("querySelector", "p").Equal(para) {
+		t.Error("hydration replaced the existing nodes")
+	}
+	if s := body.Get("innerHTML").String(); strings.HasPrefix(s, "<!--a:split-->") {
+		t.Errorf("markers have not been removed: %s", s)
+	}
+
+	hydrated.Age.Set(7)
+	body.Call("querySelector", "input[type=submit]").Call("click")
+	if s := <-c.submitted; s.name != "First" || s.age != 7 {
+		t.Errorf("unexpected submission: %+v", s)
+	}
+
+	forms.Forms.Append(NewNameForm(2))
+	if n := body.Call("querySelectorAll", "form").Length(); n != 2 {
+		t.Errorf("expected 2 forms after appending, got %d", n)
+	}
+}
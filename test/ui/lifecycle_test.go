@@ -0,0 +1,83 @@
+//go:build !js
+// +build !js
+
+package ui
+
+import (
+	"reflect"
+	"testing"
+
+	js "github.com/flyx/askew/runtime/dom"
+)
+
+var lifecycleEvents []string
+
+func (o *Lifecycle) OnInsert() {
+	lifecycleEvents = append(lifecycleEvents, "insert "+o.name)
+}
+
+func (o *Lifecycle) OnExtract() {
+	lifecycleEvents = append(lifecycleEvents, "extract "+o.name)
+}
+
+func (o *Lifecycle) OnDestroy() {
+	lifecycleEvents = append(lifecycleEvents, "destroy "+o.name)
+}
+
+func expectLifecycle(t *testing.T, expected ...string) {
+	t.Helper()
+	if !reflect.DeepEqual(lifecycleEvents, expected) {
+		t.Errorf("expected lifecycle events %v, got %v", expected, lifecycleEvents)
+	}
+	lifecycleEvents = nil
+}
+
+func TestLifecycle(t *testing.T) {
+	js.Reset()
+	lifecycleEvents = nil
+	l := NewLifecycles()
+	// the embedded components are inserted into the DocumentFragment of l.
+	expectLifecycle(t)
+
+	item := NewLifecycle("item")
+	l.Items.Append(item)
+	l.Extra.Set(NewLifecycle("extra"))
+	l.Others.Append(NewLifecycle("other"))
+	expectLifecycle(t)
+
+	body := js.Global().Get("document").Get("body")
+	l.InsertInto(body, js.Null())
+	expectLifecycle(t, "insert other", "insert extra", "insert item", "insert fixed")
+	l.Extract()
+	expectLifecycle(t, "extract other", "extract extra", "extract item", "extract fixed")
+	l.InsertInto(body, js.Null())
+	expectLifecycle(t, "insert other", "insert extra", "insert item", "insert fixed")
+
+	l.Items.Remove(0)
+	expectLifecycle(t, "extract item")
+	l.Items.Append(item)
+	expectLifecycle(t, "insert item")
+
+	l.Destroy()
+	expectLifecycle(t, "destroy other", "destroy extra", "destroy item", "destroy fixed")
+	if body.Get("firstChild").Truthy() {
+		t.Error("destroyed component is still inserted")
+	}
+}
+
+func TestLifecycleInsertIntoDocument(t *testing.T) {
+	js.Reset()
+	lifecycleEvents = nil
+	body := js.Global().Get("document").Get("body")
+	item := NewLifecycle("item")
+	item.InsertInto(body, js.Null())
+	expectLifecycle(t, "insert item")
+	item.Extract()
+	expectLifecycle(t, "extract item")
+
+	// a component that is not part of the document is not notified.
+	container := js.Global().Get("document").Call("createElement", "div")
+	item.InsertInto(container, js.Null())
+	item.Extract()
+	expectLifecycle(t)
+}
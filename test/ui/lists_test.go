@@ -0,0 +1,72 @@
+//go:build !js
+// +build !js
+
+package ui
+
+import (
+	"testing"
+
+	js "github.com/flyx/askew/runtime/dom"
+)
+
+func formNames(t *testing.T, forms *NameForms) []string {
+	t.Helper()
+	ret := make([]string, forms.Forms.Len())
+	for i := range ret {
+		ret[i] = forms.Forms.Item(i).Name.Get()
+	}
+	return ret
+}
+
+func expectForms(t *testing.T, forms *NameForms, expected ...string) {
+	t.Helper()
+	actual := formNames(t, forms)
+	nodes := js.Global().Get("document").Call("querySelectorAll", "input[name=Name]")
+	if len(actual) != len(expected) || nodes.Length() != len(expected) {
+		t.Fatalf("expected forms %v, got %v (%d in document)", expected, actual,
+			nodes.Length())
+	}
+	for i := range expected {
+		if actual[i] != expected[i] {
+			t.Errorf("expected forms %v, got %v", expected, actual)
+			break
+		}
+		if value := nodes.Index(i).Get("value").String(); value != expected[i] {
+			t.Errorf("expected form %s at %d in document, got %s", expected[i], i, value)
+		}
+	}
+}
+
+func TestNameFormsReorder(t *testing.T) {
+	js.Reset()
+	forms := NewNameForms(false, "")
+	forms.InsertInto(js.Global().Get("document").Get("body"), js.Null())
+	for i, name := range []string{"c", "a", "d", "b"} {
+		form := NewNameForm(i)
+		form.Name.Set(name)
+		forms.Forms.Append(form)
+	}
+	input := forms.Forms.Item(0).Name
+
+	forms.Forms.Move(0, 2)
+	expectForms(t, forms, "a", "d", "c", "b")
+	forms.Forms.Swap(1, 3)
+	expectForms(t, forms, "a", "b", "c", "d")
+	forms.Forms.Swap(0, 3)
+	forms.Forms.SortFunc(func(a, b *NameForm) bool {
+		return a.Name.Get() < b.Name.Get()
+	})
+	expectForms(t, forms, "a", "b", "c", "d")
+	if forms.Forms.Item(2).Name != input {
+		t.Error("moved item has been recreated")
+	}
+
+	forms.Forms.Retain(func(item *NameForm) bool {
+		return item.Name.Get() == "b" || item.Name.Get() == "d"
+	})
+	expectForms(t, forms, "b", "d")
+	forms.Forms.Append(NewNameForm(5))
+	if forms.Forms.Len() != 3 {
+		t.Errorf("expected 3 forms after append, got %d", forms.Forms.Len())
+	}
+}
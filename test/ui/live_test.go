@@ -0,0 +1,160 @@
+//go:build !js
+// +build !js
+
+package ui
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	askew "github.com/flyx/askew/runtime"
+	js "github.com/flyx/askew/runtime/dom"
+)
+
+func TestLiveIf(t *testing.T) {
+	js.Reset()
+	d := NewDetails()
+	body := js.Global().Get("document").Get("body")
+	d.InsertInto(body, js.Null())
+	document := js.Global().Get("document")
+	if !document.Call("querySelector", "section").IsNull() {
+		t.Fatal("section shown although Open is false")
+	}
+
+	// bindings inside the hidden element are updated.
+	d.Text.Set("hello")
+	d.Open.Set(true)
+	section := document.Call("querySelector", "section")
+	if section.IsNull() {
+		t.Fatal("section not shown after setting Open")
+	}
+	if text := section.Call("querySelector", "p").Get("textContent").String(); text != "hello" {
+		t.Errorf("unexpected text: %q", text)
+	}
+
+	d.More.Set(false)
+	if !section.Call("querySelector", "i").IsNull() ||
+		!strings.Contains(section.Get("innerHTML").String(), "<!--a:if.live-->") {
+		t.Error("nested element not hidden")
+	}
+
+	section.Call("querySelector", "button").Call("dispatchEvent",
+		js.Global().Get("MouseEvent").New("click"))
+	if !document.Call("querySelector", "section").IsNull() {
+		t.Error("capture inside live element did not hide it")
+	}
+
+	// after destruction, the signals do not affect the removed nodes.
+	d.Destroy()
+	d.Open.Set(true)
+	if !document.Call("querySelector", "section").IsNull() {
+		t.Error("destroyed component still observes Open")
+	}
+}
+
+func click(node js.Value) {
+	node.Call("dispatchEvent", js.Global().Get("MouseEvent").New("click"))
+}
+
+func expectTodos(t *testing.T, o *Todos, expected ...string) {
+	t.Helper()
+	items := js.Global().Get("document").Call("querySelectorAll", "p")
+	if items.Length() != len(expected) || len(o.Texts) != len(expected) ||
+		len(o.Done) != len(expected) {
+		t.Fatalf("expected %d items, got %d elements, %d texts and %d done",
+			len(expected), items.Length(), len(o.Texts), len(o.Done))
+	}
+	for i, text := range expected {
+		if actual := o.Texts[i].Get(); actual != text {
+			t.Errorf("item %d: expected %q, got %q", i, text, actual)
+		}
+		if first := !items.Index(i).Call("querySelector", "b").IsNull(); first != (i == 0) {
+			t.Errorf("item %d: a:if inside a:for.live not evaluated", i)
+		}
+	}
+}
+
+func TestLiveFor(t *testing.T) {
+	js.Reset()
+	o := NewTodos()
+	o.InsertInto(js.Global().Get("document").Get("body"), js.Null())
+	expectTodos(t, o)
+
+	o.Items = []string{"a", "b", "c"}
+	o.Refresh()
+	expectTodos(t, o, "a", "b", "c")
+	o.Done[1].Set(true)
+	if !js.Global().Get("document").Call("querySelectorAll", "p").Index(1).Get(
+		"classList").Call("contains", "done").Bool() {
+		t.Error("binding inside a:for.live does not refer to its element")
+	}
+	if actual := js.Global().Get("document").Call("querySelectorAll", "p").Index(2).Get(
+		"nextSibling").Get("data").String(); actual != "a:for.live" {
+		t.Errorf("elements not in front of the marker, found %q", actual)
+	}
+
+	// the handler refreshes the component while its listener is called.
+	click(js.Global().Get("document").Call("querySelectorAll", "p").Index(1))
+	expectTodos(t, o, "a", "c")
+	click(js.Global().Get("document").Call("querySelectorAll", "p").Index(1))
+	expectTodos(t, o, "a")
+	if expected := []string{"b", "c"}; !reflect.DeepEqual(o.removed, expected) {
+		t.Errorf("expected removed %v, got %v", expected, o.removed)
+	}
+
+	// the capture after the a:for.live has been attached to the right element.
+	click(js.Global().Get("document").Call("querySelector", "button"))
+	expectTodos(t, o)
+}
+
+func TestLiveForReleasesListeners(t *testing.T) {
+	js.Reset()
+	o := NewTodos()
+	o.Items = []string{"a"}
+	o.Refresh()
+	o.InsertInto(js.Global().Get("document").Get("body"), js.Null())
+	old := js.Global().Get("document").Call("querySelector", "p")
+	o.Refresh()
+	click(old)
+	if len(o.removed) != 0 {
+		t.Error("listener of removed element still called")
+	}
+
+	o.Destroy()
+	if js.Global().Get("document").Get("body").Get("childNodes").Length() != 0 {
+		t.Error("elements of a:for.live not removed on destruction")
+	}
+}
+
+func TestHydrateLiveFor(t *testing.T) {
+	js.Reset()
+	o := NewTodos()
+	o.Items = []string{"a", "b"}
+	o.Refresh()
+	var b strings.Builder
+	if err := askew.RenderHTML(&b, o); err != nil {
+		t.Fatal(err)
+	}
+
+	js.Reset()
+	body := js.Global().Get("document").Get("body")
+	body.Set("innerHTML", b.String())
+	existing := body.Call("querySelectorAll", "p")
+	o = new(Todos)
+	o.Items = []string{"a", "b"}
+	o.Hydrate(body.Get("firstChild"))
+	expectTodos(t, o, "a", "b")
+	o.Texts[1].Set("z")
+	if actual := existing.Index(1).Call("querySelector", "span").Get("textContent").String(); actual != "z" {
+		t.Errorf("hydrated binding does not refer to the existing node: %q", actual)
+	}
+
+	click(existing.Index(0))
+	expectTodos(t, o, "b")
+	if expected := []string{"a"}; !reflect.DeepEqual(o.removed, expected) {
+		t.Errorf("expected removed %v, got %v", expected, o.removed)
+	}
+	click(body.Call("querySelector", "button"))
+	expectTodos(t, o)
+}
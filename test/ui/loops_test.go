@@ -0,0 +1,107 @@
+//go:build !js
+// +build !js
+
+package ui
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	askew "github.com/flyx/askew/runtime"
+	js "github.com/flyx/askew/runtime/dom"
+)
+
+func TestBindingsInLoop(t *testing.T) {
+	js.Reset()
+	g := NewGrid([][]string{{"a", "b"}, {}, {"c"}})
+	g.InsertInto(js.Global().Get("document").Get("body"), js.Null())
+	expected := [][]string{{"a", "b"}, {}, {"c"}}
+	if len(g.Cells) != len(expected) {
+		t.Fatalf("expected %d rows, got %d", len(expected), len(g.Cells))
+	}
+	for i, row := range expected {
+		if len(g.Cells[i]) != len(row) {
+			t.Errorf("row %d: expected %d cells, got %d", i, len(row), len(g.Cells[i]))
+			continue
+		}
+		for j, cell := range row {
+			if actual := g.Cells[i][j].Get(); actual != cell {
+				t.Errorf("cell %d,%d: expected %q, got %q", i, j, cell, actual)
+			}
+		}
+	}
+	g.Cells[2][0].Set("z")
+	cells := js.Global().Get("document").Call("querySelectorAll", "td")
+	if actual := cells.Index(2).Get("textContent").String(); actual != "z" {
+		t.Errorf("unexpected content of last cell: %q", actual)
+	}
+	if actual := g.Summary.Get(); actual != "filled" {
+		t.Errorf("unexpected summary: %q", actual)
+	}
+}
+
+func TestBindingsInEmptyLoop(t *testing.T) {
+	js.Reset()
+	g := NewGrid(nil)
+	if len(g.Cells) != 0 {
+		t.Errorf("expected no cells, got %d", len(g.Cells))
+	}
+	if g.Summary.BoundValue == nil || g.Summary.Get() != "empty" {
+		t.Error("Summary does not refer to the kept branch")
+	}
+}
+
+func TestCapturesInLoop(t *testing.T) {
+	js.Reset()
+	g := NewGrid([][]string{{"a", "b"}, {"c"}})
+	g.InsertInto(js.Global().Get("document").Get("body"), js.Null())
+	cells := js.Global().Get("document").Call("querySelectorAll", "td")
+	for _, i := range []int{2, 0, 1} {
+		cells.Index(i).Call("dispatchEvent", js.Global().Get("MouseEvent").New("click"))
+	}
+	if expected := []int{10, 0, 1}; !reflect.DeepEqual(g.chosen, expected) {
+		t.Errorf("expected chosen %v, got %v", expected, g.chosen)
+	}
+
+	// re-initializing the component replaces the listeners of the loop.
+	g.Destroy()
+	g.Init([][]string{{"x"}})
+	g.InsertInto(js.Global().Get("document").Get("body"), js.Null())
+	g.chosen = nil
+	js.Global().Get("document").Call("querySelector", "td").Call("dispatchEvent",
+		js.Global().Get("MouseEvent").New("click"))
+	if expected := []int{0}; !reflect.DeepEqual(g.chosen, expected) {
+		t.Errorf("expected chosen %v after re-init, got %v", expected, g.chosen)
+	}
+	if len(g.Cells) != 1 || len(g.Cells[0]) != 1 {
+		t.Errorf("expected 1 cell after re-init, got %v", g.Cells)
+	}
+}
+
+func TestHydrateBindingsInLoop(t *testing.T) {
+	js.Reset()
+	rows := [][]string{{"a"}, {}, {"b", "c"}}
+	var b strings.Builder
+	if err := askew.RenderHTML(&b, NewGrid(rows)); err != nil {
+		t.Fatal(err)
+	}
+
+	js.Reset()
+	body := js.Global().Get("document").Get("body")
+	body.Set("innerHTML", b.String())
+	cells := body.Call("querySelectorAll", "td")
+	g := HydrateGrid(body.Get("firstChild"), rows)
+	if len(g.Cells) != 3 || len(g.Cells[0]) != 1 || len(g.Cells[1]) != 0 ||
+		len(g.Cells[2]) != 2 {
+		t.Fatalf("unexpected structure of hydrated cells: %v", g.Cells)
+	}
+	g.Cells[2][1].Set("z")
+	if actual := cells.Index(2).Get("textContent").String(); actual != "z" {
+		t.Errorf("hydrated binding does not refer to the existing node: %q", actual)
+	}
+	cells.Index(1).Call("dispatchEvent", js.Global().Get("MouseEvent").New("click"))
+	if expected := []int{20}; !reflect.DeepEqual(g.chosen, expected) {
+		t.Errorf("expected chosen %v, got %v", expected, g.chosen)
+	}
+}
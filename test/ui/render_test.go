@@ -0,0 +1,42 @@
+//go:build !js
+// +build !js
+
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	askew "github.com/flyx/askew/runtime"
+	js "github.com/flyx/askew/runtime/dom"
+)
+
+func TestRenderNameForms(t *testing.T) {
+	js.Reset()
+	forms := NewNameForms(true, "after")
+	first := NewNameForm(1)
+	first.Name.Set("First")
+	forms.Forms.Append(first)
+	forms.Forms.Append(NewNameForm(2))
+
+	var b strings.Builder
+	if err := askew.RenderHTML(&b, forms); err != nil {
+		t.Fatal(err)
+	}
+	html := b.String()
+	for _, expected := range []string{
+		`<p class="bold">Before the forms</p>`,
+		"This is form #<!--a:split-->1<br/>",
+		`<input name="Name" value="First"/>`,
+		"This is form #<!--a:split-->2<br/>",
+		"<!--embed(Forms)-->",
+		"<p>after</p>",
+	} {
+		if !strings.Contains(html, expected) {
+			t.Errorf("rendered HTML does not contain %q:\n%s", expected, html)
+		}
+	}
+	if strings.Index(html, "form #<!--a:split-->2") > strings.Index(html, "<!--embed(Forms)-->") {
+		t.Error("list items must be rendered before the end of the embed")
+	}
+}
@@ -0,0 +1,55 @@
+//go:build !js
+// +build !js
+
+package ui
+
+import (
+	"strings"
+	"testing"
+
+	js "github.com/flyx/askew/runtime/dom"
+)
+
+type submission struct {
+	name string
+	age  int
+}
+
+type nameFormController struct {
+	submitted chan submission
+}
+
+func (c *nameFormController) Submit(name string, age int) {
+	c.submitted <- submission{name, age}
+}
+
+func (c *nameFormController) Reset(foo string) bool {
+	return true
+}
+
+func TestNameFormSubmit(t *testing.T) {
+	js.Reset()
+	c := &nameFormController{submitted: make(chan submission, 1)}
+	form := NewNameForm(1)
+	form.Controller = c
+	form.InsertInto(js.Global().Get("document").Get("body"), js.Null())
+
+	form.Name.Set("Bob")
+	form.Age.Set(42)
+	section := js.Global().Get("document").Call("querySelector", "section")
+	section.Call("querySelector", "input[type=submit]").Call("click")
+	if s := <-c.submitted; s.name != "Bob" || s.age != 42 {
+		t.Errorf("unexpected submission: %+v", s)
+	}
+
+	e := js.Global().Get("Event").New("submit",
+		map[string]interface{}{"bubbles": true, "cancelable": true})
+	section.Call("querySelector", "form").Call("dispatchEvent", e)
+	<-c.submitted
+	if !e.Get("defaultPrevented").Bool() {
+		t.Error("submitting has not been prevented")
+	}
+	if text := section.Get("textContent").String(); !strings.Contains(text, "form #1") {
+		t.Errorf("form does not contain its index: %q", text)
+	}
+}
@@ -0,0 +1,251 @@
+package typecheck
+
+import (
+	"bytes"
+	"errors"
+	"go/ast"
+	"go/build"
+	"go/parser"
+	"go/token"
+	"go/types"
+	"io"
+	"io/ioutil"
+	"os"
+	"os/exec"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/flyx/askew/data"
+)
+
+type pkgInfo struct {
+	pkg   *types.Package
+	files []*ast.File
+	errs  []types.Error
+	// set if the package cannot be checked reliably.
+	skipReason string
+}
+
+// Checker type-checks Go packages inside the current module.
+// All packages are loaded from source for GOOS=js and GOARCH=wasm, since the
+// generated code targets the browser.
+type Checker struct {
+	syms *data.Symbols
+	// generated files that have not been written to disk, by path.
+	overlay map[string][]byte
+	fset    *token.FileSet
+	ctxt    build.Context
+	// loaded packages by directory. nil while the package is being loaded.
+	loaded map[string]*pkgInfo
+}
+
+// NewChecker creates a checker for the packages in syms. overlay contains
+// the content of generated files that have not been written, by path.
+func NewChecker(syms *data.Symbols, overlay map[string][]byte) *Checker {
+	ret := &Checker{syms: syms, overlay: overlay, fset: token.NewFileSet(),
+		ctxt: build.Default, loaded: make(map[string]*pkgInfo)}
+	ret.ctxt.GOOS = "js"
+	ret.ctxt.GOARCH = "wasm"
+	ret.ctxt.CgoEnabled = false
+	adjustToolTags(&ret.ctxt)
+	ret.ctxt.OpenFile = ret.openFile
+	return ret
+}
+
+func (c *Checker) openFile(path string) (io.ReadCloser, error) {
+	if content, ok := c.overlay[path]; ok {
+		return ioutil.NopCloser(bytes.NewReader(content)), nil
+	}
+	return os.Open(path)
+}
+
+// dirOf returns the directory containing the package with the given import
+// path. Packages inside the module are given relative to the module's base
+// directory, other packages are searched in GOROOT and then with `go list`
+// from the given directory.
+func (c *Checker) dirOf(importPath, srcDir string) (string, error) {
+	base := c.syms.ImportPath
+	if importPath == base {
+		return ".", nil
+	}
+	if strings.HasPrefix(importPath, base+"/") {
+		return filepath.FromSlash(importPath[len(base)+1:]), nil
+	}
+	goroot := filepath.Join(c.ctxt.GOROOT, "src")
+	for _, dir := range []string{filepath.Join(goroot, importPath),
+		filepath.Join(goroot, "vendor", importPath)} {
+		if info, err := os.Stat(dir); err == nil && info.IsDir() {
+			return dir, nil
+		}
+	}
+
+	cmd := exec.Command("go", "list", "-f", "{{.Dir}}", importPath)
+	cmd.Dir = srcDir
+	cmd.Env = append(os.Environ(), "GOOS=js", "GOARCH=wasm")
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	out, err := cmd.Output()
+	if err != nil {
+		return "", errors.New("cannot find package " + importPath + ": " +
+			strings.TrimSpace(stderr.String()))
+	}
+	return strings.TrimSpace(string(out)), nil
+}
+
+// goFiles returns the names of the Go files in dir that match the build
+// context.
+func (c *Checker) goFiles(dir string) ([]string, error) {
+	names := make(map[string]struct{})
+	infos, err := ioutil.ReadDir(dir)
+	if err != nil && !os.IsNotExist(err) {
+		return nil, err
+	}
+	for _, info := range infos {
+		if !info.IsDir() {
+			names[info.Name()] = struct{}{}
+		}
+	}
+	for path := range c.overlay {
+		if filepath.Dir(path) == dir {
+			names[filepath.Base(path)] = struct{}{}
+		}
+	}
+	var ret []string
+	for name := range names {
+		if !strings.HasSuffix(name, ".go") || strings.HasSuffix(name, "_test.go") {
+			continue
+		}
+		if ok, err := c.ctxt.MatchFile(dir, name); err != nil {
+			return nil, err
+		} else if ok {
+			ret = append(ret, name)
+		}
+	}
+	sort.Strings(ret)
+	return ret, nil
+}
+
+// load type-checks the package with the given import path in dir.
+// Function bodies are only checked for packages inside the module.
+func (c *Checker) load(importPath, dir string) (*pkgInfo, error) {
+	if info, ok := c.loaded[dir]; ok {
+		if info == nil {
+			return nil, errors.New("import cycle via " + importPath)
+		}
+		return info, nil
+	}
+	names, err := c.goFiles(dir)
+	if err != nil {
+		return nil, err
+	}
+	if len(names) == 0 {
+		return nil, errors.New("no Go files in " + dir)
+	}
+
+	c.loaded[dir] = nil
+	info := &pkgInfo{}
+	for _, name := range names {
+		path := filepath.Join(dir, name)
+		var src interface{}
+		if content, ok := c.overlay[path]; ok {
+			src = content
+		}
+		f, err := parser.ParseFile(c.fset, path, src, 0)
+		if err != nil {
+			info.skipReason = err.Error()
+			c.loaded[dir] = info
+			return info, nil
+		}
+		info.files = append(info.files, f)
+	}
+	absDir, _ := filepath.Abs(dir)
+	conf := types.Config{Importer: &sourceImporter{c: c, dir: absDir},
+		IgnoreFuncBodies: filepath.IsAbs(dir),
+		Error: func(err error) {
+			if te, ok := err.(types.Error); ok {
+				info.errs = append(info.errs, te)
+			}
+		}}
+	info.pkg, _ = conf.Check(importPath, c.fset, info.files, nil)
+	c.loaded[dir] = info
+	return info, nil
+}
+
+// sourceImporter imports packages for the package in dir.
+type sourceImporter struct {
+	c   *Checker
+	dir string
+}
+
+func (si *sourceImporter) Import(path string) (*types.Package, error) {
+	return si.ImportFrom(path, si.dir, 0)
+}
+
+func (si *sourceImporter) ImportFrom(path, srcDir string,
+	mode types.ImportMode) (*types.Package, error) {
+	if path == "unsafe" {
+		return types.Unsafe, nil
+	}
+	dir, err := si.c.dirOf(path, srcDir)
+	if err != nil {
+		return nil, err
+	}
+	info, err := si.c.load(path, dir)
+	if err != nil {
+		return nil, err
+	}
+	if info.skipReason != "" {
+		return nil, errors.New(info.skipReason)
+	}
+	return info.pkg, nil
+}
+
+// isGenerated checks whether the file at path has been generated by askew.
+func isGenerated(path string) bool {
+	return strings.HasSuffix(path, ".askew.go") || strings.HasSuffix(path, ".asite.go")
+}
+
+// Check type-checks the package at the given relative path and reports
+// errors in the generated code, in the implementation of handlers and in the
+// other Go files of the package.
+// Returns an error if the package cannot be checked, e.g. because a
+// dependency is not available.
+func (c *Checker) Check(relPath string) error {
+	pkg := c.syms.Packages[relPath]
+	info, err := c.load(pkg.ImportPath, relPath)
+	if err != nil {
+		return err
+	}
+	if info.skipReason != "" {
+		return errors.New(info.skipReason)
+	}
+	for _, e := range info.errs {
+		// errors in dependencies would cause lots of follow-up errors.
+		if strings.HasPrefix(e.Msg, "could not import") {
+			return errors.New(c.fset.Position(e.Pos).String() + ": " + e.Msg)
+		}
+	}
+
+	l := locator{c: c, relPath: relPath, pkg: pkg, info: info,
+		missing: make(map[*data.Component]map[string]struct{}),
+		seen:    make(map[reported]struct{})}
+	for _, file := range pkg.Files {
+		for _, cmp := range file.Components {
+			l.checkHandlers(file, cmp)
+		}
+	}
+	for _, e := range info.errs {
+		pos := c.fset.Position(e.Pos)
+		if !isGenerated(pos.Filename) {
+			// errors in hand-written code are reported at their position.
+			c.syms.Diagnostics.Add(&data.Diagnostic{Pos: data.Position{
+				File: pos.Filename, Line: pos.Line, Column: pos.Column}, Msg: e.Msg})
+			continue
+		}
+		if path, err := l.locate(e); err != nil {
+			c.syms.Report(path, err)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+package typecheck
+
+import (
+	"os"
+	"path/filepath"
+	"strings"
+	"testing"
+
+	"github.com/flyx/askew/data"
+	"github.com/flyx/askew/output"
+	"github.com/flyx/askew/packages"
+	"github.com/flyx/askew/units"
+)
+
+// check generates the code of the askew files in testdata/<name>, type-checks
+// it and returns the reported diagnostics with the location in the generated
+// code removed.
+func check(t *testing.T, name string) ([]string, error) {
+	t.Helper()
+	wd, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = os.Chdir(filepath.Join("testdata", name)); err != nil {
+		t.Fatal(err)
+	}
+	defer os.Chdir(wd)
+
+	base, err := packages.Discover(nil, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var syms data.Symbols
+	syms.BaseDir = *base
+	overlay := make(map[string][]byte)
+	for relPath, pkg := range syms.Packages {
+		syms.CurPkg = relPath
+		for _, file := range pkg.Files {
+			units.ProcessFile(file, &syms)
+		}
+		if syms.Diagnostics.Len() > 0 {
+			t.Fatalf("unexpected errors: %v", syms.Diagnostics.Sorted())
+		}
+		w := output.PackageWriter{Syms: &syms, PackageName: pkg.Name,
+			RelPath: relPath, Write: func(path string, content []byte) error {
+				overlay[path] = content
+				return nil
+			}}
+		for _, file := range pkg.Files {
+			if err = w.WriteFile(file); err != nil {
+				t.Fatal(err)
+			}
+		}
+	}
+
+	c := NewChecker(&syms, overlay)
+	for relPath := range syms.Packages {
+		if err = c.Check(relPath); err != nil {
+			return nil, err
+		}
+	}
+	var ret []string
+	for _, d := range syms.Diagnostics.Sorted() {
+		msg := d.Error()
+		if i := strings.Index(msg, " (in generated code at "); i != -1 {
+			msg = msg[:i]
+		}
+		ret = append(ret, msg)
+	}
+	return ret, nil
+}
+
+func TestCheck(t *testing.T) {
+	actual, err := check(t, "check")
+	if err != nil {
+		t.Fatal(err)
+	}
+	// the type checker's messages differ between Go versions, only their
+	// start is compared.
+	expected := []string{
+		// errors in hand-written code are reported, too.
+		"handlers.go:12:4: o.EmitSelected undefined",
+		"ui.askew:2:2: handler `Missing` of Form: not implemented (missing method Missing on *Form)",
+		"ui.askew:2:2: handler `Submit` of Form: implementation has 1 parameters, but 2 are declared",
+		"ui.askew:12:33: cannot use",
+		"ui.askew:16:1: undefined: greeting",
+		// reported once, though the arguments are also given in askewHydrate.
+		"ui.askew:21:46: cannot use 42",
+		"ui.askew:22:2: undefined: name",
+		// errors in the method re-creating the elements of an a:for.live.
+		"ui.askew:33:17: o.Missing undefined",
+		"ui.askew:34:54: cannot use item",
+	}
+	if len(actual) != len(expected) {
+		t.Fatalf("expected %d diagnostics, got %d: %q", len(expected), len(actual), actual)
+	}
+	for i := range expected {
+		if !strings.HasPrefix(actual[i], expected[i]) {
+			t.Errorf("diagnostic %d: expected %q, got %q", i, expected[i], actual[i])
+		}
+	}
+}
+
+func TestCheckValid(t *testing.T) {
+	actual, err := check(t, "valid")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(actual) != 0 {
+		t.Errorf("unexpected diagnostics: %q", actual)
+	}
+}
+
+func TestCheckMissingDependency(t *testing.T) {
+	_, err := check(t, "missingdep")
+	if err == nil || !strings.Contains(err.Error(), "example.com/does/not/exist") {
+		t.Errorf("expected the package to be skipped, got %v", err)
+	}
+}
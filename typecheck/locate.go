@@ -0,0 +1,534 @@
+package typecheck
+
+import (
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/token"
+	"go/types"
+	"path/filepath"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/flyx/askew/data"
+	"github.com/flyx/net/html"
+)
+
+// locator maps errors in generated code back to the askew source.
+type locator struct {
+	c       *Checker
+	relPath string
+	pkg     *data.Package
+	info    *pkgInfo
+	// handlers that are missing or have a wrong signature. errors at calls to
+	// them are follow-up errors.
+	missing map[*data.Component]map[string]struct{}
+	// errors that have already been reported. Most code is generated both
+	// for initializing and for hydrating a component, so errors in it are
+	// reported twice by the type checker.
+	seen map[reported]struct{}
+}
+
+type reported struct {
+	node     *html.Node
+	key, msg string
+}
+
+func (l *locator) report(file *data.AskewFile, cmp *data.Component, name string,
+	h data.Handler, msg string) {
+	if l.missing[cmp] == nil {
+		l.missing[cmp] = make(map[string]struct{})
+	}
+	l.missing[cmp][name] = struct{}{}
+	l.c.syms.Report(file.Path, &data.NodeError{Node: h.Node,
+		Err: errors.New(": handler `" + name + "` of " + cmp.Name + ": " + msg)})
+}
+
+// checkHandlers checks whether the handlers declared by the given component
+// have been implemented.
+func (l *locator) checkHandlers(file *data.AskewFile, cmp *data.Component) {
+	if len(cmp.Handlers) == 0 {
+		return
+	}
+	obj := l.info.pkg.Scope().Lookup(cmp.Name)
+	if obj == nil {
+		return
+	}
+	methods := types.NewMethodSet(types.NewPointer(obj.Type()))
+	// sorted so that errors at the same position are reported in a stable
+	// order.
+	names := make([]string, 0, len(cmp.Handlers))
+	for name := range cmp.Handlers {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	for _, name := range names {
+		h := cmp.Handlers[name]
+		sel := methods.Lookup(l.info.pkg, name)
+		if sel == nil {
+			l.report(file, cmp, name, h, fmt.Sprintf(
+				"not implemented (missing method %s on *%s)", name, cmp.Name))
+			continue
+		}
+		sig, ok := sel.Obj().Type().(*types.Signature)
+		if !ok {
+			l.report(file, cmp, name, h, "not implemented (not a method)")
+			continue
+		}
+		if sig.Params().Len() != len(h.Params) {
+			l.report(file, cmp, name, h, fmt.Sprintf(
+				"implementation has %d parameters, but %d are declared",
+				sig.Params().Len(), len(h.Params)))
+			continue
+		}
+		// results of handlers without declared return type are ignored.
+		if h.Returns != nil && sig.Results().Len() != 1 {
+			l.report(file, cmp, name, h, fmt.Sprintf(
+				"implementation returns %d values, but a return type is declared",
+				sig.Results().Len()))
+		}
+	}
+}
+
+// enclosing returns the nodes in f that contain pos, from outermost to
+// innermost.
+func enclosing(f *ast.File, pos token.Pos) []ast.Node {
+	var ret []ast.Node
+	ast.Inspect(f, func(n ast.Node) bool {
+		if n == nil || pos < n.Pos() || pos >= n.End() {
+			return false
+		}
+		ret = append(ret, n)
+		return true
+	})
+	return ret
+}
+
+// componentOf returns the component the given top-level declaration has been
+// generated for.
+func componentOf(file *data.AskewFile, decl ast.Decl) *data.Component {
+	var names []string
+	switch d := decl.(type) {
+	case *ast.FuncDecl:
+		if d.Recv != nil && len(d.Recv.List) == 1 {
+			t := d.Recv.List[0].Type
+			if star, ok := t.(*ast.StarExpr); ok {
+				t = star.X
+			}
+			if id, ok := t.(*ast.Ident); ok {
+				names = append(names, id.Name)
+			}
+		} else {
+			for _, cmp := range file.Components {
+				if cmp.NewName() == d.Name.Name || cmp.HydrateName() == d.Name.Name {
+					return cmp
+				}
+			}
+		}
+	case *ast.GenDecl:
+		for _, spec := range d.Specs {
+			switch s := spec.(type) {
+			case *ast.TypeSpec:
+				names = append(names, s.Name.Name)
+			case *ast.ValueSpec:
+				for _, id := range s.Names {
+					names = append(names, strings.TrimSuffix(
+						strings.TrimPrefix(id.Name, "α"), "Template"))
+				}
+			}
+		}
+	}
+	for _, name := range names {
+		candidates := []string{name, strings.TrimSuffix(name, "List"),
+			strings.TrimPrefix(name, "Optional"), strings.TrimSuffix(name, "Controller"),
+			strings.TrimSuffix(strings.TrimPrefix(name, "Virtual"), "List")}
+		for _, c := range candidates {
+			if cmp, ok := file.Components[c]; ok {
+				return cmp
+			}
+		}
+	}
+	return nil
+}
+
+// fieldOf returns the name of the field or variable at the root of the given
+// selector chain. recv is the name of the variable containing the fields,
+// or empty if they are global variables.
+func fieldOf(e ast.Expr, recv string) (name string, direct bool) {
+	for direct = true; ; direct = false {
+		switch x := e.(type) {
+		case *ast.SelectorExpr:
+			if id, ok := x.X.(*ast.Ident); ok {
+				if recv == "" {
+					return id.Name, false
+				}
+				if id.Name == recv {
+					return x.Sel.Name, direct
+				}
+				return "", false
+			}
+			e = x.X
+		case *ast.Ident:
+			if recv == "" {
+				return x.Name, direct
+			}
+			return "", false
+		default:
+			return "", false
+		}
+	}
+}
+
+func contains(nodes []ast.Expr, pos token.Pos) bool {
+	for _, n := range nodes {
+		if n.Pos() <= pos && pos < n.End() {
+			return true
+		}
+	}
+	return false
+}
+
+// embedOf searches the given nodes, innermost first, for code generated for
+// one of the given embeds. Returns the embed and the attribute the code has
+// been generated from, if known.
+func embedOf(nodes []ast.Node, pos token.Pos, embeds []data.Embed,
+	recv string) (*data.Embed, string) {
+	find := func(e ast.Expr) (*data.Embed, bool) {
+		name, direct := fieldOf(e, recv)
+		for i := range embeds {
+			if embeds[i].Field == name {
+				return &embeds[i], direct
+			}
+		}
+		return nil, false
+	}
+	for i := len(nodes) - 1; i >= 0; i-- {
+		switch n := nodes[i].(type) {
+		case *ast.CallExpr:
+			sel, ok := n.Fun.(*ast.SelectorExpr)
+			if !ok {
+				continue
+			}
+			if e, direct := find(sel.X); e != nil {
+				if direct && (sel.Sel.Name == "Init" || sel.Sel.Name == "Hydrate") &&
+					contains(n.Args, pos) {
+					return e, "args"
+				}
+				return e, ""
+			}
+		case *ast.AssignStmt:
+			for _, lhs := range n.Lhs {
+				if e, direct := find(lhs); e != nil {
+					if direct {
+						return e, "value"
+					}
+					if sel, ok := lhs.(*ast.SelectorExpr); ok &&
+						strings.HasSuffix(sel.Sel.Name, "Controller") {
+						return e, "control"
+					}
+					return e, ""
+				}
+			}
+		}
+	}
+	return nil, ""
+}
+
+// isHydrate checks whether the given declaration is the askewHydrate method
+// of a component.
+func isHydrate(decl ast.Node) bool {
+	fd, ok := decl.(*ast.FuncDecl)
+	return ok && fd.Recv != nil && fd.Name.Name == "askewHydrate"
+}
+
+// capturePath returns the path given to `Walk` in the assignment to
+// `src` inside the given block, which is the code generated for a capture.
+func capturePath(block *ast.BlockStmt) ([]int, bool) {
+	for _, stmt := range block.List {
+		assign, ok := stmt.(*ast.AssignStmt)
+		if !ok || len(assign.Lhs) != 1 || len(assign.Rhs) != 1 {
+			continue
+		}
+		if id, ok := assign.Lhs[0].(*ast.Ident); !ok || id.Name != "src" {
+			continue
+		}
+		call, ok := assign.Rhs[0].(*ast.CallExpr)
+		if !ok {
+			continue
+		}
+		if sel, ok := call.Fun.(*ast.SelectorExpr); !ok || sel.Sel.Name != "Walk" {
+			continue
+		}
+		ret := make([]int, 0, len(call.Args))
+		for _, arg := range call.Args {
+			lit, ok := arg.(*ast.BasicLit)
+			if !ok {
+				return nil, false
+			}
+			i, err := strconv.Atoi(lit.Value)
+			if err != nil {
+				return nil, false
+			}
+			ret = append(ret, i)
+		}
+		return ret, true
+	}
+	return nil, false
+}
+
+func equalPaths(a, b []int) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// handlerOf returns the name of the handler called in the innermost call
+// inside nodes.
+func handlerOf(nodes []ast.Node) string {
+	for i := len(nodes) - 1; i >= 0; i-- {
+		if call, ok := nodes[i].(*ast.CallExpr); ok {
+			if sel, ok := call.Fun.(*ast.SelectorExpr); ok {
+				if name, _ := fieldOf(sel, "o"); name != "" {
+					return sel.Sel.Name
+				}
+			}
+		}
+	}
+	return ""
+}
+
+// blockCaptures returns the captures inside the control blocks of b, in the
+// order of the code generated for them.
+func blockCaptures(b *data.Block) []*data.Capture {
+	var ret []*data.Capture
+	for i := range b.Captures {
+		ret = append(ret, &b.Captures[i])
+	}
+	for _, cb := range b.Controlled {
+		for _, governed := range cb.Blocks() {
+			ret = append(ret, blockCaptures(&governed.Block)...)
+		}
+	}
+	return ret
+}
+
+// isBlockCapture returns true if n is the call that attaches a capture inside
+// a control block.
+func isBlockCapture(n ast.Node) bool {
+	call, ok := n.(*ast.CallExpr)
+	if !ok || len(call.Args) != 2 {
+		return false
+	}
+	if id, ok := call.Fun.(*ast.Ident); !ok || id.Name != "αbind" {
+		return false
+	}
+	fn, ok := call.Args[1].(*ast.FuncLit)
+	if !ok || len(fn.Type.Params.List) != 1 {
+		return false
+	}
+	names := fn.Type.Params.List[0].Names
+	return len(names) == 1 && names[0].Name == "src"
+}
+
+// liveForOf returns the a:for.live of the given component whose askewFor
+// method is the declaration in nodes, if any.
+func liveForOf(nodes []ast.Node, cmp *data.Component) *data.LiveFor {
+	if len(nodes) < 2 {
+		return nil
+	}
+	fd, ok := nodes[1].(*ast.FuncDecl)
+	if !ok || fd.Recv == nil || !strings.HasPrefix(fd.Name.Name, "askewFor") {
+		return nil
+	}
+	index, err := strconv.Atoi(strings.TrimPrefix(fd.Name.Name, "askewFor"))
+	if err == nil && index < len(cmp.LiveFor) {
+		return cmp.LiveFor[index]
+	}
+	return nil
+}
+
+// captureOf searches the given nodes for the code generated for a capture of
+// the given component. Captures inside control blocks are identified by the
+// order of the calls attaching them in the enclosing function, which is the
+// askewFor method for captures inside an a:for.live.
+func captureOf(nodes []ast.Node, cmp *data.Component) *data.Capture {
+	block := &cmp.Block
+	if lf := liveForOf(nodes, cmp); lf != nil {
+		block = &lf.Block
+	}
+	for i := len(nodes) - 1; i >= 2; i-- {
+		if !isBlockCapture(nodes[i]) {
+			continue
+		}
+		index := 0
+		ast.Inspect(nodes[1], func(n ast.Node) bool {
+			if n != nil && n.Pos() < nodes[i].Pos() && isBlockCapture(n) {
+				index++
+			}
+			return true
+		})
+		if captures := blockCaptures(block); index < len(captures) {
+			return captures[index]
+		}
+		return nil
+	}
+	for i := len(nodes) - 1; i >= 0; i-- {
+		block, ok := nodes[i].(*ast.BlockStmt)
+		if !ok {
+			continue
+		}
+		if path, ok := capturePath(block); ok {
+			for j := range cmp.Captures {
+				if equalPaths(cmp.Captures[j].Path, path) {
+					return &cmp.Captures[j]
+				}
+			}
+			return nil
+		}
+	}
+	return nil
+}
+
+// observedOf searches the given nodes for the code generated for an observed
+// value of the given component. The observed values are identified by the
+// order of the calls to Observe and Model in the enclosing function.
+func observedOf(nodes []ast.Node, cmp *data.Component) *data.Assignment {
+	isObserve := func(n ast.Node) bool {
+		call, ok := n.(*ast.CallExpr)
+		if !ok {
+			return false
+		}
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok || (sel.Sel.Name != "Observe" && sel.Sel.Name != "Model") {
+			return false
+		}
+		name, _ := fieldOf(sel.X, "o")
+		return name == "αcd"
+	}
+	if len(nodes) < 2 {
+		return nil
+	}
+	for i := len(nodes) - 1; i >= 2; i-- {
+		if !isObserve(nodes[i]) {
+			continue
+		}
+		index := 0
+		ast.Inspect(nodes[1], func(n ast.Node) bool {
+			if n != nil && n.Pos() < nodes[i].Pos() && isObserve(n) {
+				index++
+			}
+			return true
+		})
+		if index < len(cmp.Observed) {
+			return &cmp.Observed[index]
+		}
+		return nil
+	}
+	return nil
+}
+
+// liveOf searches the given nodes for the declaration of the observable of an
+// a:if.live of the given component. The declared variable is named after the
+// index of the a:if.live.
+func liveOf(nodes []ast.Node, cmp *data.Component) *data.LiveIf {
+	for i := len(nodes) - 1; i >= 0; i-- {
+		spec, ok := nodes[i].(*ast.ValueSpec)
+		if !ok || len(spec.Names) != 1 || !strings.HasPrefix(spec.Names[0].Name, "αs") {
+			continue
+		}
+		index, err := strconv.Atoi(strings.TrimPrefix(spec.Names[0].Name, "αs"))
+		if err == nil && index < len(cmp.Live) {
+			return &cmp.Live[index]
+		}
+		return nil
+	}
+	return nil
+}
+
+// locate maps e to the askew source. Returns the path of the askew source
+// file and the error to report, which is nil if e is a follow-up error.
+func (l *locator) locate(e types.Error) (string, error) {
+	pos := l.c.fset.Position(e.Pos)
+	msg := ": " + e.Msg + " (in generated code at " + pos.String() + ")"
+	var f *ast.File
+	for _, cur := range l.info.files {
+		if l.c.fset.Position(cur.Pos()).Filename == pos.Filename {
+			f = cur
+			break
+		}
+	}
+	nodes := enclosing(f, e.Pos)
+
+	var node *html.Node
+	var key string
+	var sourcePath string
+	if site := l.pkg.Site; site != nil &&
+		pos.Filename == filepath.Join(l.relPath, site.BaseName+".asite.go") {
+		sourcePath = site.Path
+		node = site.RootNode()
+		recv := ""
+		if site.VarName != nil {
+			recv = *site.VarName
+		}
+		if embed, attr := embedOf(nodes, e.Pos, site.Embeds, recv); embed != nil {
+			node, key = embed.Node, attr
+		}
+	} else {
+		var file *data.AskewFile
+		for _, cur := range l.pkg.Files {
+			if pos.Filename == filepath.Join(l.relPath, cur.BaseName+".askew.go") {
+				file = cur
+				break
+			}
+		}
+		if file == nil {
+			return pos.Filename, errors.New(msg[2:])
+		}
+		sourcePath = file.Path
+		var cmp *data.Component
+		if len(nodes) > 1 {
+			cmp = componentOf(file, nodes[1].(ast.Decl))
+		}
+		if cmp == nil {
+			return sourcePath, errors.New(msg[2:])
+		}
+		node = cmp.Node
+		if embed, attr := embedOf(nodes, e.Pos, cmp.Embeds, "o"); embed != nil {
+			if isHydrate(nodes[1]) {
+				// the arguments of embedded components are given both in askewInit
+				// and askewHydrate. The messages of the errors differ in the name
+				// of the called function, so they are only reported for askewInit.
+				return sourcePath, nil
+			}
+			node, key = embed.Node, attr
+		} else if capture := captureOf(nodes, cmp); capture != nil {
+			if _, ok := l.missing[cmp][handlerOf(nodes)]; ok {
+				return sourcePath, nil
+			}
+			node, key = capture.Node, "a:capture"
+		} else if observed := observedOf(nodes, cmp); observed != nil {
+			node, key = observed.Node, "a:bindings"
+		} else if live := liveOf(nodes, cmp); live != nil {
+			node, key = live.Node, "a:if.live"
+		} else if lf := liveForOf(nodes, cmp); lf != nil {
+			node, key = lf.Node, "a:for.live"
+		}
+	}
+	r := reported{node: node, key: key, msg: e.Msg}
+	if _, ok := l.seen[r]; ok {
+		return sourcePath, nil
+	}
+	l.seen[r] = struct{}{}
+	if key == "" {
+		return sourcePath, &data.NodeError{Node: node, Err: errors.New(msg)}
+	}
+	return sourcePath, &data.NodeError{Node: node,
+		Err: &data.AttrError{Key: key, Offset: -1, Err: errors.New(msg)}}
+}
@@ -0,0 +1,15 @@
+package check
+
+func (o *Form) Submit(name string) {}
+
+func (o *Form) Reset() {}
+
+func (o *Form) Extra() (int, error) { return 0, nil }
+
+func (o *Form) Changed(name int) {}
+
+func (o *Form) emit() {
+	o.EmitSelected(1)
+}
+
+func (o *Todos) Pick(index int) {}
@@ -0,0 +1,5 @@
+package missingdep
+
+import "example.com/does/not/exist"
+
+var _ = exist.Value
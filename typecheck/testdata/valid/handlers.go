@@ -0,0 +1,5 @@
+package valid
+
+func (o *Counter) Increment() {
+	o.Label.Set(o.Label.Get() + "+")
+}
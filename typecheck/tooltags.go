@@ -0,0 +1,23 @@
+//go:build go1.17
+// +build go1.17
+
+package typecheck
+
+import (
+	"go/build"
+	"runtime"
+	"strings"
+)
+
+// adjustToolTags removes the tool tags of the host architecture that do not
+// apply to wasm.
+func adjustToolTags(ctxt *build.Context) {
+	tags := make([]string, 0, len(ctxt.ToolTags))
+	for _, tag := range ctxt.ToolTags {
+		if !strings.HasPrefix(tag, "goexperiment.regabi") &&
+			!strings.HasPrefix(tag, runtime.GOARCH+".") {
+			tags = append(tags, tag)
+		}
+	}
+	ctxt.ToolTags = tags
+}
@@ -0,0 +1,9 @@
+//go:build !go1.17
+// +build !go1.17
+
+package typecheck
+
+import "go/build"
+
+// adjustToolTags does nothing since tool tags do not exist before Go 1.17.
+func adjustToolTags(ctxt *build.Context) {}
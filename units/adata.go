@@ -25,7 +25,7 @@ func (dp *aDataProcessor) Process(n *html.Node) (descend bool,
 	}
 	fields, err := parsers.ParseFields(def.Data)
 	if err != nil {
-		return false, nil, errors.New(": unable to parse fields: " + err.Error())
+		return false, nil, parsers.TextError(": unable to parse fields: ", def.Data, err)
 	}
 	if dp.cmp.Fields != nil {
 		names := make(map[string]struct{})
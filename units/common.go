@@ -28,8 +28,9 @@ func (p *unitProcessor) processUnitContent(
 	if component != nil {
 		w.Data = &aDataProcessor{component, &indexList}
 		w.Controller = &controllerProcessor{p.syms, component, &indexList}
-		w.StdElements = &elementHandler{stdElementHandler{p.syms, &indexList, &unit.Block, -1, nil}, component}
+		w.StdElements = &elementHandler{stdElementHandler{p.syms, &indexList, &unit.Block, -1, nil, nil, nil, component, 0}}
 		w.Handlers = &handlersProcessor{p.syms, component, &indexList}
+		w.Events = &eventsProcessor{p.syms, component, &indexList}
 	} else {
 		w.StdElements = walker.Allow{}
 	}
@@ -18,27 +18,36 @@ func (p *componentProcessor) Process(n *html.Node) (descend bool,
 	replacement *html.Node, err error) {
 	var cmpAttrs attributes.Component
 	err = attributes.Collect(n, &cmpAttrs)
+	if err == nil && len(cmpAttrs.Name) == 0 {
+		err = errors.New(": attribute `name` missing")
+	}
 	if err != nil {
+		// the component cannot be registered, so references to it would yield
+		// follow-up errors.
+		p.syms.Packages[p.syms.CurPkg].MarkFailed(cmpAttrs.Name)
 		return
 	}
-	if len(cmpAttrs.Name) == 0 {
-		return false, nil, errors.New(": attribute `name` missing")
-	}
 
 	replacement = &html.Node{Type: html.DocumentNode}
 	cmp := &data.Component{Unit: data.Unit{}, Template: replacement,
 		Name: cmpAttrs.Name, Parameters: cmpAttrs.Params,
-		GenNewInit: cmpAttrs.GenNewInit}
+		GenNewInit: cmpAttrs.GenNewInit, Node: n}
 	if cmpAttrs.Usage == nil {
+		// the virtual list type is only generated if it is used, since it is
+		// large and rarely needed.
 		cmp.GenList, cmp.GenOpt = true, true
 	} else {
+		cmp.UsageRestricted = true
 		for _, item := range cmpAttrs.Usage {
 			switch strings.ToLower(item) {
 			case "list":
 				cmp.GenList = true
 			case "optional":
 				cmp.GenOpt = true
+			case "virtual":
+				cmp.GenVirtual = true
 			default:
+				p.syms.Packages[p.syms.CurPkg].MarkFailed(cmpAttrs.Name)
 				return false, nil, errors.New(": attribute `usage` contains unknown name: " + item)
 			}
 		}
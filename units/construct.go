@@ -12,8 +12,9 @@ import (
 )
 
 type constructParent struct {
-	newName   string
-	numParams int
+	newName    string
+	numParams  int
+	hydratable bool
 }
 
 type constructProcessor struct {
@@ -27,18 +28,22 @@ func (cp *constructProcessor) Process(n *html.Node) (descend bool,
 	if cp.e.Kind == data.DirectEmbed {
 		return false, nil, errors.New(": element requires list or optional embed as parent")
 	}
+	if cp.e.Virtual {
+		return false, nil, errors.New(": virtual list cannot have <a:construct>")
+	}
 	typeAttr := attributes.Val(n.Attr, "type")
 	var newName string
+	var hydratable bool
 	if typeAttr == "" {
 		if cp.parentType.newName == "" {
 			return false, nil, errors.New(": must supply type ")
 		}
-		newName = cp.parentType.newName
+		newName, hydratable = cp.parentType.newName, cp.parentType.hydratable
 	} else {
 		c, symName, aliasName, err := cp.syms.ResolveComponent(typeAttr)
 		if err != nil {
 			if _, ok := err.(data.OutsideModuleErr); !ok {
-				return false, nil, err
+				return false, nil, attributes.ValueError("type", typeAttr, err)
 			}
 			newName = aliasName + ".New" + symName
 		}
@@ -46,6 +51,7 @@ func (cp *constructProcessor) Process(n *html.Node) (descend bool,
 			newName = aliasName + "."
 		}
 		newName += c.NewName()
+		hydratable = c.GenNewInit
 	}
 
 	var attrs attributes.General
@@ -55,18 +61,32 @@ func (cp *constructProcessor) Process(n *html.Node) (descend bool,
 	if attrs.Assign != nil {
 		return false, nil, errors.New(": a:assign not allowed here")
 	}
-	if attrs.Bindings != nil {
+	if attrs.Bindings != nil || attrs.Observed != nil {
 		return false, nil, errors.New(": a:bindings not allowed here")
 	}
 	if attrs.Capture != nil {
 		return false, nil, errors.New(": a:capture not allowed here")
 	}
+	if attrs.ElseIf != nil || attrs.Else {
+		return false, nil, errors.New(": a:else-if and a:else not allowed here")
+	}
+	if attrs.Switch != nil || attrs.Case != "" || attrs.Default {
+		return false, nil, errors.New(": a:switch, a:case and a:default not allowed here")
+	}
+	if attrs.IfLive != "" {
+		return false, nil, errors.New(": a:if.live not allowed here")
+	}
+	if attrs.ForLive != nil {
+		return false, nil, errors.New(": a:for.live not allowed here")
+	}
 	if attrs.For != nil && attrs.If != nil {
 		return false, nil, errors.New(": cannot have both a:if and a:for here")
 	}
-	args, err := parsers.AnalyseArguments(attributes.Val(n.Attr, "args"))
+	rawArgs := attributes.Val(n.Attr, "args")
+	args, err := parsers.AnalyseArguments(rawArgs)
 	if err != nil {
-		return false, nil, errors.New(": in args: " + err.Error())
+		return false, nil, attributes.ValueError("args", rawArgs,
+			parsers.Wrap(": in args: ", err))
 	}
 	if cp.parentType.numParams >= 0 && args.Count != cp.parentType.numParams {
 		return false, nil, fmt.Errorf(
@@ -75,7 +95,8 @@ func (cp *constructProcessor) Process(n *html.Node) (descend bool,
 	if attrs.If != nil {
 		cp.e.ConstructorCalls = append(cp.e.ConstructorCalls,
 			data.ConstructorCall{ConstructorName: newName, Args: args,
-				Kind: data.ConstructIf, Expression: attrs.If.Expression})
+				Kind: data.ConstructIf, Expression: attrs.If.Expression,
+				Hydratable: hydratable})
 	} else if attrs.For != nil {
 		if cp.e.Kind == data.OptionalEmbed {
 			return false, nil, errors.New(": a:for not allowed inside optional embed")
@@ -83,11 +104,12 @@ func (cp *constructProcessor) Process(n *html.Node) (descend bool,
 		cp.e.ConstructorCalls = append(cp.e.ConstructorCalls,
 			data.ConstructorCall{ConstructorName: newName, Args: args,
 				Kind: data.ConstructFor, Index: attrs.For.Index,
-				Variable: attrs.For.Variable, Expression: attrs.For.Expression})
+				Variable: attrs.For.Variable, Expression: attrs.For.Expression,
+				Hydratable: hydratable})
 	} else {
 		cp.e.ConstructorCalls = append(cp.e.ConstructorCalls,
 			data.ConstructorCall{ConstructorName: newName, Args: args,
-				Kind: data.ConstructDirect})
+				Kind: data.ConstructDirect, Hydratable: hydratable})
 	}
 	w := walker.Walker{TextNode: walker.WhitespaceOnly{}}
 	_, _, err = w.WalkChildren(n, &walker.Siblings{Cur: n.FirstChild})
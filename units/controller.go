@@ -28,7 +28,7 @@ func (cp *controllerProcessor) Process(n *html.Node) (descend bool,
 	}
 	parsed, err := parsers.ParseHandlers(def.Data)
 	if err != nil {
-		return false, nil, errors.New(": unable to parse `" + def.Data + "`: " + err.Error())
+		return false, nil, parsers.TextError(": unable to parse `"+def.Data+"`: ", def.Data, err)
 	}
 	cp.cmp.Controller = make(map[string]data.ControllerMethod)
 	for _, raw := range parsed {
@@ -41,7 +41,7 @@ func (cp *controllerProcessor) Process(n *html.Node) (descend bool,
 		}
 		cp.cmp.Controller[raw.Name] =
 			data.ControllerMethod{
-				Handler: data.Handler{Params: raw.Params, Returns: raw.Returns}}
+				Handler: data.Handler{Params: raw.Params, Returns: raw.Returns, Node: n}}
 	}
 
 	replacement = &html.Node{Type: html.CommentNode, Data: "controller"}
@@ -82,40 +82,47 @@ type stdElementHandler struct {
 	b          *data.Block
 	curFormPos int
 	curForm    map[string]formValue
+	// lastIf is the IfBlock of lastIfNode, the last element processed that had
+	// a:if or a:else-if, which may be continued by a:else-if or a:else.
+	lastIf     *data.ControlBlock
+	lastIfNode *html.Node
+	cmp        *data.Component
+	// loops is the number of a:for the processed elements are inside.
+	loops int
 }
 
 type elementHandler struct {
 	stdElementHandler
-	cmp *data.Component
 }
 
-func (eh *elementHandler) mapCaptures(n *html.Node, v []data.UnboundEventMapping) error {
+// mapCaptures returns the capture of n given by v, or nil if v is empty.
+func (seh *stdElementHandler) mapCaptures(n *html.Node, v []data.UnboundEventMapping) (*data.Capture, error) {
 	if len(v) == 0 {
-		return nil
+		return nil, nil
 	}
 	formDepth := -1
-	if eh.curFormPos != -1 {
-		formDepth = len(*eh.indexList) - eh.curFormPos
+	if seh.curFormPos != -1 {
+		formDepth = len(*seh.indexList) - seh.curFormPos
 	}
 	ret := make([]data.EventMapping, 0, len(v))
 	for _, unmapped := range v {
 		fromController := false
 		var h data.Handler
 		ok := false
-		if eh.cmp.Handlers != nil {
-			h, ok = eh.cmp.Handlers[unmapped.Handler]
+		if seh.cmp.Handlers != nil {
+			h, ok = seh.cmp.Handlers[unmapped.Handler]
 		}
 		if !ok {
-			if eh.cmp.Controller != nil {
+			if seh.cmp.Controller != nil {
 				var c data.ControllerMethod
-				c, ok = eh.cmp.Controller[unmapped.Handler]
+				c, ok = seh.cmp.Controller[unmapped.Handler]
 				if ok {
 					h = c.Handler
 					fromController = true
 				}
 			}
 			if !ok {
-				return errors.New("capture references unknown handler: " + unmapped.Handler)
+				return nil, errors.New("capture references unknown handler: " + unmapped.Handler)
 			}
 		}
 		notMapped := make(map[string]struct{})
@@ -128,7 +135,7 @@ func (eh *elementHandler) mapCaptures(n *html.Node, v []data.UnboundEventMapping
 			ikey := fmt.Sprintf("~%v", pIndex)
 			biVal, iok := unmapped.ParamMappings[ikey]
 			if ok && iok {
-				return fmt.Errorf("param %v cannot be bound both named and unnamed", p)
+				return nil, fmt.Errorf("param %v cannot be bound both named and unnamed", p)
 			}
 			if !ok {
 				bVal, ok = biVal, iok
@@ -144,24 +151,27 @@ func (eh *elementHandler) mapCaptures(n *html.Node, v []data.UnboundEventMapping
 						Kind: data.BoundDataset, IDs: []string{p.Name}}})
 			} else {
 				if bVal.Kind == data.BoundFormValue {
-					if formDepth == -1 {
-						return errors.New(": illegal form() binding outside of <form> element")
+					if seh.curForm == nil {
+						return nil, errors.New(": illegal form() binding outside of <form> element")
 					}
 					bVal.FormDepth = formDepth
-					_, ok := eh.curForm[bVal.ID()]
+					_, ok := seh.curForm[bVal.ID()]
 					if !ok {
-						return errors.New(": unknown form value name: `" + bVal.ID() + "`")
+						return nil, errors.New(": unknown form value name: `" + bVal.ID() + "`")
 					}
 				}
 				mapped = append(mapped, data.BoundParam{Param: p, Value: bVal})
 			}
 		}
 		for unknown := range notMapped {
-			return errors.New("unknown param for capture mapping: " + unknown)
+			return nil, errors.New("unknown param for capture mapping: " + unknown)
 		}
 		handling := unmapped.Handling
 		if handling == data.AutoPreventDefault {
-			if h.Returns != nil && h.Returns.Kind == data.BoolType {
+			// passive listeners cannot prevent the default action, and neither
+			// can handlers that are called after the event has been dispatched.
+			if h.Returns != nil && h.Returns.Kind == data.BoolType &&
+				!unmapped.Options.Passive && !unmapped.Options.IsLimited() {
 				handling = data.AskPreventDefault
 			} else {
 				handling = data.DontPreventDefault
@@ -169,30 +179,32 @@ func (eh *elementHandler) mapCaptures(n *html.Node, v []data.UnboundEventMapping
 		}
 		ret = append(ret, data.EventMapping{
 			Event: unmapped.Event, Handler: unmapped.Handler, ParamMappings: mapped,
-			Handling: handling, FromController: fromController})
+			Handling: handling, Options: unmapped.Options,
+			FromController: fromController})
 	}
 
-	eh.cmp.Captures = append(eh.cmp.Captures, data.Capture{
-		Path: append([]int(nil), *eh.indexList...), Mappings: ret})
-	return nil
+	return &data.Capture{
+		Path: append([]int(nil), *seh.indexList...), Mappings: ret, Node: n}, nil
 }
 
-func (eh *elementHandler) processBindings(arr []data.VariableMapping) error {
+// processBindings returns the variables given by arr.
+func (seh *stdElementHandler) processBindings(arr []data.VariableMapping) ([]data.VariableMapping, error) {
 	formDepth := -1
-	if eh.curFormPos != -1 {
-		formDepth = len(*eh.indexList) - eh.curFormPos
+	if seh.curFormPos != -1 {
+		formDepth = len(*seh.indexList) - seh.curFormPos
 	}
-	path := append([]int(nil), *eh.indexList...)
+	path := append([]int(nil), *seh.indexList...)
 
+	ret := make([]data.VariableMapping, 0, len(arr))
 	for _, vb := range arr {
 		if vb.Value.Kind == data.BoundFormValue {
 			if formDepth == -1 {
-				return errors.New(": illegal form() binding outside of <form> element")
+				return nil, seh.formError()
 			}
 			vb.Value.FormDepth = formDepth
-			val, ok := eh.curForm[vb.Value.ID()]
+			val, ok := seh.curForm[vb.Value.ID()]
 			if !ok {
-				return errors.New(": unknown form value name: `" + vb.Value.ID() + "`")
+				return nil, errors.New(": unknown form value name: `" + vb.Value.ID() + "`")
 			}
 			if vb.Variable.Type == nil {
 				vb.Variable.Type = val.t
@@ -213,8 +225,33 @@ func (eh *elementHandler) processBindings(arr []data.VariableMapping) error {
 				}
 			}
 		}
-		vb.Path = path
-		eh.cmp.Variables = append(eh.cmp.Variables, vb)
+		vb.Path, vb.Loops = path, seh.loops
+		ret = append(ret, vb)
+	}
+	return ret, nil
+}
+
+func (eh *elementHandler) processObserved(n *html.Node, arr []data.Assignment) error {
+	formDepth := -1
+	if eh.curFormPos != -1 {
+		formDepth = len(*eh.indexList) - eh.curFormPos
+	}
+	path := append([]int(nil), *eh.indexList...)
+
+	for _, o := range arr {
+		if o.Target.Kind == data.BoundFormValue {
+			if formDepth == -1 {
+				return errors.New(": illegal form() binding outside of <form> element")
+			}
+			o.Target.FormDepth = formDepth
+			val, ok := eh.curForm[o.Target.ID()]
+			if !ok {
+				return errors.New(": unknown form value name: `" + o.Target.ID() + "`")
+			}
+			o.Target.IsRadio = val.radio
+		}
+		o.Path, o.Node = path, n
+		eh.cmp.Observed = append(eh.cmp.Observed, o)
 	}
 	return nil
 }
@@ -228,7 +265,7 @@ func (seh *stdElementHandler) processAssignments(arr []data.Assignment, path []i
 	for _, a := range arr {
 		if a.Target.Kind == data.BoundFormValue {
 			if formDepth == -1 {
-				return errors.New(": illegal form() binding outside of <form> element")
+				return seh.formError()
 			}
 			a.Target.FormDepth = formDepth
 			_, ok := seh.curForm[a.Target.IDs[0]]
@@ -242,6 +279,15 @@ func (seh *stdElementHandler) processAssignments(arr []data.Assignment, path []i
 	return nil
 }
 
+// formError returns the error for a form() binding that cannot reach its
+// form.
+func (seh *stdElementHandler) formError() error {
+	if seh.curForm != nil {
+		return errors.New(": form() binding inside a:if, a:for or a:switch must be inside the <form> as well")
+	}
+	return errors.New(": illegal form() binding outside of <form> element")
+}
+
 func (seh *stdElementHandler) updateCurForm(n *html.Node) error {
 	if len(*seh.indexList) <= seh.curFormPos {
 		seh.curFormPos = -1
@@ -262,7 +308,7 @@ func (seh *stdElementHandler) updateCurForm(n *html.Node) error {
 }
 
 func (seh *stdElementHandler) handleControlBlocksAndAssignments(n *html.Node, attrs attributes.General) (descend bool, err error) {
-	var block *data.ControlBlock
+	var block, chain *data.ControlBlock
 
 	if attrs.If != nil {
 		block = attrs.If
@@ -274,31 +320,53 @@ func (seh *stdElementHandler) handleControlBlocksAndAssignments(n *html.Node, at
 
 		block = attrs.For
 	}
+	if attrs.ElseIf != nil || attrs.Else {
+		switch {
+		case attrs.ElseIf != nil && attrs.Else:
+			return false, errors.New(": cannot have a:else-if and a:else on same element")
+		case attrs.If != nil:
+			return false, errors.New(": cannot have a:if and a:else-if or a:else on same element")
+		case attrs.For != nil:
+			return false, errors.New(": cannot have a:for and a:else-if or a:else on same element")
+		}
+		chain = seh.precedingIf(n)
+		if chain == nil {
+			return false, errors.New(": a:else-if and a:else must follow an element with a:if or a:else-if")
+		}
+		if attrs.ElseIf != nil {
+			block = attrs.ElseIf
+		} else {
+			block = &data.ControlBlock{Kind: data.IfBlock}
+		}
+	}
+	if attrs.Case != "" || attrs.Default {
+		return false, errors.New(": a:case and a:default must be on a child of an element with a:switch")
+	}
+	if attrs.Switch != nil {
+		if block != nil {
+			return false, errors.New(": cannot have a:switch together with a:if, a:for, a:else-if or a:else")
+		}
+		return false, seh.processSwitch(n, attrs)
+	}
 	if block != nil {
 		block.Path = append([]int(nil), *seh.indexList...)
-		var indexList []int
-		cp := &ctrlBlockElementProcessor{stdElementHandler{seh.syms, &indexList, &block.Block, seh.curFormPos, seh.curForm}}
-		cp.processAssignments(attrs.Assign, []int{})
-
-		w := walker.Walker{
-			TextNode: walker.Allow{}, Text: &aTextProcessor{&block.Block, &indexList},
-			Embed:       &embedProcessor{seh.syms, &indexList, block.Path},
-			StdElements: cp,
-			IndexList:   &indexList}
-		n.FirstChild, n.LastChild, err = w.WalkChildren(n, &walker.Siblings{Cur: n.FirstChild})
-		if err != nil {
+		if err = seh.processBlock(n, block, attrs); err != nil {
 			return false, err
 		}
 
-		// reverse contained control blocks so that they are processed back to front,
-		// ensuring that their paths are correct.
-		tmp := make([]*data.ControlBlock, len(block.Controlled))
-		for i, e := range block.Controlled {
-			tmp[len(tmp)-i-1] = e
+		switch {
+		case chain == nil:
+			seh.b.Controlled = append(seh.b.Controlled, block)
+			if attrs.If != nil {
+				seh.lastIf, seh.lastIfNode = block, n
+			}
+		case attrs.Else:
+			chain.Else = append(chain.Else, block)
+			seh.lastIf, seh.lastIfNode = nil, nil
+		default:
+			chain.Else = append(chain.Else, block)
+			seh.lastIfNode = n
 		}
-		block.Controlled = tmp
-
-		seh.b.Controlled = append(seh.b.Controlled, block)
 		return false, nil
 	}
 	err = seh.processAssignments(attrs.Assign, append([]int(nil), *seh.indexList...))
@@ -308,6 +376,125 @@ func (seh *stdElementHandler) handleControlBlocksAndAssignments(n *html.Node, at
 	return true, nil
 }
 
+// processBlock processes n, which is the element at the given block's path,
+// and its content as content of the block.
+func (seh *stdElementHandler) processBlock(n *html.Node, block *data.ControlBlock,
+	attrs attributes.General) (err error) {
+	var indexList []int
+	// paths inside the block are relative to n, so a form outside of the
+	// block cannot be reached. Captures can still use its values.
+	formPos, form := seh.curFormPos, seh.curForm
+	if formPos < len(*seh.indexList) {
+		formPos = -1
+	} else {
+		formPos -= len(*seh.indexList)
+	}
+	loops := seh.loops
+	if block.Kind == data.ForBlock {
+		loops++
+		block.Loops = loops
+	}
+	cp := &ctrlBlockElementProcessor{stdElementHandler{seh.syms, &indexList,
+		&block.Block, formPos, form, nil, nil, seh.cmp, loops}}
+	if err = cp.processAssignments(attrs.Assign, []int{}); err != nil {
+		return err
+	}
+	if err = cp.processDynamic(n, attrs); err != nil {
+		return err
+	}
+
+	w := walker.Walker{
+		TextNode: walker.Allow{}, Text: &aTextProcessor{&block.Block, &indexList},
+		Embed:       &embedProcessor{seh.syms, &indexList, block.Path},
+		StdElements: cp,
+		IndexList:   &indexList}
+	n.FirstChild, n.LastChild, err = w.WalkChildren(n, &walker.Siblings{Cur: n.FirstChild})
+	if err != nil {
+		return err
+	}
+
+	// reverse contained control blocks so that they are processed back to front,
+	// ensuring that their paths are correct.
+	tmp := make([]*data.ControlBlock, len(block.Controlled))
+	for i, e := range block.Controlled {
+		tmp[len(tmp)-i-1] = e
+	}
+	block.Controlled = tmp
+	return nil
+}
+
+// processSwitch processes n, which has a:switch. Its child elements are the
+// cases of the switch.
+func (seh *stdElementHandler) processSwitch(n *html.Node, attrs attributes.General) (err error) {
+	block := attrs.Switch
+	block.Path = append([]int(nil), *seh.indexList...)
+	if err = seh.processAssignments(attrs.Assign, block.Path); err != nil {
+		return err
+	}
+	w := walker.Walker{TextNode: walker.WhitespaceOnly{},
+		StdElements: &caseProcessor{seh, block}, IndexList: seh.indexList}
+	n.FirstChild, n.LastChild, err = w.WalkChildren(n, &walker.Siblings{Cur: n.FirstChild})
+	if err != nil {
+		return err
+	}
+	seh.b.Controlled = append(seh.b.Controlled, block)
+	return nil
+}
+
+// caseProcessor processes the children of an element with a:switch.
+type caseProcessor struct {
+	seh   *stdElementHandler
+	block *data.ControlBlock
+}
+
+func (cp *caseProcessor) Process(n *html.Node) (descend bool, replacement *html.Node, err error) {
+	if err = cp.seh.updateCurForm(n); err != nil {
+		return
+	}
+
+	var attrs attributes.General
+	if err = attributes.ExtractAskewAttribs(n, &attrs); err != nil {
+		return
+	}
+	switch {
+	case attrs.Case == "" && !attrs.Default:
+		return false, nil, errors.New(": children of a:switch must have a:case or a:default")
+	case attrs.Case != "" && attrs.Default:
+		return false, nil, errors.New(": cannot have a:case and a:default on same element")
+	case attrs.If != nil || attrs.For != nil || attrs.ElseIf != nil || attrs.Else ||
+		attrs.Switch != nil:
+		return false, nil, errors.New(": cannot have a:if, a:for, a:else-if, a:else or a:switch on a case")
+	case attrs.IfLive != "":
+		return false, nil, errors.New(": cannot use a:if.live inside a:if, a:for or a:switch")
+	case attrs.ForLive != nil:
+		return false, nil, errors.New(": cannot use a:for.live inside a:if, a:for or a:switch")
+	case attrs.Default && cp.block.HasDefault():
+		return false, nil, errors.New(": duplicate a:default")
+	}
+	c := &data.ControlBlock{Expression: attrs.Case,
+		Path: append([]int(nil), *cp.seh.indexList...)}
+	if err = cp.seh.processBlock(n, c, attrs); err != nil {
+		return
+	}
+	cp.block.Cases = append(cp.block.Cases, c)
+	return false, nil, nil
+}
+
+// precedingIf returns the IfBlock that is continued by a:else-if or a:else on
+// n, or nil if the previous element has neither a:if nor a:else-if. Only
+// whitespace and comments may be between the elements.
+func (seh *stdElementHandler) precedingIf(n *html.Node) *data.ControlBlock {
+	prev := n.PrevSibling
+	for prev != nil && (prev.Type == html.CommentNode ||
+		prev.Type == html.TextNode && strings.TrimSpace(prev.Data) == "") {
+		prev = prev.PrevSibling
+	}
+	if prev == nil || prev != seh.lastIfNode {
+		return nil
+	}
+	return seh.lastIf
+}
+
 func (eh *elementHandler) Process(n *html.Node) (descend bool, replacement *html.Node, err error) {
 	if err = eh.updateCurForm(n); err != nil {
 		return
@@ -317,25 +504,75 @@ func (eh *elementHandler) Process(n *html.Node) (descend bool, replacement *html
 	if err = attributes.ExtractAskewAttribs(n, &attrs); err != nil {
 		return
 	}
-	descend, err = eh.handleControlBlocksAndAssignments(n, attrs)
-	if descend {
-		if err := eh.mapCaptures(n, attrs.Capture); err != nil {
-			return false, nil, errors.New(": " + err.Error())
+	if attrs.IfLive != "" {
+		if err = eh.processLive(n, attrs); err != nil {
+			return
 		}
+	}
+	if attrs.ForLive != nil {
+		return false, nil, eh.processLiveFor(n, attrs)
+	}
+	descend, err = eh.handleControlBlocksAndAssignments(n, attrs)
+	// the captures and bindings of other control block elements are part of
+	// their block, but the element with a:switch itself is always kept.
+	if err != nil || !descend && attrs.Switch == nil {
+		return
+	}
+	capture, err := eh.mapCaptures(n, attrs.Capture)
+	if err != nil {
+		return false, nil, attributes.ValueError("a:capture", "",
+			errors.New(": "+err.Error()))
+	}
+	if capture != nil {
+		eh.cmp.Captures = append(eh.cmp.Captures, *capture)
+	}
+	variables, err := eh.processBindings(attrs.Bindings)
+	if err != nil {
+		return false, nil, attributes.ValueError("a:bindings", "", err)
+	}
+	eh.cmp.Variables = append(eh.cmp.Variables, variables...)
+	if err = eh.processObserved(n, attrs.Observed); err != nil {
+		return false, nil, attributes.ValueError("a:bindings", "", err)
+	}
+	return
+}
 
-		if err = eh.processBindings(attrs.Bindings); err != nil {
-			return false, nil, err
-		}
-	} else {
-		if len(attrs.Capture) > 0 {
-			return false, nil, errors.New(": cannot capture inside a:if or a:for")
-		}
-		if len(attrs.Bindings) > 0 {
-			return false, nil, errors.New(": cannot bind inside a:if or a:for")
-		}
+// processLive records the a:if.live of n. The element is processed like any
+// other element, the generated code hides it after it has been initialized.
+func (eh *elementHandler) processLive(n *html.Node, attrs attributes.General) error {
+	if attrs.If != nil || attrs.For != nil || attrs.ForLive != nil ||
+		attrs.ElseIf != nil || attrs.Else || attrs.Switch != nil {
+		return errors.New(": cannot have a:if.live together with a:if, a:for, a:for.live, a:else-if, a:else or a:switch")
 	}
+	path := append([]int(nil), *eh.indexList...)
+	// the first and last node of a component must never change.
+	if len(path) == 1 && (n.PrevSibling == nil || n.NextSibling == nil) {
+		return errors.New(": a:if.live cannot be on the first or last node of a component")
+	}
+	eh.cmp.Live = append(eh.cmp.Live,
+		data.LiveIf{Expression: attrs.IfLive, Path: path, Node: n})
+	return nil
+}
 
-	return
+// processLiveFor processes n, which has a:for.live, and its content as the
+// block of the a:for.live.
+func (eh *elementHandler) processLiveFor(n *html.Node, attrs attributes.General) error {
+	if attrs.If != nil || attrs.For != nil || attrs.ElseIf != nil || attrs.Else ||
+		attrs.Switch != nil || attrs.Case != "" || attrs.Default {
+		return errors.New(": cannot have a:for.live together with a:if, a:for, a:else-if, a:else, a:switch, a:case or a:default")
+	}
+	path := append([]int(nil), *eh.indexList...)
+	// the first and last node of a component must never change.
+	if len(path) == 1 && (n.PrevSibling == nil || n.NextSibling == nil) {
+		return errors.New(": a:for.live cannot be on the first or last node of a component")
+	}
+	if insideLive(eh.cmp.Live, path) {
+		return errors.New(": cannot use a:for.live inside a:if.live")
+	}
+	lf := &data.LiveFor{ControlBlock: attrs.ForLive, Node: n}
+	lf.Path = path
+	eh.cmp.LiveFor = append(eh.cmp.LiveFor, lf)
+	return eh.processBlock(n, lf.ControlBlock, attrs)
 }
 
 type ctrlBlockElementProcessor struct {
@@ -352,12 +589,36 @@ func (cbeh *ctrlBlockElementProcessor) Process(n *html.Node) (descend bool, repl
 		return
 	}
 
-	if len(attrs.Capture) > 0 {
-		return false, nil, errors.New(": cannot capture inside a:if or a:for")
+	if attrs.IfLive != "" {
+		return false, nil, errors.New(": cannot use a:if.live inside a:if, a:for or a:switch")
 	}
-	if len(attrs.Bindings) > 0 {
-		return false, nil, errors.New(": cannot bind inside a:if or a:for")
+	if attrs.ForLive != nil {
+		return false, nil, errors.New(": cannot use a:for.live inside a:if, a:for or a:switch")
 	}
 	descend, err = cbeh.handleControlBlocksAndAssignments(n, attrs)
+	if err == nil && (descend || attrs.Switch != nil) {
+		err = cbeh.processDynamic(n, attrs)
+	}
 	return
 }
+
+// processDynamic processes the captures and bindings of n, which become part
+// of the control block.
+func (cbeh *ctrlBlockElementProcessor) processDynamic(n *html.Node, attrs attributes.General) error {
+	if len(attrs.Observed) > 0 {
+		return errors.New(": cannot use observed values inside a:if, a:for or a:switch")
+	}
+	capture, err := cbeh.mapCaptures(n, attrs.Capture)
+	if err != nil {
+		return attributes.ValueError("a:capture", "", errors.New(": "+err.Error()))
+	}
+	if capture != nil {
+		cbeh.b.Captures = append(cbeh.b.Captures, *capture)
+	}
+	variables, err := cbeh.processBindings(attrs.Bindings)
+	if err != nil {
+		return attributes.ValueError("a:bindings", "", err)
+	}
+	cbeh.b.Variables = append(cbeh.b.Variables, variables...)
+	return nil
+}
@@ -3,6 +3,7 @@ package units
 import (
 	"errors"
 	"fmt"
+	"strings"
 
 	"github.com/flyx/askew/attributes"
 	"github.com/flyx/askew/data"
@@ -30,7 +31,7 @@ func resolveEmbed(n *html.Node, syms *data.Symbols,
 	}
 
 	e = data.Embed{Kind: data.DirectEmbed, Path: indexList,
-		Field: attrs.Name, Control: attrs.Control}
+		Field: attrs.Name, Control: attrs.Control, Node: n}
 	if e.Field == "" {
 		return data.Embed{}, nil, "", errors.New(": attribute `name` missing")
 	}
@@ -43,6 +44,17 @@ func resolveEmbed(n *html.Node, syms *data.Symbols,
 		}
 		e.Kind = data.OptionalEmbed
 	}
+	if attrs.Virtual {
+		if e.Kind != data.ListEmbed {
+			return data.Embed{}, nil, "", errors.New(": `virtual` requires `list`")
+		}
+		if attrs.ItemHeight == 0 {
+			return data.Embed{}, nil, "", errors.New(": virtual list requires `item-height`")
+		}
+		e.Virtual, e.ItemHeight = true, attrs.ItemHeight
+	} else if attrs.ItemHeight != 0 {
+		return data.Embed{}, nil, "", errors.New(": `item-height` requires `virtual`")
+	}
 	if attrs.T == "" {
 		if e.Kind == data.DirectEmbed {
 			attrs.T = "askew.Component"
@@ -64,7 +76,7 @@ func resolveEmbed(n *html.Node, syms *data.Symbols,
 			// of parameters though
 			err = nil
 		} else {
-			return data.Embed{}, nil, "", errors.New(": attribute `type` invalid: " + err.Error())
+			return data.Embed{}, nil, "", attributes.ValueError("type", attrs.T, err)
 		}
 	} else {
 		// only when askew generates the new and init funcs for the component can
@@ -106,10 +118,27 @@ func (ep *embedProcessor) Process(n *html.Node) (descend bool,
 	if err != nil {
 		return false, nil, err
 	}
+	if insideLive(ep.syms.CurUnit.Live, path) {
+		return false, nil, errors.New(": cannot embed inside a:if.live")
+	}
+	for _, lf := range ep.syms.CurUnit.LiveFor {
+		if isInside(lf.Path, path) {
+			return false, nil, errors.New(": cannot embed inside a:for.live")
+		}
+	}
+	if e.Virtual {
+		if err = checkVirtualContainer(n, path); err != nil {
+			return false, nil, err
+		}
+		if target != nil && !target.UsageRestricted {
+			target.GenVirtual = true
+		}
+	}
 
 	cp := constructProcessor{ep.syms, &e, constructParent{newName: newName}}
 	if target != nil {
 		cp.parentType.numParams = len(target.Parameters)
+		cp.parentType.hydratable = target.GenNewInit
 	} else {
 		cp.parentType.numParams = -1
 	}
@@ -119,8 +148,58 @@ func (ep *embedProcessor) Process(n *html.Node) (descend bool,
 	if e.Kind == data.OptionalEmbed && len(e.ConstructorCalls) > 1 {
 		return false, nil, errors.New(": too many <a:construct> for optional embed")
 	}
+	if e.Kind == data.DirectEmbed {
+		e.Hydratable = cp.parentType.hydratable
+	} else {
+		e.Hydratable = true
+		for _, c := range e.ConstructorCalls {
+			e.Hydratable = e.Hydratable && c.Hydratable
+		}
+	}
 	ep.syms.CurUnit.Embeds = append(ep.syms.CurUnit.Embeds, e)
 	replacement = &html.Node{Type: html.CommentNode,
 		Data: "embed(" + e.Field + ")"}
 	return
 }
+
+// checkVirtualContainer checks that the virtual list embed n is the only
+// content of its parent element, which is required for calculating the
+// visible items from the scroll position.
+func checkVirtualContainer(n *html.Node, path []int) error {
+	if len(path) < 2 {
+		return errors.New(": virtual list must be inside an element")
+	}
+	for c := n.Parent.FirstChild; c != nil; c = c.NextSibling {
+		switch {
+		case c == n, c.Type == html.CommentNode:
+		case c.Type == html.TextNode && strings.TrimSpace(c.Data) == "":
+		default:
+			return errors.New(": virtual list must be the only content of its parent element")
+		}
+	}
+	return nil
+}
+
+// insideLive returns true if the given path leads into an element with
+// a:if.live.
+func insideLive(live []data.LiveIf, path []int) bool {
+	for _, l := range live {
+		if isInside(l.Path, path) {
+			return true
+		}
+	}
+	return false
+}
+
+// isInside returns true if the given path leads into the element at outer.
+func isInside(outer, path []int) bool {
+	if len(outer) >= len(path) {
+		return false
+	}
+	for i := range outer {
+		if outer[i] != path[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -0,0 +1,60 @@
+package units
+
+import (
+	"errors"
+
+	"github.com/flyx/askew/data"
+	"github.com/flyx/askew/parsers"
+	"github.com/flyx/net/html"
+)
+
+type eventsProcessor struct {
+	syms      *data.Symbols
+	cmp       *data.Component
+	indexList *[]int
+}
+
+func (ep *eventsProcessor) Process(n *html.Node) (descend bool,
+	replacement *html.Node, err error) {
+	if len(*ep.indexList) != 1 {
+		return false, nil, errors.New(": must be defined as direct child of <a:component>")
+	}
+	def := n.FirstChild
+	if def.Type != html.TextNode || def.NextSibling != nil {
+		return false, nil, errors.New(": must have plain text as content and nothing else")
+	}
+	if ep.cmp.Events != nil {
+		return false, nil, errors.New(": only one <a:events> allowed per <a:component>")
+	}
+	parsed, err := parsers.ParseHandlers(def.Data)
+	if err != nil {
+		return false, nil, parsers.TextError(": unable to parse `"+def.Data+"`: ", def.Data, err)
+	}
+	ep.cmp.Events = make(map[string]data.Event)
+	emitters := make(map[string]struct{})
+	for _, raw := range parsed {
+		if raw.Returns != nil {
+			return false, nil, errors.New(": event cannot have a return type: " + raw.Name)
+		}
+		// the names of events differing only in their first letter would
+		// yield the same Emit method.
+		emitter := data.EmitName(raw.Name)
+		if _, ok := emitters[emitter]; ok {
+			return false, nil, errors.New(": duplicate event name: " + raw.Name)
+		}
+		emitters[emitter] = struct{}{}
+		for _, p := range raw.Params {
+			switch p.Type.Kind {
+			case data.IntType, data.StringType, data.BoolType, data.JSValueType:
+			default:
+				return false, nil, errors.New(": unsupported type for parameter `" +
+					p.Name + "` of event " + raw.Name + ": " + p.Type.String() +
+					" (must be int, string, bool or js.Value)")
+			}
+		}
+		ep.cmp.Events[raw.Name] = data.Event{Params: raw.Params, Node: n}
+	}
+
+	replacement = &html.Node{Type: html.CommentNode, Data: "events"}
+	return
+}
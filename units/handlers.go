@@ -25,7 +25,7 @@ func (hp *handlersProcessor) Process(n *html.Node) (descend bool,
 	}
 	parsed, err := parsers.ParseHandlers(def.Data)
 	if err != nil {
-		return false, nil, errors.New(": unable to parse `" + def.Data + "`: " + err.Error())
+		return false, nil, parsers.TextError(": unable to parse `"+def.Data+"`: ", def.Data, err)
 	}
 	if hp.cmp.Handlers != nil {
 		return false, nil, errors.New(": only one <a:handlers> allowed per <a:component>")
@@ -40,7 +40,7 @@ func (hp *handlersProcessor) Process(n *html.Node) (descend bool,
 			return false, nil, errors.New(": duplicate handler name: " + raw.Name)
 		}
 		hp.cmp.Handlers[raw.Name] =
-			data.Handler{Params: raw.Params, Returns: raw.Returns}
+			data.Handler{Params: raw.Params, Returns: raw.Returns, Node: n}
 	}
 
 	replacement = &html.Node{Type: html.CommentNode, Data: "handlers"}
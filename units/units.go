@@ -1,7 +1,6 @@
 package units
 
 import (
-	"errors"
 	"os"
 	"path/filepath"
 
@@ -11,18 +10,18 @@ import (
 	"github.com/flyx/net/html/atom"
 )
 
-// ProcessFile processes a file containing units (*.askew)
-func ProcessFile(file *data.AskewFile, syms *data.Symbols) error {
+// ProcessFile processes a file containing units (*.askew).
+// Errors are reported to syms; processing continues with the next component.
+func ProcessFile(file *data.AskewFile, syms *data.Symbols) {
 	syms.SetAskewFile(file)
 	os.Stdout.WriteString("[info] processing units: " + file.Path + "\n")
 	w := walker.Walker{TextNode: walker.WhitespaceOnly{},
 		Component: &componentProcessor{unitProcessor{syms}},
+		OnError:   func(err error) { syms.Report(file.Path, err) },
 	}
-	_, _, err := w.WalkChildren(nil, &walker.NodeSlice{Items: file.Content})
-	if err != nil {
-		return errors.New(file.Path + ": " + err.Error())
+	if _, _, err := w.WalkChildren(nil, &walker.NodeSlice{Items: file.Content}); err != nil {
+		syms.Report(file.Path, err)
 	}
-	return err
 }
 
 func processSiteDescriptor(site *data.ASiteFile) error {
@@ -30,7 +29,7 @@ func processSiteDescriptor(site *data.ASiteFile) error {
 	rootNode := site.Document.FirstChild.NextSibling
 	err := attributes.Collect(rootNode, &siteAttrs)
 	if err != nil {
-		return err
+		return &data.NodeError{Node: rootNode, Err: err}
 	}
 	if siteAttrs.HTMLFile == "" {
 		site.HTMLFile = "index.html"
@@ -57,15 +56,19 @@ func processSiteDescriptor(site *data.ASiteFile) error {
 	return nil
 }
 
-// ProcessSite processes a file containing a site skeleton (*.asite)
-func ProcessSite(file *data.ASiteFile, syms *data.Symbols) error {
+// ProcessSite processes a file containing a site skeleton (*.asite).
+// Errors are reported to syms.
+func ProcessSite(file *data.ASiteFile, syms *data.Symbols) {
 	syms.SetASiteFile(file)
 	os.Stdout.WriteString("[info] processing site: " + file.Path + "\n")
 	if err := processSiteDescriptor(file); err != nil {
-		return err
+		syms.Report(file.Path, err)
+		return
 	}
 
 	p := unitProcessor{syms}
 
-	return p.processUnitContent(file.RootNode(), &file.Unit, nil, file.RootNode(), false)
+	if err := p.processUnitContent(file.RootNode(), &file.Unit, nil, file.RootNode(), false); err != nil {
+		syms.Report(file.Path, err)
+	}
 }
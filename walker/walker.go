@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/flyx/askew/data"
 	"github.com/flyx/net/html"
 )
 
@@ -33,6 +34,7 @@ type Walker struct {
 	Include     NodeHandler
 	Embed       NodeHandler
 	Handlers    NodeHandler
+	Events      NodeHandler
 	Site        NodeHandler
 	StdElements NodeHandler
 	TextNode    NodeHandler
@@ -41,6 +43,10 @@ type Walker struct {
 	Data        NodeHandler
 	Construct   NodeHandler
 	IndexList   *[]int
+	// OnError, if set, is called with the error of a node that failed to
+	// process. The walker then leaves that node unchanged and continues with
+	// its next sibling instead of returning the error.
+	OnError func(err error)
 }
 
 func (w *Walker) walk(n *html.Node, nodesCount map[string]int) (replacement *html.Node, err error) {
@@ -49,9 +55,13 @@ func (w *Walker) walk(n *html.Node, nodesCount map[string]int) (replacement *htm
 		return nil, errors.New(": encountered error node: " + n.Data)
 	case html.TextNode:
 		if w.TextNode == nil {
-			return nil, errors.New(": text content not allowed here")
+			err = errors.New(": text content not allowed here")
+		} else {
+			_, replacement, err = w.TextNode.Process(n)
+		}
+		if err != nil {
+			return nil, &data.NodeError{Node: n, Err: err}
 		}
-		_, replacement, err = w.TextNode.Process(n)
 		return
 	case html.ElementNode:
 		break
@@ -65,7 +75,12 @@ func (w *Walker) walk(n *html.Node, nodesCount map[string]int) (replacement *htm
 	nodesCount[n.Data] = count
 	replacement, err = w.processElement(n)
 	if err != nil {
-		return nil, fmt.Errorf("/%s[%d]%s", n.Data, count, err.Error())
+		crumb := fmt.Sprintf("/%s[%d]", n.Data, count)
+		if ne, ok := err.(*data.NodeError); ok {
+			ne.Path = crumb + ne.Path
+			return nil, ne
+		}
+		return nil, &data.NodeError{Node: n, Path: crumb, Err: err}
 	}
 	return
 }
@@ -95,6 +110,8 @@ func (w *Walker) processElement(n *html.Node) (replacement *html.Node, err error
 			h = w.Embed
 		case "a:handlers":
 			h = w.Handlers
+		case "a:events":
+			h = w.Events
 		case "a:text":
 			h = w.Text
 		case "a:controller":
@@ -167,7 +184,11 @@ func (w *Walker) WalkChildren(parent *html.Node,
 	for c := l.next(); c != nil; c = l.next() {
 		f, err := w.walk(c, nodesCount)
 		if err != nil {
-			return nil, nil, err
+			if w.OnError == nil {
+				return nil, nil, err
+			}
+			w.OnError(err)
+			f = nil
 		}
 		if repFirst == nil {
 			if f == nil {
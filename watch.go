@@ -0,0 +1,122 @@
+package main
+
+import (
+	"os"
+	"strings"
+	"time"
+
+	"github.com/flyx/askew/data"
+	"github.com/flyx/askew/output"
+	"github.com/flyx/askew/packages"
+)
+
+// watchInterval is the time between two checks for modified files.
+const watchInterval = 500 * time.Millisecond
+
+type watcher struct {
+	p        *processor
+	excludes []string
+	dataPath string
+	data     interface{}
+	dataMod  time.Time
+	snapshot packages.Snapshot
+}
+
+func modTime(path string) time.Time {
+	info, err := os.Stat(path)
+	if err != nil {
+		return time.Time{}
+	}
+	return info.ModTime()
+}
+
+// changes returns the relative paths of all packages that need to be
+// discovered again.
+func (w *watcher) changes() []string {
+	cur, err := packages.TakeSnapshot(w.excludes)
+	if err != nil {
+		os.Stdout.WriteString("[error] " + err.Error() + "\n")
+		return nil
+	}
+	changed := cur.ChangedPackages(w.snapshot)
+	w.snapshot = cur
+	if w.dataPath != "" {
+		if t := modTime(w.dataPath); !t.Equal(w.dataMod) {
+			w.dataMod = t
+			loaded, err := loadData(w.dataPath)
+			if err != nil {
+				os.Stdout.WriteString("[error] " + err.Error() + "\n")
+				return changed
+			}
+			w.data = loaded
+			changed = append(changed, cur.TemplatePackages()...)
+		}
+	}
+	return changed
+}
+
+// forget discards everything the processor stores about the files of the
+// package at relPath, which is about to be discovered again.
+func (w *watcher) forget(relPath string) {
+	pkg, ok := w.p.syms.Packages[relPath]
+	if !ok {
+		return
+	}
+	for _, file := range pkg.Files {
+		w.p.syms.Positions.Discard(file.Path)
+		delete(w.p.failed, file)
+	}
+	if pkg.Site != nil {
+		w.p.syms.Positions.Discard(pkg.Site.Path)
+	}
+}
+
+// regenerate discovers the given packages anew and regenerates them along
+// with all packages depending on them.
+func (w *watcher) regenerate(changed []string, outputPath string,
+	backend output.Backend) {
+	syms := &w.p.syms
+	// dependents must be calculated before rediscovery since packages might
+	// have been removed.
+	affected := packages.Dependents(syms.ImportPath, syms.Packages, changed)
+	syms.Diagnostics = data.Diagnostics{}
+	for _, relPath := range affected {
+		w.forget(relPath)
+		if err := packages.DiscoverPackage(&syms.BaseDir, relPath, w.data); err != nil {
+			os.Stdout.WriteString("[error] " + err.Error() + "\n")
+			return
+		}
+	}
+	subset := make(map[string]*data.Package)
+	for _, relPath := range affected {
+		if pkg, ok := syms.Packages[relPath]; ok {
+			subset[relPath] = pkg
+		}
+	}
+	if syms.Diagnostics.Len() > 0 {
+		reportDiagnostics(&syms.Diagnostics)
+		return
+	}
+	order, err := packages.Sort(syms.ImportPath, subset)
+	if err != nil {
+		os.Stdout.WriteString("[error] " + err.Error() + "\n")
+		return
+	}
+	os.Stdout.WriteString("[info] regenerating: " + strings.Join(order, ", ") + "\n")
+	w.p.generate(order, outputPath, backend)
+}
+
+// run checks for changes periodically and never returns.
+func (w *watcher) run(outputPath string, backend output.Backend) {
+	if w.dataPath != "" {
+		w.dataMod = modTime(w.dataPath)
+	}
+	os.Stdout.WriteString("[info] watching for changes\n")
+	for {
+		time.Sleep(watchInterval)
+		if changed := w.changes(); len(changed) > 0 {
+			w.regenerate(changed, outputPath, backend)
+			os.Stdout.WriteString("[info] watching for changes\n")
+		}
+	}
+}
@@ -0,0 +1,59 @@
+package main
+
+import (
+	"os"
+	"testing"
+
+	"github.com/flyx/askew/output"
+	"github.com/flyx/askew/packages"
+)
+
+func TestDiscoveryErrorsReported(t *testing.T) {
+	inProject(t, map[string]string{
+		"ui/a.askew": `<a:package></a:package>`,
+	}, func(dir string) {
+		p, order := newProcessor(t, checkMode)
+		if p.generate(order, dir, output.WasmBackend) {
+			t.Fatal("generate succeeded despite errors")
+		}
+		expectDiagnostics(t, &p.syms.Diagnostics,
+			"ui/a.askew:1:1: must contain text content")
+	})
+}
+
+func TestRegenerateForgetsDeletedFiles(t *testing.T) {
+	inProject(t, map[string]string{
+		"ui/a.askew": `<a:component name="A"><p a:bindings="bogus"></p></a:component>`,
+		"ui/b.askew": `<a:component name="B"><a:include name="missing"></a:include></a:component>`,
+	}, func(dir string) {
+		snapshot, err := packages.TakeSnapshot(nil)
+		if err != nil {
+			t.Fatal(err)
+		}
+		p, order := newProcessor(t, checkMode)
+		if p.generate(order, dir, output.WasmBackend) {
+			t.Fatal("generate succeeded despite errors")
+		}
+		if len(p.failed) != 1 {
+			t.Fatalf("expected one failed file, got %d", len(p.failed))
+		}
+		old := p.syms.Packages["ui"].Files[0]
+		if _, ok := p.syms.Positions.Node(old.Content[0]); !ok {
+			t.Fatal("missing position of processed file")
+		}
+
+		if err = os.Remove("ui/b.askew"); err != nil {
+			t.Fatal(err)
+		}
+		w := watcher{p: p, snapshot: snapshot}
+		w.regenerate(w.changes(), dir, output.WasmBackend)
+		if len(p.failed) != 0 {
+			t.Errorf("failed files of previous run have not been pruned")
+		}
+		if _, ok := p.syms.Positions.Node(old.Content[0]); ok {
+			t.Error("positions of previous run have not been pruned")
+		}
+		expectDiagnostics(t, &p.syms.Diagnostics,
+			"ui/a.askew:1:38: invalid bindings: parse error near 'b'")
+	})
+}